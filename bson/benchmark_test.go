@@ -9,6 +9,7 @@ package bson
 import (
 	"bytes"
 	"compress/gzip"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -419,6 +420,71 @@ func BenchmarkCodeUnmarshal(b *testing.B) {
 	})
 }
 
+// BenchmarkUnmarshalFields compares extracting 3 fields from a 100-field document with UnmarshalFields against
+// unmarshaling the whole document.
+func BenchmarkUnmarshalFields(b *testing.B) {
+	doc := make(D, 0, 100)
+	for i := 0; i < 100; i++ {
+		key := fmt.Sprintf("field%d", i)
+		switch i % 4 {
+		case 0:
+			doc = append(doc, E{key, fmt.Sprintf("value %d", i)})
+		case 1:
+			doc = append(doc, E{key, int64(i)})
+		case 2:
+			doc = append(doc, E{key, D{{"a", int32(i)}, {"b", A{"x", "y", "z"}}}})
+		case 3:
+			doc = append(doc, E{key, A{"one", "two", "three"}})
+		}
+	}
+	data, err := Marshal(doc)
+	if err != nil {
+		b.Fatalf("error marshalling BSON: %s", err)
+	}
+
+	type selected struct {
+		Field0  string   `bson:"field0"`
+		Field49 int64    `bson:"field49"`
+		Field99 []string `bson:"field99"`
+	}
+
+	b.Run("Unmarshal map", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(int64(len(data)))
+		for i := 0; i < b.N; i++ {
+			var m map[string]interface{}
+			if err := Unmarshal(data, &m); err != nil {
+				b.Fatalf("error unmarshalling BSON: %s", err)
+			}
+		}
+	})
+	b.Run("Unmarshal struct", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(int64(len(data)))
+		for i := 0; i < b.N; i++ {
+			var s selected
+			if err := Unmarshal(data, &s); err != nil {
+				b.Fatalf("error unmarshalling BSON: %s", err)
+			}
+		}
+	})
+	b.Run("UnmarshalFields", func(b *testing.B) {
+		b.ReportAllocs()
+		b.SetBytes(int64(len(data)))
+		for i := 0; i < b.N; i++ {
+			var s selected
+			_, err := UnmarshalFields(data, map[string]interface{}{
+				"field0":  &s.Field0,
+				"field49": &s.Field49,
+				"field99": &s.Field99,
+			})
+			if err != nil {
+				b.Fatalf("error unmarshalling BSON: %s", err)
+			}
+		}
+	})
+}
+
 func BenchmarkCodeMarshal(b *testing.B) {
 	b.ReportAllocs()
 	if codeJSON == nil {
@@ -447,3 +513,73 @@ func BenchmarkCodeMarshal(b *testing.B) {
 		b.SetBytes(int64(len(codeJSON)))
 	})
 }
+
+// BenchmarkEncoderContext compares encoding and decoding a large document with and without a
+// cancelable context set on the Encoder or Decoder.
+func BenchmarkEncoderContext(b *testing.B) {
+	doc := readExtJSONFile("full_bson.json.gz")
+	data, err := Marshal(doc)
+	if err != nil {
+		b.Fatalf("error marshalling BSON: %s", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for _, tc := range []struct {
+		desc string
+		ctx  context.Context
+	}{
+		{"no context", nil},
+		{"cancelable context", ctx},
+	} {
+		b.Run(tc.desc, func(b *testing.B) {
+			b.Run("Encode", func(b *testing.B) {
+				b.ReportAllocs()
+				b.SetBytes(int64(len(data)))
+				buf := new(bytes.Buffer)
+				for i := 0; i < b.N; i++ {
+					buf.Reset()
+					enc := NewEncoder(NewDocumentWriter(buf))
+					enc.SetContext(tc.ctx)
+					if err := enc.Encode(doc); err != nil {
+						b.Fatalf("error encoding BSON: %s", err)
+					}
+				}
+			})
+			b.Run("Decode", func(b *testing.B) {
+				b.ReportAllocs()
+				b.SetBytes(int64(len(data)))
+				for i := 0; i < b.N; i++ {
+					dec := NewDecoder(NewDocumentReader(bytes.NewReader(data)))
+					dec.SetContext(tc.ctx)
+					var got map[string]interface{}
+					if err := dec.Decode(&got); err != nil {
+						b.Fatalf("error decoding BSON: %s", err)
+					}
+				}
+			})
+		})
+	}
+}
+
+var benchDocumentSink D
+
+func BenchmarkNewDocument(b *testing.B) {
+	b.Run("literal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			benchDocumentSink = D{{"a", 1}, {"b", "x"}, {"c", true}}
+		}
+	})
+	b.Run("NewDocument", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			d, err := NewDocument("a", 1, "b", "x", "c", true)
+			if err != nil {
+				b.Fatal("NewDocument:", err)
+			}
+			benchDocumentSink = d
+		}
+	})
+}
@@ -0,0 +1,109 @@
+// Copyright (C) MongoDB, Inc. 2026-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bson
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Binary subtypes used by the default registry to encode LittleEndianBytes and BigEndianBytes values. Both are in the
+// user-defined subtype range so that they do not collide with subtypes reserved by the BSON specification.
+const (
+	TypeBinaryLittleEndian byte = TypeBinaryUserDefined
+	TypeBinaryBigEndian    byte = TypeBinaryUserDefined + 1
+)
+
+// LittleEndianBytes is a byte slice that holds little-endian data. The default registry encodes LittleEndianBytes
+// values as BSON binary values with subtype TypeBinaryLittleEndian so that readers can tell the byte order apart from
+// generic binary data.
+type LittleEndianBytes []byte
+
+// BigEndianBytes is a byte slice that holds big-endian data. The default registry encodes BigEndianBytes values as
+// BSON binary values with subtype TypeBinaryBigEndian so that readers can tell the byte order apart from generic
+// binary data.
+type BigEndianBytes []byte
+
+var (
+	tLittleEndianBytes = reflect.TypeOf(LittleEndianBytes(nil))
+	tBigEndianBytes    = reflect.TypeOf(BigEndianBytes(nil))
+)
+
+// BinaryCodec is the Codec used for byte slice types that are stored as BSON binary values with a specific subtype.
+// It can be registered for any type whose underlying type is []byte.
+//
+// Example usage:
+//
+//	type checksum []byte
+//
+//	reg := bson.NewRegistry()
+//	codec := &bson.BinaryCodec{Subtype: bson.TypeBinaryMD5}
+//	reg.RegisterTypeEncoder(reflect.TypeOf(checksum(nil)), codec)
+//	reg.RegisterTypeDecoder(reflect.TypeOf(checksum(nil)), codec)
+type BinaryCodec struct {
+	// Subtype is the BSON binary subtype written by EncodeValue. DecodeValue returns an error for binary values with
+	// any other subtype.
+	Subtype byte
+}
+
+var (
+	_ ValueEncoder = &BinaryCodec{}
+	_ ValueDecoder = &BinaryCodec{}
+)
+
+// EncodeValue is the ValueEncoder for byte slice types with a specific binary subtype.
+func (bc *BinaryCodec) EncodeValue(_ EncodeContext, vw ValueWriter, val reflect.Value) error {
+	if !val.IsValid() || val.Kind() != reflect.Slice || val.Type().Elem() != tByte {
+		return ValueEncoderError{
+			Name:     "BinaryEncodeValue",
+			Kinds:    []reflect.Kind{reflect.Slice},
+			Received: val,
+		}
+	}
+	if val.IsNil() {
+		return vw.WriteNull()
+	}
+	return vw.WriteBinaryWithSubtype(val.Bytes(), bc.Subtype)
+}
+
+// DecodeValue is the ValueDecoder for byte slice types with a specific binary subtype.
+func (bc *BinaryCodec) DecodeValue(_ DecodeContext, vr ValueReader, val reflect.Value) error {
+	if !val.CanSet() || val.Kind() != reflect.Slice || val.Type().Elem() != tByte {
+		return ValueDecoderError{
+			Name:     "BinaryDecodeValue",
+			Kinds:    []reflect.Kind{reflect.Slice},
+			Received: val,
+		}
+	}
+
+	switch vrType := vr.Type(); vrType {
+	case TypeBinary:
+		data, subtype, err := vr.ReadBinary()
+		if err != nil {
+			return err
+		}
+		if subtype != bc.Subtype {
+			return fmt.Errorf("only binary values with subtype %#02x can be decoded into %s, but got subtype %#02x",
+				bc.Subtype, val.Type(), subtype)
+		}
+		val.SetBytes(data)
+		return nil
+	case TypeNull:
+		if err := vr.ReadNull(); err != nil {
+			return err
+		}
+	case TypeUndefined:
+		if err := vr.ReadUndefined(); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("cannot decode %v into a %s", vrType, val.Type())
+	}
+
+	val.Set(reflect.Zero(val.Type()))
+	return nil
+}
@@ -0,0 +1,118 @@
+// Copyright (C) MongoDB, Inc. 2026-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bson
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+)
+
+func TestBinaryCodec(t *testing.T) {
+	t.Parallel()
+
+	data := []byte{0x01, 0x02, 0x03}
+
+	type binaryDoc struct {
+		Plain []byte            `bson:"plain"`
+		LE    LittleEndianBytes `bson:"le"`
+		BE    BigEndianBytes    `bson:"be"`
+	}
+
+	t.Run("wire subtypes", func(t *testing.T) {
+		t.Parallel()
+
+		b, err := Marshal(binaryDoc{Plain: data, LE: data, BE: data})
+		require.NoError(t, err, "Marshal error")
+
+		testCases := []struct {
+			key     string
+			subtype byte
+		}{
+			{"plain", TypeBinaryGeneric},
+			{"le", TypeBinaryLittleEndian},
+			{"be", TypeBinaryBigEndian},
+		}
+		for _, tc := range testCases {
+			val, err := Raw(b).LookupErr(tc.key)
+			require.NoError(t, err, "LookupErr error for %q", tc.key)
+			require.Equal(t, TypeBinary, val.Type, "expected %q to be binary", tc.key)
+
+			// A binary value is encoded as an int32 length, the subtype byte, and then the data.
+			assert.Equal(t, tc.subtype, val.Value[4],
+				"expected subtype %#02x for %q, got %#02x", tc.subtype, tc.key, val.Value[4])
+			assert.Equal(t, data, val.Value[5:], "expected data %v for %q, got %v", data, tc.key, val.Value[5:])
+		}
+	})
+	t.Run("round trip", func(t *testing.T) {
+		t.Parallel()
+
+		want := binaryDoc{Plain: data, LE: data, BE: data}
+		b, err := Marshal(want)
+		require.NoError(t, err, "Marshal error")
+
+		var got binaryDoc
+		err = Unmarshal(b, &got)
+		require.NoError(t, err, "Unmarshal error")
+		assert.Equal(t, want, got, "expected %v, got %v", want, got)
+	})
+	t.Run("nil", func(t *testing.T) {
+		t.Parallel()
+
+		b, err := Marshal(binaryDoc{})
+		require.NoError(t, err, "Marshal error")
+		assert.Equal(t, TypeNull, Raw(b).Lookup("le").Type, "expected nil value to be encoded as null")
+
+		got := binaryDoc{LE: data}
+		err = Unmarshal(b, &got)
+		require.NoError(t, err, "Unmarshal error")
+		assert.Nil(t, got.LE, "expected nil value, got %v", got.LE)
+	})
+	t.Run("subtype mismatch", func(t *testing.T) {
+		t.Parallel()
+
+		b, err := Marshal(D{{"be", Binary{Subtype: TypeBinaryLittleEndian, Data: data}}})
+		require.NoError(t, err, "Marshal error")
+
+		var got binaryDoc
+		err = Unmarshal(b, &got)
+		assert.NotNil(t, err, "expected Unmarshal error, got nil")
+	})
+	t.Run("custom type", func(t *testing.T) {
+		t.Parallel()
+
+		type checksum []byte
+
+		reg := NewRegistry()
+		codec := &BinaryCodec{Subtype: TypeBinaryMD5}
+		reg.RegisterTypeEncoder(reflect.TypeOf(checksum(nil)), codec)
+		reg.RegisterTypeDecoder(reflect.TypeOf(checksum(nil)), codec)
+
+		buf := new(bytes.Buffer)
+		enc := NewEncoder(NewDocumentWriter(buf))
+		enc.SetRegistry(reg)
+		err := enc.Encode(D{{"sum", checksum(data)}})
+		require.NoError(t, err, "Encode error")
+
+		val := Raw(buf.Bytes()).Lookup("sum")
+		subtype, got := val.Binary()
+		assert.Equal(t, TypeBinaryMD5, subtype, "expected subtype %#02x, got %#02x", TypeBinaryMD5, subtype)
+		assert.Equal(t, data, got, "expected data %v, got %v", data, got)
+
+		var doc struct {
+			Sum checksum `bson:"sum"`
+		}
+		dec := NewDecoder(NewDocumentReader(bytes.NewReader(buf.Bytes())))
+		dec.SetRegistry(reg)
+		err = dec.Decode(&doc)
+		require.NoError(t, err, "Decode error")
+		assert.Equal(t, checksum(data), doc.Sum, "expected %v, got %v", checksum(data), doc.Sum)
+	})
+}
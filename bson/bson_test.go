@@ -275,6 +275,255 @@ func TestD(t *testing.T) {
 	})
 }
 
+func TestNewDocument(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		args    []interface{}
+		want    D
+		wantErr bool
+	}{
+		{
+			name: "no arguments",
+			args: nil,
+			want: D{},
+		},
+		{
+			name: "even arguments",
+			args: []interface{}{"a", 1, "b", "x"},
+			want: D{{"a", 1}, {"b", "x"}},
+		},
+		{
+			name:    "odd arguments",
+			args:    []interface{}{"a", 1, "b"},
+			wantErr: true,
+		},
+		{
+			name:    "non-string key",
+			args:    []interface{}{"a", 1, 2, "x"},
+			wantErr: true,
+		},
+		{
+			name: "nil value",
+			args: []interface{}{"a", nil},
+			want: D{{"a", nil}},
+		},
+		{
+			name: "nested document value",
+			args: []interface{}{"a", MustDocument("b", 1), "c", NewArray(1, "x")},
+			want: D{{"a", D{{"b", 1}}}, {"c", A{1, "x"}}},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // Capture range variable.
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewDocument(tc.args...)
+			if tc.wantErr {
+				assert.NotNil(t, err, "expected NewDocument error, got nil")
+				assert.Nil(t, got, "expected nil document, got %v", got)
+				return
+			}
+			assert.Nil(t, err, "NewDocument error: %v", err)
+			assert.Equal(t, tc.want, got, "expected document %v, got %v", tc.want, got)
+		})
+	}
+}
+
+func TestMustDocument(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+		t.Parallel()
+
+		got := MustDocument("a", 1)
+		want := D{{"a", 1}}
+		assert.Equal(t, want, got, "expected document %v, got %v", want, got)
+	})
+	t.Run("panics on error", func(t *testing.T) {
+		t.Parallel()
+
+		defer func() {
+			r := recover()
+			assert.NotNil(t, r, "expected MustDocument to panic")
+		}()
+		_ = MustDocument("a")
+	})
+}
+
+func TestNewArray(t *testing.T) {
+	t.Parallel()
+
+	got := NewArray("a", nil, D{{"b", 1}})
+	want := A{"a", nil, D{{"b", 1}}}
+	assert.Equal(t, want, got, "expected array %v, got %v", want, got)
+
+	empty := NewArray()
+	assert.Equal(t, 0, len(empty), "expected empty array, got %v", empty)
+}
+
+func TestMergeDocuments(t *testing.T) {
+	t.Parallel()
+
+	oid := NewObjectIDFromTimestamp(time.Unix(1, 0))
+
+	testCases := []struct {
+		name         string
+		base         D
+		override     D
+		want         D
+		wantDeep     D
+		wantCoalesce D
+	}{
+		{
+			name:         "nil documents",
+			want:         D{},
+			wantDeep:     D{},
+			wantCoalesce: D{},
+		},
+		{
+			name:         "no overlap",
+			base:         D{{"a", 1}, {"b", 2}},
+			override:     D{{"c", 3}},
+			want:         D{{"a", 1}, {"b", 2}, {"c", 3}},
+			wantDeep:     D{{"a", 1}, {"b", 2}, {"c", 3}},
+			wantCoalesce: D{{"a", 1}, {"b", 2}, {"c", 3}},
+		},
+		{
+			name:         "full overlap",
+			base:         D{{"a", 1}, {"b", 2}},
+			override:     D{{"b", "x"}, {"a", "y"}},
+			want:         D{{"a", "y"}, {"b", "x"}},
+			wantDeep:     D{{"a", "y"}, {"b", "x"}},
+			wantCoalesce: D{{"a", "y"}, {"b", "x"}},
+		},
+		{
+			name:         "partial overlap",
+			base:         D{{"a", 1}, {"b", 2}},
+			override:     D{{"b", 3}, {"c", 4}},
+			want:         D{{"a", 1}, {"b", 3}, {"c", 4}},
+			wantDeep:     D{{"a", 1}, {"b", 3}, {"c", 4}},
+			wantCoalesce: D{{"a", 1}, {"b", 3}, {"c", 4}},
+		},
+		{
+			name:         "nested documents",
+			base:         D{{"a", D{{"x", 1}, {"y", 2}}}},
+			override:     D{{"a", D{{"y", 3}, {"z", 4}}}},
+			want:         D{{"a", D{{"y", 3}, {"z", 4}}}},
+			wantDeep:     D{{"a", D{{"x", 1}, {"y", 3}, {"z", 4}}}},
+			wantCoalesce: D{{"a", D{{"y", 3}, {"z", 4}}}},
+		},
+		{
+			name:         "nested document replaced by scalar",
+			base:         D{{"a", D{{"x", 1}}}},
+			override:     D{{"a", 5}},
+			want:         D{{"a", 5}},
+			wantDeep:     D{{"a", 5}},
+			wantCoalesce: D{{"a", 5}},
+		},
+		{
+			name:         "scalar replaced by nested document",
+			base:         D{{"a", 5}},
+			override:     D{{"a", D{{"x", 1}}}},
+			want:         D{{"a", D{{"x", 1}}}},
+			wantDeep:     D{{"a", D{{"x", 1}}}},
+			wantCoalesce: D{{"a", D{{"x", 1}}}},
+		},
+		{
+			name:         "nil and zero overrides",
+			base:         D{{"a", 1}, {"b", "x"}, {"c", oid}},
+			override:     D{{"a", 0}, {"b", nil}, {"c", NilObjectID}, {"d", nil}},
+			want:         D{{"a", 0}, {"b", nil}, {"c", NilObjectID}, {"d", nil}},
+			wantDeep:     D{{"a", 0}, {"b", nil}, {"c", NilObjectID}, {"d", nil}},
+			wantCoalesce: D{{"a", 1}, {"b", "x"}, {"c", oid}, {"d", nil}},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // Capture range variable.
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			baseCopy := append(D(nil), tc.base...)
+			overrideCopy := append(D(nil), tc.override...)
+
+			got := MergeDocuments(tc.base, tc.override)
+			assert.Equal(t, tc.want, got, "expected MergeDocuments result %v, got %v", tc.want, got)
+
+			got = MergeDocumentsDeep(tc.base, tc.override)
+			assert.Equal(t, tc.wantDeep, got, "expected MergeDocumentsDeep result %v, got %v", tc.wantDeep, got)
+
+			got = MergeDocumentsCoalesce(tc.base, tc.override)
+			assert.Equal(t, tc.wantCoalesce, got, "expected MergeDocumentsCoalesce result %v, got %v", tc.wantCoalesce, got)
+
+			assert.Equal(t, baseCopy, tc.base, "expected base to be unmodified")
+			assert.Equal(t, overrideCopy, tc.override, "expected override to be unmodified")
+		})
+	}
+}
+
+func TestD_ToRawValueMap(t *testing.T) {
+	t.Parallel()
+
+	d := D{{"name", "alice"}, {"age", int32(30)}, {"address", D{{"city", "NYC"}}}, {"age", int32(99)}}
+
+	m, err := d.ToRawValueMap()
+	require.NoError(t, err, "ToRawValueMap error")
+	assert.Len(t, m, 3, "expected 3 keys, got %d", len(m))
+	assert.Equal(t, "alice", m["name"].StringValue(), "expected name %q, got %q", "alice", m["name"].StringValue())
+	// Duplicate keys resolve to the first element.
+	assert.Equal(t, int32(30), m["age"].Int32(), "expected age %v, got %v", 30, m["age"].Int32())
+	city := m["address"].Document().Lookup("city").StringValue()
+	assert.Equal(t, "NYC", city, "expected city %q, got %q", "NYC", city)
+
+	_, err = D{{"bad", make(chan int)}}.ToRawValueMap()
+	assert.Error(t, err, "expected ToRawValueMap error for an unmarshalable value")
+}
+
+func TestD_GetAs(t *testing.T) {
+	t.Parallel()
+
+	d := D{{"name", "alice"}, {"age", int32(30)}, {"address", D{{"city", "NYC"}, {"zip", "10001"}}}}
+
+	t.Run("scalar", func(t *testing.T) {
+		t.Parallel()
+
+		var age int64
+		err := d.GetAs("age", &age)
+		require.NoError(t, err, "GetAs error")
+		assert.Equal(t, int64(30), age, "expected age %v, got %v", 30, age)
+	})
+	t.Run("nested document", func(t *testing.T) {
+		t.Parallel()
+
+		var address D
+		err := d.GetAs("address", &address)
+		require.NoError(t, err, "GetAs error")
+		want := D{{"city", "NYC"}, {"zip", "10001"}}
+		assert.Equal(t, want, address, "expected address %v, got %v", want, address)
+	})
+	t.Run("missing key", func(t *testing.T) {
+		t.Parallel()
+
+		var v string
+		err := d.GetAs("missing", &v)
+		assert.ErrorIs(t, err, bsoncore.ErrElementNotFound, "expected error %v, got %v", bsoncore.ErrElementNotFound, err)
+	})
+	t.Run("type mismatch", func(t *testing.T) {
+		t.Parallel()
+
+		var v int32
+		err := d.GetAs("name", &v)
+		require.Error(t, err, "expected GetAs error")
+		assert.False(t, errors.Is(err, bsoncore.ErrElementNotFound), "expected a decoding error, got %v", err)
+	})
+}
+
 func TestDStringer(t *testing.T) {
 	got := D{{"a", 1}, {"b", 2}}.String()
 	want := `{"a":{"$numberInt":"1"},"b":{"$numberInt":"2"}}`
@@ -7,9 +7,11 @@
 package bson
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 )
 
 var (
@@ -87,9 +89,25 @@ type EncodeContext struct {
 	nilMapAsEmpty           bool
 	nilSliceAsEmpty         bool
 	nilByteSliceAsEmpty     bool
+	ipAsString              bool
 	omitZeroStruct          bool
 	omitEmpty               bool
 	useJSONStructTags       bool
+
+	// durationUnit, if positive, overrides the unit used by DurationCodec to encode time.Duration
+	// values as BSON numbers.
+	durationUnit     time.Duration
+	durationAsString bool
+
+	// squashConflict determines how StructCodec resolves keys of an inline map or bson.D that
+	// collide with struct field names.
+	squashConflict SquashConflict
+
+	// cancel, if non-nil, is used to periodically check whether encoding should be aborted.
+	cancel *cancelCheck
+
+	// hook, if non-nil, is called for each field of the documents being encoded.
+	hook EncoderHook
 }
 
 // DecodeContext is the contextual information required for a Codec to decode a
@@ -118,6 +136,61 @@ type DecodeContext struct {
 	useLocalTimeZone  bool
 	zeroMaps          bool
 	zeroStructs       bool
+
+	// durationUnit, if positive, overrides the unit used by DurationCodec to interpret BSON numbers
+	// decoded into time.Duration values.
+	durationUnit time.Duration
+
+	// requireAllFields, if true, causes struct decoding to return an error if a struct field that is
+	// neither a pointer nor marked "omitempty" has no corresponding element in the BSON document.
+	requireAllFields bool
+
+	// cancel, if non-nil, is used to periodically check whether decoding should be aborted.
+	cancel *cancelCheck
+}
+
+// cancelCheckInterval is the number of document or array elements that are encoded or decoded
+// between checks of the context set on an Encoder or Decoder.
+const cancelCheckInterval = 1024
+
+// cancelCheck tracks the number of elements processed since the context was last checked. A nil
+// *cancelCheck never reports cancellation, so codecs can call tick unconditionally.
+type cancelCheck struct {
+	ctx   context.Context
+	count int
+}
+
+// newCancelCheck returns a cancelCheck for ctx, or nil if ctx can never be canceled.
+func newCancelCheck(ctx context.Context) *cancelCheck {
+	if ctx == nil || ctx.Done() == nil {
+		return nil
+	}
+	return &cancelCheck{ctx: ctx}
+}
+
+// err returns a wrapped context error if the context is done.
+func (cc *cancelCheck) err() error {
+	if cc == nil {
+		return nil
+	}
+	if err := cc.ctx.Err(); err != nil {
+		return fmt.Errorf("bson: operation aborted: %w", err)
+	}
+	return nil
+}
+
+// tick records that an element has been processed and checks the context every
+// cancelCheckInterval elements.
+func (cc *cancelCheck) tick() error {
+	if cc == nil {
+		return nil
+	}
+	cc.count++
+	if cc.count < cancelCheckInterval {
+		return nil
+	}
+	cc.count = 0
+	return cc.err()
 }
 
 // ValueEncoder is the interface implemented by types that can encode a provided Go type to BSON.
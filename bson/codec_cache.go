@@ -7,6 +7,7 @@
 package bson
 
 import (
+	"container/list"
 	"reflect"
 	"sync"
 	"sync/atomic"
@@ -164,3 +165,105 @@ func (c *kindDecoderCache) Clone() *kindDecoderCache {
 	}
 	return cc
 }
+
+// structDescriptionCache caches struct descriptions by type. The zero value is an unbounded cache
+// backed by a sync.Map, so hits do not take a lock. If a limit is set, the cache is an LRU cache
+// guarded by a mutex that evicts the least recently used description once the limit is exceeded.
+type structDescriptionCache struct {
+	cache sync.Map // map[reflect.Type]*structDescription, used if limit is 0
+
+	// limit should only be changed with setLimit, which must not be called concurrently with any
+	// other method.
+	limit int
+
+	mu        sync.Mutex
+	lru       *list.List // of *structDescriptionCacheEntry, most recently used first
+	entries   map[reflect.Type]*list.Element
+	evictions uint64
+}
+
+type structDescriptionCacheEntry struct {
+	t  reflect.Type
+	sd *structDescription
+}
+
+// setLimit clears the cache and sets the maximum number of entries it holds. A limit of 0 or less
+// means the cache is unbounded.
+func (c *structDescriptionCache) setLimit(limit int) {
+	if limit < 0 {
+		limit = 0
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.cache.Range(func(k, _ interface{}) bool {
+		c.cache.Delete(k)
+		return true
+	})
+	c.limit = limit
+	c.lru = list.New()
+	c.entries = make(map[reflect.Type]*list.Element)
+	c.evictions = 0
+}
+
+func (c *structDescriptionCache) Load(rt reflect.Type) (*structDescription, bool) {
+	if c.limit == 0 {
+		if v, _ := c.cache.Load(rt); v != nil {
+			return v.(*structDescription), true
+		}
+		return nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[rt]
+	if !ok {
+		return nil, false
+	}
+	c.lru.MoveToFront(elem)
+	return elem.Value.(*structDescriptionCacheEntry).sd, true
+}
+
+func (c *structDescriptionCache) LoadOrStore(rt reflect.Type, sd *structDescription) *structDescription {
+	if c.limit == 0 {
+		if v, loaded := c.cache.LoadOrStore(rt, sd); loaded {
+			sd = v.(*structDescription)
+		}
+		return sd
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[rt]; ok {
+		c.lru.MoveToFront(elem)
+		return elem.Value.(*structDescriptionCacheEntry).sd
+	}
+
+	c.entries[rt] = c.lru.PushFront(&structDescriptionCacheEntry{t: rt, sd: sd})
+	for c.lru.Len() > c.limit {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*structDescriptionCacheEntry).t)
+		c.evictions++
+	}
+	return sd
+}
+
+// stats returns the number of cached descriptions and the number of evictions since the limit was
+// last set.
+func (c *structDescriptionCache) stats() (entries int, evictions uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.limit == 0 {
+		c.cache.Range(func(_, _ interface{}) bool {
+			entries++
+			return true
+		})
+		return entries, 0
+	}
+	return c.lru.Len(), c.evictions
+}
@@ -174,3 +174,75 @@ func BenchmarkEncoderCacheStore(b *testing.B) {
 		}
 	})
 }
+
+func TestStructDescriptionCache(t *testing.T) {
+	typs := codecCacheTestTypes[:4]
+	descs := make([]*structDescription, len(typs))
+	for i := range descs {
+		descs[i] = new(structDescription)
+	}
+
+	t.Run("unbounded", func(t *testing.T) {
+		var c structDescriptionCache
+		for i, rt := range typs {
+			if got := c.LoadOrStore(rt, descs[i]); got != descs[i] {
+				t.Errorf("LoadOrStore(%s) = %p; want: %p", rt, got, descs[i])
+			}
+		}
+		if got := c.LoadOrStore(typs[0], new(structDescription)); got != descs[0] {
+			t.Errorf("LoadOrStore(%s) = %p; want existing: %p", typs[0], got, descs[0])
+		}
+		if entries, evictions := c.stats(); entries != len(typs) || evictions != 0 {
+			t.Errorf("stats() = %d, %d; want: %d, %d", entries, evictions, len(typs), 0)
+		}
+	})
+	t.Run("bounded", func(t *testing.T) {
+		var c structDescriptionCache
+		c.setLimit(2)
+
+		c.LoadOrStore(typs[0], descs[0])
+		c.LoadOrStore(typs[1], descs[1])
+		// Use typs[0] so typs[1] is the least recently used entry.
+		if got, ok := c.Load(typs[0]); !ok || got != descs[0] {
+			t.Errorf("Load(%s) = %p, %t; want: %p, %t", typs[0], got, ok, descs[0], true)
+		}
+		c.LoadOrStore(typs[2], descs[2])
+
+		if _, ok := c.Load(typs[1]); ok {
+			t.Errorf("Load(%s) found an entry; want it to be evicted", typs[1])
+		}
+		for _, i := range []int{0, 2} {
+			if got, ok := c.Load(typs[i]); !ok || got != descs[i] {
+				t.Errorf("Load(%s) = %p, %t; want: %p, %t", typs[i], got, ok, descs[i], true)
+			}
+		}
+		if entries, evictions := c.stats(); entries != 2 || evictions != 1 {
+			t.Errorf("stats() = %d, %d; want: %d, %d", entries, evictions, 2, 1)
+		}
+
+		// Resetting the limit clears the cache.
+		c.setLimit(0)
+		if _, ok := c.Load(typs[0]); ok {
+			t.Errorf("Load(%s) found an entry after setLimit; want none", typs[0])
+		}
+	})
+}
+
+func BenchmarkStructDescriptionCacheLoad(b *testing.B) {
+	for _, limit := range []int{0, len(codecCacheTestTypes)} {
+		b.Run("limit="+strconv.Itoa(limit), func(b *testing.B) {
+			var c structDescriptionCache
+			c.setLimit(limit)
+			typs := codecCacheTestTypes
+			for _, t := range typs {
+				c.LoadOrStore(t, new(structDescription))
+			}
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				for i := 0; pb.Next(); i++ {
+					c.Load(typs[i%len(typs)])
+				}
+			})
+		})
+	}
+}
@@ -7,10 +7,12 @@
 package bson
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"reflect"
 	"sync"
+	"time"
 )
 
 // ErrDecodeToNil is the error returned when trying to decode to a nil value
@@ -45,6 +47,10 @@ func NewDecoder(vr ValueReader) *Decoder {
 //
 // See [Unmarshal] for details about BSON unmarshaling behavior.
 func (d *Decoder) Decode(val interface{}) error {
+	if err := d.dc.cancel.err(); err != nil {
+		return err
+	}
+
 	if unmarshaler, ok := val.(Unmarshaler); ok {
 		// TODO(skriptble): Reuse a []byte here and use the AppendDocumentBytes method.
 		buf, err := copyDocumentToBytes(d.vr)
@@ -76,12 +82,52 @@ func (d *Decoder) Decode(val interface{}) error {
 	return decoder.DecodeValue(d.dc, d.vr, rval)
 }
 
+// DecodeMap reads the next BSON document from the stream and decodes it into the map pointed to by
+// m, allocating a new map if *m is nil. Values are decoded into their native Go representation
+// (e.g. a BSON int32 is decoded as an int32, not an int64) and nested documents are decoded as
+// map[string]interface{}.
+//
+// DecodeMap is intended for processing documents with a dynamic schema. See [DecodeMapAs] to decode
+// each value into a specific type.
+func (d *Decoder) DecodeMap(m *map[string]interface{}) error {
+	return DecodeMapAs(d, m)
+}
+
+// DecodeMapAs reads the next BSON document from d and decodes each of its values into V, storing the
+// results in the map pointed to by m. A new map is allocated if *m is nil. Values are decoded using
+// the Decoder's registry, so an error is returned if a value cannot be decoded into V.
+//
+// If V is interface{}, nested documents are decoded as map[string]interface{}.
+func DecodeMapAs[V any](d *Decoder, m *map[string]V) error {
+	if m == nil {
+		return ErrDecodeToNil
+	}
+
+	dc := d.dc
+	dc.defaultDocumentType = tMapStringInterface
+
+	rval := reflect.ValueOf(m).Elem()
+	decoder, err := dc.LookupDecoder(rval.Type())
+	if err != nil {
+		return err
+	}
+
+	return decoder.DecodeValue(dc, d.vr, rval)
+}
+
 // Reset will reset the state of the decoder, using the same *DecodeContext used in
 // the original construction but using vr for reading.
 func (d *Decoder) Reset(vr ValueReader) {
 	d.vr = vr
 }
 
+// SetContext causes the Decoder to periodically check ctx while decoding documents and arrays and
+// to abort with an error wrapping ctx.Err() once ctx is done. Passing a nil context disables the
+// checks.
+func (d *Decoder) SetContext(ctx context.Context) {
+	d.dc.cancel = newCancelCheck(ctx)
+}
+
 // SetRegistry replaces the current registry of the decoder with r.
 func (d *Decoder) SetRegistry(r *Registry) {
 	d.dc.Registry = r
@@ -123,6 +169,13 @@ func (d *Decoder) UseLocalTimeZone() {
 	d.dc.useLocalTimeZone = true
 }
 
+// DurationUnit causes the Decoder to interpret BSON numbers unmarshaled into time.Duration values
+// as counts of the given unit (e.g. time.Millisecond) instead of nanoseconds. BSON strings are
+// always parsed with time.ParseDuration.
+func (d *Decoder) DurationUnit(unit time.Duration) {
+	d.dc.durationUnit = unit
+}
+
 // ZeroMaps causes the Decoder to delete any existing values from Go maps in the destination value
 // passed to Decode before unmarshaling BSON documents into them.
 func (d *Decoder) ZeroMaps() {
@@ -134,3 +187,9 @@ func (d *Decoder) ZeroMaps() {
 func (d *Decoder) ZeroStructs() {
 	d.dc.zeroStructs = true
 }
+
+// RequireAllFields causes the Decoder to return an error when unmarshaling into a Go struct if a
+// struct field that is neither a pointer nor marked "omitempty" has no corresponding BSON element.
+func (d *Decoder) RequireAllFields() {
+	d.dc.requireAllFields = true
+}
@@ -8,6 +8,7 @@ package bson
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"reflect"
 	"testing"
@@ -451,6 +452,13 @@ func TestDecoderConfiguration(t *testing.T) {
 		MyInt    int
 	}
 
+	type requireAllFieldsTest struct {
+		MyString   string
+		MyInt      int
+		MyOptional string `bson:",omitempty"`
+		MyPointer  *int
+	}
+
 	testCases := []struct {
 		description string
 		configure   func(*Decoder)
@@ -609,6 +617,22 @@ func TestDecoderConfiguration(t *testing.T) {
 			},
 			want: &zeroStructsTest{MyString: "test value"},
 		},
+		// Test that RequireAllFields does not return an error when every required struct field has a
+		// corresponding BSON element, even if "omitempty" and pointer fields are missing.
+		{
+			description: "RequireAllFields",
+			configure: func(dec *Decoder) {
+				dec.RequireAllFields()
+			},
+			input: bsoncore.NewDocumentBuilder().
+				AppendString("mystring", "test value").
+				AppendInt32("myint", 1).
+				Build(),
+			decodeInto: func() interface{} {
+				return &requireAllFieldsTest{}
+			},
+			want: &requireAllFieldsTest{MyString: "test value", MyInt: 1},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -650,6 +674,33 @@ func TestDecoderConfiguration(t *testing.T) {
 		const want = "error decoding key id: decoding an object ID into a string is not supported by default (set Decoder.ObjectIDAsHexString to enable decoding as a hexadecimal string)"
 		assert.EqualError(t, err, want)
 	})
+	t.Run("RequireAllFields missing field", func(t *testing.T) {
+		t.Parallel()
+
+		type requireAllFieldsTest struct {
+			MyString string
+			MyInt    int
+		}
+
+		doc := bsoncore.NewDocumentBuilder().
+			AppendString("mystring", "test value").
+			Build()
+
+		// Missing fields are left as zero values by default.
+		var got requireAllFieldsTest
+		err := NewDecoder(NewDocumentReader(bytes.NewReader(doc))).Decode(&got)
+		require.NoError(t, err, "Decode error")
+		assert.Equal(t, requireAllFieldsTest{MyString: "test value"}, got, "expected and actual decode results do not match")
+
+		dec := NewDecoder(NewDocumentReader(bytes.NewReader(doc)))
+		dec.RequireAllFields()
+
+		err = dec.Decode(&requireAllFieldsTest{})
+		var de *DecodeError
+		require.True(t, errors.As(err, &de), "expected DecodeError, got %v", err)
+		assert.Equal(t, []string{"myint"}, de.Keys(), "expected error keys to match")
+		assert.ErrorIs(t, err, errMissingField)
+	})
 	t.Run("DefaultDocumentM top-level", func(t *testing.T) {
 		t.Parallel()
 
@@ -697,3 +748,134 @@ func TestDecoderConfiguration(t *testing.T) {
 		assert.Equal(t, want, got, "expected and actual decode results do not match")
 	})
 }
+
+func TestDecoder_DecodeMap(t *testing.T) {
+	t.Parallel()
+
+	oid := NewObjectIDFromTimestamp(time.Unix(1700000000, 0))
+	doc := D{
+		{"double", 3.14},
+		{"string", "foo"},
+		{"document", D{{"x", int32(1)}, {"nested", D{{"y", "bar"}}}}},
+		{"array", A{int32(1), D{{"z", int64(2)}}}},
+		{"binary", Binary{Subtype: 0x80, Data: []byte{0x01, 0x02}}},
+		{"undefined", Undefined{}},
+		{"objectID", oid},
+		{"boolean", true},
+		{"dateTime", DateTime(1700000000000)},
+		{"null", nil},
+		{"regex", Regex{Pattern: "^foo", Options: "i"}},
+		{"dbPointer", DBPointer{DB: "db.coll", Pointer: oid}},
+		{"javaScript", JavaScript("function() {}")},
+		{"symbol", Symbol("sym")},
+		{"int32", int32(42)},
+		{"timestamp", Timestamp{T: 1, I: 2}},
+		{"int64", int64(42)},
+		{"decimal128", NewDecimal128(1, 2)},
+		{"minKey", MinKey{}},
+		{"maxKey", MaxKey{}},
+	}
+	data, err := Marshal(doc)
+	require.NoError(t, err, "Marshal error")
+
+	t.Run("native types", func(t *testing.T) {
+		t.Parallel()
+
+		want := map[string]interface{}{
+			"double":     3.14,
+			"string":     "foo",
+			"document":   map[string]interface{}{"x": int32(1), "nested": map[string]interface{}{"y": "bar"}},
+			"array":      A{int32(1), map[string]interface{}{"z": int64(2)}},
+			"binary":     Binary{Subtype: 0x80, Data: []byte{0x01, 0x02}},
+			"undefined":  Undefined{},
+			"objectID":   oid,
+			"boolean":    true,
+			"dateTime":   DateTime(1700000000000),
+			"null":       nil,
+			"regex":      Regex{Pattern: "^foo", Options: "i"},
+			"dbPointer":  DBPointer{DB: "db.coll", Pointer: oid},
+			"javaScript": JavaScript("function() {}"),
+			"symbol":     Symbol("sym"),
+			"int32":      int32(42),
+			"timestamp":  Timestamp{T: 1, I: 2},
+			"int64":      int64(42),
+			"decimal128": NewDecimal128(1, 2),
+			"minKey":     MinKey{},
+			"maxKey":     MaxKey{},
+		}
+
+		var got map[string]interface{}
+		err := NewDecoder(NewDocumentReader(bytes.NewReader(data))).DecodeMap(&got)
+		require.NoError(t, err, "DecodeMap error")
+		assert.Equal(t, want, got, "expected map %v, got %v", want, got)
+	})
+	t.Run("DefaultDocumentM does not apply", func(t *testing.T) {
+		t.Parallel()
+
+		dec := NewDecoder(NewDocumentReader(bytes.NewReader(data)))
+		dec.DefaultDocumentM()
+
+		var got map[string]interface{}
+		err := dec.DecodeMap(&got)
+		require.NoError(t, err, "DecodeMap error")
+		assert.IsType(t, map[string]interface{}{}, got["document"], "expected nested document type")
+	})
+	t.Run("nil pointer", func(t *testing.T) {
+		t.Parallel()
+
+		err := NewDecoder(NewDocumentReader(bytes.NewReader(data))).DecodeMap(nil)
+		assert.Equal(t, ErrDecodeToNil, err, "expected error %v, got %v", ErrDecodeToNil, err)
+	})
+	t.Run("DecodeMapAs", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := Marshal(D{{"a", int32(1)}, {"b", int64(2)}, {"c", 3.0}})
+		require.NoError(t, err, "Marshal error")
+
+		var got map[string]int64
+		err = DecodeMapAs(NewDecoder(NewDocumentReader(bytes.NewReader(data))), &got)
+		require.NoError(t, err, "DecodeMapAs error")
+		want := map[string]int64{"a": 1, "b": 2, "c": 3}
+		assert.Equal(t, want, got, "expected map %v, got %v", want, got)
+
+		data, err = Marshal(D{{"a", "foo"}})
+		require.NoError(t, err, "Marshal error")
+		err = DecodeMapAs(NewDecoder(NewDocumentReader(bytes.NewReader(data))), &got)
+		assert.Error(t, err, "expected error decoding a string into an int64")
+	})
+}
+
+func TestDecoder_SetContext(t *testing.T) {
+	type document struct {
+		Values []cancelingInt
+	}
+	data, err := Marshal(D{{"values", make([]int64, 1<<20)}})
+	require.NoError(t, err, "Marshal error")
+
+	t.Run("canceled mid-decode", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var calls int
+		dec := NewDecoder(NewDocumentReader(bytes.NewReader(data)))
+		dec.SetRegistry(newCancelingRegistry(cancel, 1000, &calls))
+		dec.SetContext(ctx)
+
+		var got document
+		err := dec.Decode(&got)
+		assert.ErrorIs(t, err, context.Canceled, "expected error %v, got %v", context.Canceled, err)
+		assert.LessOrEqual(t, calls, 1000+cancelCheckInterval,
+			"expected decoding to stop within %d values of cancellation, decoded %d", cancelCheckInterval, calls)
+	})
+	t.Run("nil context", func(t *testing.T) {
+		var calls int
+		dec := NewDecoder(NewDocumentReader(bytes.NewReader(data)))
+		dec.SetRegistry(newCancelingRegistry(func() {}, -1, &calls))
+		dec.SetContext(nil) //nolint:staticcheck // Verify that a nil context disables the checks.
+
+		var got document
+		err := dec.Decode(&got)
+		require.NoError(t, err, "Decode error")
+		assert.Len(t, got.Values, 1<<20, "expected %d values, got %d", 1<<20, len(got.Values))
+	})
+}
@@ -14,6 +14,7 @@ import (
 	"net/url"
 	"reflect"
 	"strconv"
+	"strings"
 
 	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
 )
@@ -54,15 +55,23 @@ func registerDefaultDecoders(reg *Registry) {
 	reg.RegisterTypeDecoder(tJavaScript, decodeAdapter{javaScriptDecodeValue, javaScriptDecodeType})
 	reg.RegisterTypeDecoder(tSymbol, decodeAdapter{symbolDecodeValue, symbolDecodeType})
 	reg.RegisterTypeDecoder(tByteSlice, &byteSliceCodec{})
+	reg.RegisterTypeDecoder(tLittleEndianBytes, &BinaryCodec{Subtype: TypeBinaryLittleEndian})
+	reg.RegisterTypeDecoder(tBigEndianBytes, &BinaryCodec{Subtype: TypeBinaryBigEndian})
 	reg.RegisterTypeDecoder(tTime, &timeCodec{})
+	reg.RegisterTypeDecoder(tDuration, &DurationCodec{})
 	reg.RegisterTypeDecoder(tEmpty, &emptyInterfaceCodec{})
 	reg.RegisterTypeDecoder(tCoreArray, &arrayCodec{})
 	reg.RegisterTypeDecoder(tOID, decodeAdapter{objectIDDecodeValue, objectIDDecodeType})
 	reg.RegisterTypeDecoder(tDecimal, decodeAdapter{decimal128DecodeValue, decimal128DecodeType})
 	reg.RegisterTypeDecoder(tJSONNumber, decodeAdapter{jsonNumberDecodeValue, jsonNumberDecodeType})
 	reg.RegisterTypeDecoder(tURL, decodeAdapter{urlDecodeValue, urlDecodeType})
+	reg.RegisterTypeDecoder(tIP, ValueDecoderFunc(ipDecodeValue))
+	reg.RegisterTypeDecoder(tIPNet, ValueDecoderFunc(ipNetDecodeValue))
 	reg.RegisterTypeDecoder(tCoreDocument, ValueDecoderFunc(coreDocumentDecodeValue))
 	reg.RegisterTypeDecoder(tCodeWithScope, decodeAdapter{codeWithScopeDecodeValue, codeWithScopeDecodeType})
+	for _, t := range sqlNullTypes {
+		reg.RegisterTypeDecoder(t, &sqlNullCodec{t: t})
+	}
 	reg.RegisterKindDecoder(reflect.Bool, decodeAdapter{booleanDecodeValue, booleanDecodeType})
 	reg.RegisterKindDecoder(reflect.Int, intDecoder)
 	reg.RegisterKindDecoder(reflect.Int8, intDecoder)
@@ -143,6 +152,10 @@ func dDecodeValue(dc DecodeContext, vr ValueReader, val reflect.Value) error {
 	}
 
 	for {
+		if err := dc.cancel.tick(); err != nil {
+			return err
+		}
+
 		key, elemVr, err := dr.ReadElement()
 		if errors.Is(err, ErrEOD) {
 			break
@@ -1342,6 +1355,10 @@ func decodeDefault(dc DecodeContext, vr ValueReader, val reflect.Value) ([]refle
 
 	idx := 0
 	for {
+		if err := dc.cancel.tick(); err != nil {
+			return nil, err
+		}
+
 		vr, err := ar.ReadValue()
 		if errors.Is(err, ErrEOA) {
 			break
@@ -1468,6 +1485,44 @@ func decodeD(dc DecodeContext, vr ValueReader, _ reflect.Value) ([]reflect.Value
 	return decodeElemsFromDocumentReader(dc, dr)
 }
 
+// decodeLowercaseFields decodes a document into the struct val by matching each key to the
+// lowercased name of a field, the names the struct codec uses for fields without a bson tag. Each
+// field is decoded with the decoder registered for its type, and unknown keys are skipped.
+func decodeLowercaseFields(dc DecodeContext, vr ValueReader, val reflect.Value) error {
+	dr, err := vr.ReadDocument()
+	if err != nil {
+		return err
+	}
+
+	for {
+		key, elemVr, err := dr.ReadElement()
+		if errors.Is(err, ErrEOD) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		field := val.FieldByNameFunc(func(name string) bool {
+			return strings.ToLower(name) == key
+		})
+		if !field.IsValid() {
+			if err := elemVr.Skip(); err != nil {
+				return err
+			}
+			continue
+		}
+
+		decoder, err := dc.LookupDecoder(field.Type())
+		if err != nil {
+			return err
+		}
+		if err := decoder.DecodeValue(dc, elemVr, field); err != nil {
+			return newDecodeError(key, err)
+		}
+	}
+}
+
 func decodeElemsFromDocumentReader(dc DecodeContext, dr DocumentReader) ([]reflect.Value, error) {
 	decoder, err := dc.LookupDecoder(tEmpty)
 	if err != nil {
@@ -1476,6 +1531,10 @@ func decodeElemsFromDocumentReader(dc DecodeContext, dr DocumentReader) ([]refle
 
 	elems := make([]reflect.Value, 0)
 	for {
+		if err := dc.cancel.tick(); err != nil {
+			return nil, err
+		}
+
 		key, vr, err := dr.ReadElement()
 		if errors.Is(err, ErrEOD) {
 			break
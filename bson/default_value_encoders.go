@@ -33,6 +33,14 @@ var sliceWriterPool = sync.Pool{
 }
 
 func encodeElement(ec EncodeContext, dw DocumentWriter, e E) error {
+	if err := ec.cancel.tick(); err != nil {
+		return err
+	}
+
+	if ec.hook != nil {
+		return encodeHookedElement(ec, dw, e.Key, nil, reflect.ValueOf(e.Value))
+	}
+
 	vw, err := dw.WriteDocumentElement(e.Key)
 	if err != nil {
 		return err
@@ -60,13 +68,18 @@ func registerDefaultEncoders(reg *Registry) {
 	uintCodec := &uintCodec{}
 
 	reg.RegisterTypeEncoder(tByteSlice, &byteSliceCodec{})
+	reg.RegisterTypeEncoder(tLittleEndianBytes, &BinaryCodec{Subtype: TypeBinaryLittleEndian})
+	reg.RegisterTypeEncoder(tBigEndianBytes, &BinaryCodec{Subtype: TypeBinaryBigEndian})
 	reg.RegisterTypeEncoder(tTime, &timeCodec{})
+	reg.RegisterTypeEncoder(tDuration, &DurationCodec{})
 	reg.RegisterTypeEncoder(tEmpty, &emptyInterfaceCodec{})
 	reg.RegisterTypeEncoder(tCoreArray, &arrayCodec{})
 	reg.RegisterTypeEncoder(tOID, ValueEncoderFunc(objectIDEncodeValue))
 	reg.RegisterTypeEncoder(tDecimal, ValueEncoderFunc(decimal128EncodeValue))
 	reg.RegisterTypeEncoder(tJSONNumber, ValueEncoderFunc(jsonNumberEncodeValue))
 	reg.RegisterTypeEncoder(tURL, ValueEncoderFunc(urlEncodeValue))
+	reg.RegisterTypeEncoder(tIP, ValueEncoderFunc(ipEncodeValue))
+	reg.RegisterTypeEncoder(tIPNet, ValueEncoderFunc(ipNetEncodeValue))
 	reg.RegisterTypeEncoder(tJavaScript, ValueEncoderFunc(javaScriptEncodeValue))
 	reg.RegisterTypeEncoder(tSymbol, ValueEncoderFunc(symbolEncodeValue))
 	reg.RegisterTypeEncoder(tBinary, ValueEncoderFunc(binaryEncodeValue))
@@ -81,6 +94,9 @@ func registerDefaultEncoders(reg *Registry) {
 	reg.RegisterTypeEncoder(tMaxKey, ValueEncoderFunc(maxKeyEncodeValue))
 	reg.RegisterTypeEncoder(tCoreDocument, ValueEncoderFunc(coreDocumentEncodeValue))
 	reg.RegisterTypeEncoder(tCodeWithScope, ValueEncoderFunc(codeWithScopeEncodeValue))
+	for _, t := range sqlNullTypes {
+		reg.RegisterTypeEncoder(t, &sqlNullCodec{t: t})
+	}
 	reg.RegisterKindEncoder(reflect.Bool, ValueEncoderFunc(booleanEncodeValue))
 	reg.RegisterKindEncoder(reflect.Int, ValueEncoderFunc(intEncodeValue))
 	reg.RegisterKindEncoder(reflect.Int8, ValueEncoderFunc(intEncodeValue))
@@ -242,6 +258,10 @@ func arrayEncodeValue(ec EncodeContext, vw ValueWriter, val reflect.Value) error
 	}
 
 	for idx := 0; idx < val.Len(); idx++ {
+		if err := ec.cancel.tick(); err != nil {
+			return err
+		}
+
 		currEncoder, currVal, lookupErr := lookupElementEncoder(ec, encoder, val.Index(idx))
 		if lookupErr != nil && !errors.Is(lookupErr, errInvalidValue) {
 			return lookupErr
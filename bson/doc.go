@@ -73,6 +73,8 @@
 //  6. uint, uint32, and uint64 marshal to a BSON int64 (unless [Encoder.IntMinSize] is set).
 //  7. BSON null and undefined values will unmarshal into the zero value of a field (e.g. unmarshaling a BSON null or
 //     undefined value into a string will yield the empty string.).
+//  8. The database/sql Null* types (e.g. sql.NullString and sql.NullInt64) marshal to their value if Valid is true
+//     and to BSON null otherwise. BSON null and undefined unmarshal into them with Valid set to false.
 //
 // # Structs
 //
@@ -127,12 +129,39 @@
 //  4. inline: If the inline struct tag is specified for a struct or map field, the field will be "flattened" when
 //     marshaling and "un-flattened" when unmarshaling. This means that all of the fields in that struct/map will be
 //     pulled up one level and will become top-level fields rather than being fields in a nested document. For example,
-//     if a map field named "Map" with value map[string]interface{}{"foo": "bar"} is inlined, the resulting document will
-//     be {"foo": "bar"} instead of {"map": {"foo": "bar"}}. There can only be one inlined map field in a struct. If
-//     there are duplicated fields in the resulting document when an inlined struct is marshaled, the inlined field will
-//     be overwritten. If there are duplicated fields in the resulting document when an inlined map is marshaled, an
-//     error will be returned. This tag can be used with fields that are pointers to structs. If an inlined pointer field
-//     is nil, it will not be marshaled. For fields that are not maps or structs, this tag is ignored.
+//     if a map field named "Map" with value map[string]interface{}{"foo": "bar"} is inlined, the resulting document
+//     will be {"foo": "bar"} instead of {"map": {"foo": "bar"}}. There can only be one inlined map field in a struct.
+//     An inlined map of an inlined struct is marshaled and unmarshaled as if it were inlined in the outer struct.
+//     Earlier versions ignored such nested maps. It is only used if the outer struct has no inlined map of its own, and
+//     is ignored if another inlined struct at the same depth also has one. If there are duplicated fields in the
+//     resulting document when an inlined struct is marshaled, the inlined field will be overwritten. If there are
+//     duplicated fields in the resulting document when an inlined map is marshaled, an error will be returned unless a
+//     different policy is chosen with [Encoder.SquashConflict]. A bson.D field can be inlined like a map: its elements
+//     are marshaled as top-level fields, and unknown fields are appended to it when unmarshaling. The "squash" option
+//     is an alias for inline. This tag can be used with fields that are pointers to structs. If an inlined pointer
+//     field is nil, it will not be marshaled. The tag can also be used with interface fields, such as an embedded
+//     fmt.Stringer. When marshaling, a nil interface is omitted and a non-nil one must hold a struct or struct pointer,
+//     whose fields are inlined; fields of the outer struct take precedence over fields with the same name. When
+//     unmarshaling, the interface must already hold a non-nil struct pointer to decode into; otherwise ErrNilInterface
+//     is returned if the document has a field that is not a field of the outer struct. For fields that are not maps,
+//     bson.D, structs, or interfaces, this tag is ignored.
+//
+//  5. stringenum: If the stringenum struct tag is specified on a field, the field will be marshaled as a BSON string
+//     using its String method and unmarshaled using the parse function registered for the field's type with
+//     [Registry.RegisterStringEnum]. The field's type must implement [fmt.Stringer] and have a registered parse
+//     function, otherwise marshaling and unmarshaling the struct will return an error.
+//
+//  6. timestamp: If the timestamp struct tag is specified on a time.Time field, the field will be marshaled as a BSON
+//     timestamp whose T is the time's Unix seconds and whose increment is zero, instead of as a BSON datetime.
+//     Sub-second precision is discarded, and times before the Unix epoch or after the maximum uint32 second return an
+//     error. When unmarshaling, BSON timestamps are converted back to a time.Time using T as the Unix seconds. The tag
+//     can only be used with time.Time fields.
+//
+//  7. codec=<name>: If the codec struct tag is specified on a field, the field will be marshaled and unmarshaled using
+//     the codec registered under that name with [Registry.RegisterNamedEncoder] and [Registry.RegisterNamedDecoder]
+//     instead of the codec registered for the field's type. If only an encoder or only a decoder is registered under
+//     the name, the type-based lookup is used in the other direction. If nothing is registered under the name,
+//     marshaling and unmarshaling the struct will return an error.
 //
 // # Marshaling and Unmarshaling
 //
@@ -0,0 +1,98 @@
+// Copyright (C) MongoDB, Inc. 2026-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bson
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+)
+
+var tDuration = reflect.TypeOf(time.Duration(0))
+
+// DurationCodec is the Codec used for time.Duration values. By default, durations are encoded as BSON integers
+// counting nanoseconds, which matches how the underlying int64 was encoded by earlier versions. Durations can instead
+// be encoded as integers counting a coarser Unit, or as strings in the format produced by time.Duration.String
+// (e.g. "1m30s").
+//
+// When decoding, BSON strings are parsed with time.ParseDuration and BSON numbers are interpreted as counts of Unit.
+//
+// The Encoder.DurationUnit, Encoder.DurationAsString, and Decoder.DurationUnit methods override the codec's settings
+// for a single Encoder or Decoder.
+type DurationCodec struct {
+	// Unit is the unit of BSON numbers written by EncodeValue and read by DecodeValue, e.g. time.Millisecond.
+	// Durations are truncated to a multiple of Unit when encoding. Zero or negative values mean nanoseconds.
+	Unit time.Duration
+
+	// EncodeToString causes EncodeValue to write durations as BSON strings instead of numbers.
+	EncodeToString bool
+}
+
+var (
+	_ ValueEncoder = &DurationCodec{}
+	_ ValueDecoder = &DurationCodec{}
+)
+
+func (dc *DurationCodec) unit(ctxUnit time.Duration) time.Duration {
+	switch {
+	case ctxUnit > 0:
+		return ctxUnit
+	case dc.Unit > 0:
+		return dc.Unit
+	default:
+		return time.Nanosecond
+	}
+}
+
+// EncodeValue is the ValueEncoder for time.Duration.
+func (dc *DurationCodec) EncodeValue(ec EncodeContext, vw ValueWriter, val reflect.Value) error {
+	if !val.IsValid() || val.Type() != tDuration {
+		return ValueEncoderError{Name: "DurationEncodeValue", Types: []reflect.Type{tDuration}, Received: val}
+	}
+
+	d := time.Duration(val.Int())
+	if dc.EncodeToString || ec.durationAsString {
+		return vw.WriteString(d.String())
+	}
+
+	return intEncodeValue(ec, vw, reflect.ValueOf(int64(d/dc.unit(ec.durationUnit))))
+}
+
+// DecodeValue is the ValueDecoder for time.Duration.
+func (dc *DurationCodec) DecodeValue(dctx DecodeContext, vr ValueReader, val reflect.Value) error {
+	if !val.CanSet() || val.Type() != tDuration {
+		return ValueDecoderError{Name: "DurationDecodeValue", Types: []reflect.Type{tDuration}, Received: val}
+	}
+
+	if vr.Type() == TypeString {
+		str, err := vr.ReadString()
+		if err != nil {
+			return err
+		}
+
+		d, err := time.ParseDuration(str)
+		if err != nil {
+			return err
+		}
+		val.SetInt(int64(d))
+		return nil
+	}
+
+	n, err := intDecodeType(dctx, vr, tInt64)
+	if err != nil {
+		return err
+	}
+
+	i64 := n.Int()
+	unit := int64(dc.unit(dctx.durationUnit))
+	if i64 > math.MaxInt64/unit || i64 < math.MinInt64/unit {
+		return fmt.Errorf("%d units of %v overflows a time.Duration", i64, time.Duration(unit))
+	}
+	val.SetInt(i64 * unit)
+	return nil
+}
@@ -0,0 +1,162 @@
+// Copyright (C) MongoDB, Inc. 2026-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bson
+
+import (
+	"bytes"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+)
+
+type testDurationDoc struct {
+	D time.Duration `bson:"d"`
+}
+
+func TestDurationCodec(t *testing.T) {
+	t.Parallel()
+
+	const d = 90*time.Second + 500*time.Millisecond
+
+	testCases := []struct {
+		name       string
+		configEnc  func(*Encoder)
+		configDec  func(*Decoder)
+		wantType   Type
+		want       interface{}
+		wantDecode time.Duration
+	}{
+		{
+			name:       "nanoseconds",
+			wantType:   TypeInt64,
+			want:       int64(d),
+			wantDecode: d,
+		},
+		{
+			name:       "milliseconds",
+			configEnc:  func(enc *Encoder) { enc.DurationUnit(time.Millisecond) },
+			configDec:  func(dec *Decoder) { dec.DurationUnit(time.Millisecond) },
+			wantType:   TypeInt64,
+			want:       int64(90500),
+			wantDecode: d,
+		},
+		{
+			name:       "seconds truncates",
+			configEnc:  func(enc *Encoder) { enc.DurationUnit(time.Second) },
+			configDec:  func(dec *Decoder) { dec.DurationUnit(time.Second) },
+			wantType:   TypeInt64,
+			want:       int64(90),
+			wantDecode: 90 * time.Second,
+		},
+		{
+			name:       "seconds with IntMinSize",
+			configEnc:  func(enc *Encoder) { enc.DurationUnit(time.Second); enc.IntMinSize() },
+			configDec:  func(dec *Decoder) { dec.DurationUnit(time.Second) },
+			wantType:   TypeInt32,
+			want:       int32(90),
+			wantDecode: 90 * time.Second,
+		},
+		{
+			name:       "string",
+			configEnc:  func(enc *Encoder) { enc.DurationAsString() },
+			wantType:   TypeString,
+			want:       "1m30.5s",
+			wantDecode: d,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc // Capture range variable.
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			buf := new(bytes.Buffer)
+			enc := NewEncoder(NewDocumentWriter(buf))
+			if tc.configEnc != nil {
+				tc.configEnc(enc)
+			}
+			require.NoError(t, enc.Encode(testDurationDoc{D: d}), "Encode error")
+
+			val := Raw(buf.Bytes()).Lookup("d")
+			require.Equal(t, tc.wantType, val.Type, "expected type %v, got %v", tc.wantType, val.Type)
+			var got interface{}
+			switch val.Type {
+			case TypeInt32:
+				got = val.Int32()
+			case TypeInt64:
+				got = val.Int64()
+			case TypeString:
+				got = val.StringValue()
+			}
+			assert.Equal(t, tc.want, got, "expected %v, got %v", tc.want, got)
+
+			dec := NewDecoder(NewDocumentReader(bytes.NewReader(buf.Bytes())))
+			if tc.configDec != nil {
+				tc.configDec(dec)
+			}
+			var doc testDurationDoc
+			require.NoError(t, dec.Decode(&doc), "Decode error")
+			assert.Equal(t, tc.wantDecode, doc.D, "expected %v, got %v", tc.wantDecode, doc.D)
+		})
+	}
+
+	t.Run("codec settings", func(t *testing.T) {
+		t.Parallel()
+
+		codec := &DurationCodec{Unit: time.Millisecond}
+		reg := NewRegistry()
+		reg.RegisterTypeEncoder(reflect.TypeOf(time.Duration(0)), codec)
+		reg.RegisterTypeDecoder(reflect.TypeOf(time.Duration(0)), codec)
+
+		buf := new(bytes.Buffer)
+		enc := NewEncoder(NewDocumentWriter(buf))
+		enc.SetRegistry(reg)
+		require.NoError(t, enc.Encode(testDurationDoc{D: 2 * time.Second}), "Encode error")
+		got := Raw(buf.Bytes()).Lookup("d").Int64()
+		assert.Equal(t, int64(2000), got, "expected %v, got %v", 2000, got)
+
+		var doc testDurationDoc
+		dec := NewDecoder(NewDocumentReader(bytes.NewReader(buf.Bytes())))
+		dec.SetRegistry(reg)
+		require.NoError(t, dec.Decode(&doc), "Decode error")
+		assert.Equal(t, 2*time.Second, doc.D, "expected %v, got %v", 2*time.Second, doc.D)
+	})
+	t.Run("legacy int64", func(t *testing.T) {
+		t.Parallel()
+
+		// Earlier versions encoded time.Duration as its underlying int64 nanoseconds.
+		data, err := Marshal(D{{"d", int64(d)}})
+		require.NoError(t, err, "Marshal error")
+
+		var doc testDurationDoc
+		require.NoError(t, Unmarshal(data, &doc), "Unmarshal error")
+		assert.Equal(t, d, doc.D, "expected %v, got %v", d, doc.D)
+	})
+	t.Run("errors", func(t *testing.T) {
+		t.Parallel()
+
+		for _, val := range []interface{}{"not a duration", 1.5} {
+			data, err := Marshal(D{{"d", val}})
+			require.NoError(t, err, "Marshal error")
+
+			var doc testDurationDoc
+			assert.Error(t, Unmarshal(data, &doc), "expected Unmarshal error for %v", val)
+		}
+
+		data, err := Marshal(D{{"d", int64(math.MaxInt64 / 10)}})
+		require.NoError(t, err, "Marshal error")
+
+		var doc testDurationDoc
+		dec := NewDecoder(NewDocumentReader(bytes.NewReader(data)))
+		dec.DurationUnit(time.Second)
+		assert.Error(t, dec.Decode(&doc), "expected overflow error")
+	})
+}
@@ -7,8 +7,10 @@
 package bson
 
 import (
+	"context"
 	"reflect"
 	"sync"
+	"time"
 )
 
 // This pool is used to keep the allocations of Encoders down. This is only used for the Marshal*
@@ -39,6 +41,10 @@ func NewEncoder(vw ValueWriter) *Encoder {
 //
 // See [Marshal] for details about BSON marshaling behavior.
 func (e *Encoder) Encode(val interface{}) error {
+	if err := e.ec.cancel.err(); err != nil {
+		return err
+	}
+
 	if marshaler, ok := val.(Marshaler); ok {
 		// TODO(skriptble): Should we have a MarshalAppender interface so that we can have []byte reuse?
 		buf, err := marshaler.MarshalBSON()
@@ -56,12 +62,45 @@ func (e *Encoder) Encode(val interface{}) error {
 	return encoder.EncodeValue(e.ec, e.vw, reflect.ValueOf(val))
 }
 
+// EncodeMap writes the BSON encoding of m to the stream as a document. Unlike Encode, a nil map is
+// written as an empty document. The order of the elements in the written document is not
+// guaranteed.
+//
+// EncodeMap is intended for processing documents with a dynamic schema, where the fields are not
+// known ahead of time.
+func (e *Encoder) EncodeMap(m map[string]interface{}) error {
+	if m == nil {
+		m = map[string]interface{}{}
+	}
+
+	encoder, err := e.ec.LookupEncoder(tMapStringInterface)
+	if err != nil {
+		return err
+	}
+
+	return encoder.EncodeValue(e.ec, e.vw, reflect.ValueOf(m))
+}
+
 // Reset will reset the state of the Encoder, using the same *EncodeContext used in
 // the original construction but using vw.
 func (e *Encoder) Reset(vw ValueWriter) {
 	e.vw = vw
 }
 
+// SetContext causes the Encoder to periodically check ctx while encoding documents and arrays and
+// to abort with an error wrapping ctx.Err() once ctx is done. Passing a nil context disables the
+// checks.
+func (e *Encoder) SetContext(ctx context.Context) {
+	e.ec.cancel = newCancelCheck(ctx)
+}
+
+// SetHook causes the Encoder to call h for each field of the documents it encodes from structs,
+// maps, and D values, including the fields of nested documents. Use ChainEncoderHooks to register
+// more than one hook. Passing nil removes the hook.
+func (e *Encoder) SetHook(h EncoderHook) {
+	e.ec.hook = h
+}
+
 // SetRegistry replaces the current registry of the Encoder with r.
 func (e *Encoder) SetRegistry(r *Registry) {
 	e.ec.Registry = r
@@ -104,6 +143,33 @@ func (e *Encoder) NilByteSliceAsEmpty() {
 	e.ec.nilByteSliceAsEmpty = true
 }
 
+// IPAsString causes the Encoder to marshal net.IP values as BSON strings in their textual form and
+// net.IPNet values as BSON strings in CIDR notation instead of as BSON binary values and
+// {ip: <binary>, mask: <binary>} documents.
+func (e *Encoder) IPAsString() {
+	e.ec.ipAsString = true
+}
+
+// DurationUnit causes the Encoder to marshal time.Duration values as BSON integers counting the
+// given unit (e.g. time.Millisecond) instead of nanoseconds. Durations are truncated to a multiple
+// of unit.
+func (e *Encoder) DurationUnit(unit time.Duration) {
+	e.ec.durationUnit = unit
+}
+
+// DurationAsString causes the Encoder to marshal time.Duration values as BSON strings in the format
+// produced by time.Duration.String (e.g. "1m30s") instead of BSON integers.
+func (e *Encoder) DurationAsString() {
+	e.ec.durationAsString = true
+}
+
+// SquashConflict sets how the Encoder resolves keys of a map or bson.D field with the "inline" or
+// "squash" struct tag option that collide with the names of other struct fields. The default,
+// SquashConflictError, returns an error.
+func (e *Encoder) SquashConflict(c SquashConflict) {
+	e.ec.squashConflict = c
+}
+
 // TODO(GODRIVER-2820): Update the description to remove the note about only examining exported
 // TODO struct fields once the logic is updated to also inspect private struct fields.
 
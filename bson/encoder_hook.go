@@ -0,0 +1,132 @@
+// Copyright (C) MongoDB, Inc. 2026-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bson
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// EncoderHook is called by an Encoder for each field of a document that it encodes from a struct,
+// a map, or a D, including the fields of nested documents. See Encoder.SetHook.
+type EncoderHook interface {
+	// BeforeEncodeField is called with the key and value of a field before the value is encoded.
+	// The returned value is encoded in place of val. If it has the same type as val, it is encoded
+	// with the field's encoder, including one selected by a struct tag such as "timestamp" or
+	// "codec=". Returning an error aborts encoding.
+	// BeforeEncodeField must not modify val.
+	BeforeEncodeField(key string, val interface{}) (interface{}, error)
+
+	// AfterEncodeField is called with the key, BSON type, and encoded bytes of a field after its
+	// value is encoded. raw is only valid until AfterEncodeField returns.
+	AfterEncodeField(key string, t Type, raw []byte)
+}
+
+// ChainEncoderHooks returns an EncoderHook that calls each of hooks in order. Each hook's
+// BeforeEncodeField receives the value returned by the previous hook.
+func ChainEncoderHooks(hooks ...EncoderHook) EncoderHook {
+	return encoderHookChain(hooks)
+}
+
+type encoderHookChain []EncoderHook
+
+func (c encoderHookChain) BeforeEncodeField(key string, val interface{}) (interface{}, error) {
+	var err error
+	for _, h := range c {
+		val, err = h.BeforeEncodeField(key, val)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return val, nil
+}
+
+func (c encoderHookChain) AfterEncodeField(key string, t Type, raw []byte) {
+	for _, h := range c {
+		h.AfterEncodeField(key, t, raw)
+	}
+}
+
+// LoggingHook returns an EncoderHook that writes a line with the key and BSON type of each encoded
+// field to w. It does not modify the encoded values. Errors writing to w are ignored.
+func LoggingHook(w io.Writer) EncoderHook {
+	return loggingHook{w: w}
+}
+
+type loggingHook struct {
+	w io.Writer
+}
+
+func (loggingHook) BeforeEncodeField(_ string, val interface{}) (interface{}, error) {
+	return val, nil
+}
+
+func (lh loggingHook) AfterEncodeField(key string, t Type, _ []byte) {
+	_, _ = fmt.Fprintf(lh.w, "%s: %s\n", key, t)
+}
+
+// encodeHookedElement writes the element key to dw, passing the value rv through ec.hook. encoder
+// is the encoder already resolved for rv, e.g. from a struct tag, and is used whenever the hook
+// returns a value of the same type so that a hook never changes the wire format of a field. If
+// encoder is nil or the hook returns a value of a different type, the encoder is looked up in the
+// registry. The value is encoded into a separate buffer so that its bytes can be reported to the
+// hook before they are copied to dw.
+func encodeHookedElement(ec EncodeContext, dw DocumentWriter, key string, encoder ValueEncoder, rv reflect.Value) error {
+	var val interface{}
+	if rv.IsValid() {
+		val = rv.Interface()
+	}
+	val, err := ec.hook.BeforeEncodeField(key, val)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	tw := newDocumentWriter(&buf)
+	vw, err := tw.WriteDocumentElement(key)
+	if err != nil {
+		return err
+	}
+	if val == nil {
+		err = vw.WriteNull()
+	} else {
+		nv := reflect.ValueOf(val)
+		if encoder != nil && rv.IsValid() && nv.Type() == rv.Type() {
+			// Copy the value into an addressable Value, as struct fields are, so that encoders for
+			// pointer receivers behave the same as without a hook.
+			av := reflect.New(nv.Type()).Elem()
+			av.Set(nv)
+			nv = av
+		} else {
+			encoder, err = ec.LookupEncoder(nv.Type())
+		}
+		if err == nil {
+			err = encoder.EncodeValue(ec, vw, nv)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	// The buffer holds a single element: the type byte, the null-terminated key, and the value.
+	t := Type(buf.Next(1)[0])
+	buf.Next(len(key) + 1)
+	raw := buf.Bytes()
+
+	ec.hook.AfterEncodeField(key, t, raw)
+
+	vw, err = dw.WriteDocumentElement(key)
+	if err != nil {
+		return err
+	}
+	return copyValueFromBytes(vw, t, raw)
+}
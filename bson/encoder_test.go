@@ -8,8 +8,10 @@ package bson
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"reflect"
+	"sort"
 	"testing"
 	"time"
 
@@ -338,3 +340,294 @@ func TestEncoderConfiguration(t *testing.T) {
 		})
 	}
 }
+
+func TestEncoder_EncodeMap(t *testing.T) {
+	testCases := []struct {
+		name string
+		m    map[string]interface{}
+		want D
+	}{
+		{
+			name: "nil map",
+			m:    nil,
+			want: D{},
+		},
+		{
+			name: "flat values",
+			m:    map[string]interface{}{"int32": int32(1), "string": "foo", "null": nil},
+			want: D{{"int32", int32(1)}, {"null", nil}, {"string", "foo"}},
+		},
+		{
+			name: "nested documents",
+			m: map[string]interface{}{
+				"doc": map[string]interface{}{"x": int64(1), "nested": map[string]interface{}{"y": true}},
+			},
+			want: D{{"doc", D{{"nested", D{{"y", true}}}, {"x", int64(1)}}}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			err := NewEncoder(NewDocumentWriter(buf)).EncodeMap(tc.m)
+			require.NoError(t, err, "EncodeMap error")
+
+			// Map iteration order is not deterministic, so compare the re-encoded document after
+			// sorting its elements.
+			var got D
+			err = Unmarshal(buf.Bytes(), &got)
+			require.NoError(t, err, "Unmarshal error")
+			sortD(got)
+			assert.Equal(t, tc.want, got, "expected document %v, got %v", tc.want, got)
+		})
+	}
+}
+
+// cancelingInt is encoded and decoded by codecs that cancel a context once a given number of
+// values have been processed.
+type cancelingInt int64
+
+func newCancelingRegistry(cancel context.CancelFunc, after int, calls *int) *Registry {
+	reg := NewRegistry()
+	reg.RegisterTypeEncoder(reflect.TypeOf(cancelingInt(0)), ValueEncoderFunc(
+		func(_ EncodeContext, vw ValueWriter, val reflect.Value) error {
+			*calls++
+			if *calls == after {
+				cancel()
+			}
+			return vw.WriteInt64(val.Int())
+		}))
+	reg.RegisterTypeDecoder(reflect.TypeOf(cancelingInt(0)), ValueDecoderFunc(
+		func(_ DecodeContext, vr ValueReader, val reflect.Value) error {
+			*calls++
+			if *calls == after {
+				cancel()
+			}
+			i64, err := vr.ReadInt64()
+			if err != nil {
+				return err
+			}
+			val.SetInt(i64)
+			return nil
+		}))
+	return reg
+}
+
+func TestEncoder_SetContext(t *testing.T) {
+	// A multi-megabyte array of values.
+	doc := struct {
+		Values []cancelingInt
+	}{
+		Values: make([]cancelingInt, 1<<20),
+	}
+
+	t.Run("canceled mid-encode", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var calls int
+		enc := NewEncoder(NewDocumentWriter(new(bytes.Buffer)))
+		enc.SetRegistry(newCancelingRegistry(cancel, 1000, &calls))
+		enc.SetContext(ctx)
+
+		err := enc.Encode(doc)
+		assert.ErrorIs(t, err, context.Canceled, "expected error %v, got %v", context.Canceled, err)
+		assert.LessOrEqual(t, calls, 1000+cancelCheckInterval,
+			"expected encoding to stop within %d values of cancellation, encoded %d", cancelCheckInterval, calls)
+	})
+	t.Run("already canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		var calls int
+		enc := NewEncoder(NewDocumentWriter(new(bytes.Buffer)))
+		enc.SetRegistry(newCancelingRegistry(cancel, -1, &calls))
+		enc.SetContext(ctx)
+
+		err := enc.Encode(doc)
+		assert.ErrorIs(t, err, context.Canceled, "expected error %v, got %v", context.Canceled, err)
+		assert.Equal(t, 0, calls, "expected no values to be encoded, got %d", calls)
+	})
+	t.Run("not canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var calls int
+		enc := NewEncoder(NewDocumentWriter(new(bytes.Buffer)))
+		enc.SetRegistry(newCancelingRegistry(cancel, -1, &calls))
+		enc.SetContext(ctx)
+
+		err := enc.Encode(doc)
+		require.NoError(t, err, "Encode error")
+		assert.Equal(t, len(doc.Values), calls, "expected %d values to be encoded, got %d", len(doc.Values), calls)
+	})
+}
+
+// maskingHook replaces string values longer than 8 characters with "[REDACTED]".
+type maskingHook struct{}
+
+func (maskingHook) BeforeEncodeField(_ string, val interface{}) (interface{}, error) {
+	if s, ok := val.(string); ok && len(s) > 8 {
+		return "[REDACTED]", nil
+	}
+	return val, nil
+}
+
+func (maskingHook) AfterEncodeField(string, Type, []byte) {}
+
+type recordingHook struct {
+	keys  []string
+	types []Type
+	raws  [][]byte
+}
+
+func (*recordingHook) BeforeEncodeField(_ string, val interface{}) (interface{}, error) {
+	return val, nil
+}
+
+func (rh *recordingHook) AfterEncodeField(key string, t Type, raw []byte) {
+	rh.keys = append(rh.keys, key)
+	rh.types = append(rh.types, t)
+	rh.raws = append(rh.raws, append([]byte(nil), raw...))
+}
+
+type errorHook struct {
+	err error
+}
+
+func (eh errorHook) BeforeEncodeField(string, interface{}) (interface{}, error) {
+	return nil, eh.err
+}
+
+func (errorHook) AfterEncodeField(string, Type, []byte) {}
+
+func TestEncoder_SetHook(t *testing.T) {
+	type address struct {
+		Street string
+		Zip    string
+	}
+	type person struct {
+		Name    string
+		Email   string
+		Age     int32
+		Address address
+		Tags    map[string]interface{}
+		Extra   D
+		Missing interface{}
+	}
+
+	encode := func(t *testing.T, hook EncoderHook, val interface{}) Raw {
+		t.Helper()
+
+		buf := new(bytes.Buffer)
+		enc := NewEncoder(NewDocumentWriter(buf))
+		enc.SetHook(hook)
+		err := enc.Encode(val)
+		require.NoError(t, err, "Encode error")
+		return Raw(buf.Bytes())
+	}
+
+	t.Run("masking", func(t *testing.T) {
+		p := person{
+			Name:    "Bob",
+			Email:   "bob@example.com",
+			Age:     42,
+			Address: address{Street: "1 Infinite Loop", Zip: "95014"},
+			Tags:    map[string]interface{}{"note": "a very long note"},
+			Extra:   D{{"ssn", "123-45-6789"}},
+		}
+		orig := p
+		orig.Tags = map[string]interface{}{"note": "a very long note"}
+
+		got := encode(t, maskingHook{}, &p)
+
+		want, err := Marshal(person{
+			Name:    "Bob",
+			Email:   "[REDACTED]",
+			Age:     42,
+			Address: address{Street: "[REDACTED]", Zip: "95014"},
+			Tags:    map[string]interface{}{"note": "[REDACTED]"},
+			Extra:   D{{"ssn", "[REDACTED]"}},
+		})
+		require.NoError(t, err, "Marshal error")
+		assert.Equal(t, Raw(want), got, "expected %v, got %v", Raw(want), got)
+		assert.Equal(t, orig, p, "expected the encoded struct to be unchanged")
+	})
+	t.Run("no-op hook preserves tagged encoders", func(t *testing.T) {
+		type tagged struct {
+			TS    time.Time            `bson:"ts,timestamp"`
+			Color testColor            `bson:"color,stringenum"`
+			Code  string               `bson:"code,codec=upper"`
+			Map   map[string]testColor `bson:"map"`
+		}
+
+		reg := NewRegistry()
+		reg.RegisterStringEnum(reflect.TypeOf(testColor(0)), parseTestColor)
+		reg.RegisterNamedEncoder("upper", upperCaseCodec{})
+
+		ts := time.Unix(1700000000, 0)
+		val := tagged{
+			TS:    ts,
+			Color: testColorGreen,
+			Code:  "abc",
+			Map:   map[string]testColor{"a": testColorRed},
+		}
+
+		encodeWithReg := func(hook EncoderHook) Raw {
+			buf := new(bytes.Buffer)
+			enc := NewEncoder(NewDocumentWriter(buf))
+			enc.SetRegistry(reg)
+			enc.SetHook(hook)
+			err := enc.Encode(val)
+			require.NoError(t, err, "Encode error")
+			return Raw(buf.Bytes())
+		}
+
+		want := encodeWithReg(nil)
+		got := encodeWithReg(&recordingHook{})
+		assert.Equal(t, want, got, "expected hook to not change the encoded bytes")
+		assert.Equal(t, TypeTimestamp, got.Lookup("ts").Type, "expected ts to be a timestamp, got %v", got.Lookup("ts").Type)
+		assert.Equal(t, "ABC", got.Lookup("code").StringValue(), "expected code to use the named codec")
+	})
+	t.Run("after receives encoded fields", func(t *testing.T) {
+		hook := &recordingHook{}
+		got := encode(t, hook, D{{"a", int32(1)}, {"b", D{{"c", "x"}}}})
+
+		assert.Equal(t, []string{"a", "c", "b"}, hook.keys, "expected fields in encoding order")
+		assert.Equal(t, []Type{TypeInt32, TypeString, TypeEmbeddedDocument}, hook.types)
+		assert.Equal(t, []byte(got.Lookup("b").Value), hook.raws[2], "expected raw bytes of field b")
+	})
+	t.Run("logging", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		hook := ChainEncoderHooks(maskingHook{}, LoggingHook(buf))
+		got := encode(t, hook, M{"email": "bob@example.com"})
+
+		assert.Equal(t, "email: string\n", buf.String(), "unexpected log output")
+		assert.Equal(t, "[REDACTED]", got.Lookup("email").StringValue(), "expected chained hook to mask the value")
+	})
+	t.Run("nil values", func(t *testing.T) {
+		hook := &recordingHook{}
+		got := encode(t, hook, person{})
+
+		want, err := Marshal(person{})
+		require.NoError(t, err, "Marshal error")
+		assert.Equal(t, Raw(want), got, "expected %v, got %v", Raw(want), got)
+		assert.Contains(t, hook.keys, "missing", "expected hook to be called for nil interface field")
+	})
+	t.Run("error", func(t *testing.T) {
+		hookErr := errors.New("hook error")
+
+		enc := NewEncoder(NewDocumentWriter(new(bytes.Buffer)))
+		enc.SetHook(errorHook{err: hookErr})
+		err := enc.Encode(D{{"a", 1}})
+		assert.ErrorIs(t, err, hookErr, "expected error %v, got %v", hookErr, err)
+	})
+}
+
+func sortD(d D) {
+	sort.Slice(d, func(i, j int) bool { return d[i].Key < d[j].Key })
+	for _, e := range d {
+		if nested, ok := e.Value.(D); ok {
+			sortD(nested)
+		}
+	}
+}
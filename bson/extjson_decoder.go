@@ -0,0 +1,58 @@
+// Copyright (C) MongoDB, Inc. 2026-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bson
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+)
+
+// ExtJSONDecoder reads and decodes a stream of Extended JSON values, such as
+// newline-delimited documents exported by mongoexport. Both canonical and
+// relaxed Extended JSON are accepted, and type wrappers such as $numberLong and
+// $numberDecimal are preserved.
+type ExtJSONDecoder struct {
+	dec *json.Decoder
+	dc  DecodeContext
+}
+
+// NewExtJSONDecoder returns a new ExtJSONDecoder that reads Extended JSON
+// values from r.
+func NewExtJSONDecoder(r io.Reader) *ExtJSONDecoder {
+	return &ExtJSONDecoder{
+		dec: json.NewDecoder(r),
+		dc:  DecodeContext{Registry: defaultRegistry},
+	}
+}
+
+// SetRegistry replaces the current registry of the ExtJSONDecoder with r.
+func (d *ExtJSONDecoder) SetRegistry(r *Registry) {
+	d.dc.Registry = r
+}
+
+// More reports whether there is another value in the stream.
+func (d *ExtJSONDecoder) More() bool {
+	return d.dec.More()
+}
+
+// Decode reads the next Extended JSON value from the stream and stores it in
+// the value pointed to by val. Decode returns io.EOF when there are no more
+// values in the stream.
+func (d *ExtJSONDecoder) Decode(val interface{}) error {
+	var raw json.RawMessage
+	if err := d.dec.Decode(&raw); err != nil {
+		return err
+	}
+
+	ejvr, err := NewExtJSONValueReader(bytes.NewReader(raw), false)
+	if err != nil {
+		return err
+	}
+
+	return unmarshalFromReader(d.dc, ejvr, val)
+}
@@ -0,0 +1,103 @@
+// Copyright (C) MongoDB, Inc. 2026-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bson
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+)
+
+func TestUnmarshalExtJSONNumberTypes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("numberLong decodes to int64", func(t *testing.T) {
+		t.Parallel()
+
+		var got Raw
+		err := UnmarshalExtJSON([]byte(`{"a": {"$numberLong": "42"}, "b": 1.5}`), false, &got)
+		require.NoError(t, err, "UnmarshalExtJSON error")
+
+		a := got.Lookup("a")
+		assert.Equal(t, TypeInt64, a.Type, "expected type %v, got %v", TypeInt64, a.Type)
+		assert.Equal(t, int64(42), a.Int64(), "expected value %v, got %v", int64(42), a.Int64())
+
+		b := got.Lookup("b")
+		assert.Equal(t, TypeDouble, b.Type, "expected type %v, got %v", TypeDouble, b.Type)
+	})
+	t.Run("numberDecimal decodes to decimal128", func(t *testing.T) {
+		t.Parallel()
+
+		var got D
+		err := UnmarshalExtJSON([]byte(`{"d": {"$numberDecimal": "1.10"}}`), false, &got)
+		require.NoError(t, err, "UnmarshalExtJSON error")
+
+		want := D{{"d", func() Decimal128 {
+			d, _ := ParseDecimal128("1.10")
+			return d
+		}()}}
+		assert.Equal(t, want, got, "expected document %v, got %v", want, got)
+	})
+	t.Run("invalid JSON", func(t *testing.T) {
+		t.Parallel()
+
+		var got D
+		err := UnmarshalExtJSON([]byte(`{"a": `), false, &got)
+		assert.NotNil(t, err, "expected UnmarshalExtJSON error, got nil")
+	})
+}
+
+func TestExtJSONDecoder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("stream of documents", func(t *testing.T) {
+		t.Parallel()
+
+		input := `{"a": {"$numberLong": "1"}}
+{"a": {"$numberLong": "9223372036854775807"}}
+{"a": 3}`
+		dec := NewExtJSONDecoder(strings.NewReader(input))
+
+		var got []RawValue
+		for dec.More() {
+			var doc Raw
+			err := dec.Decode(&doc)
+			require.NoError(t, err, "Decode error")
+			got = append(got, doc.Lookup("a"))
+		}
+		require.Equal(t, 3, len(got), "expected 3 documents, got %d", len(got))
+
+		assert.Equal(t, TypeInt64, got[0].Type, "expected type %v, got %v", TypeInt64, got[0].Type)
+		assert.Equal(t, int64(1), got[0].Int64(), "expected value %v, got %v", int64(1), got[0].Int64())
+		assert.Equal(t, TypeInt64, got[1].Type, "expected type %v, got %v", TypeInt64, got[1].Type)
+		assert.Equal(t, int64(9223372036854775807), got[1].Int64(),
+			"expected value %v, got %v", int64(9223372036854775807), got[1].Int64())
+		assert.Equal(t, TypeInt32, got[2].Type, "expected type %v, got %v", TypeInt32, got[2].Type)
+
+		err := dec.Decode(&D{})
+		assert.True(t, errors.Is(err, io.EOF), "expected error %v, got %v", io.EOF, err)
+	})
+	t.Run("decode into struct", func(t *testing.T) {
+		t.Parallel()
+
+		type doc struct {
+			Count int64
+			Name  string
+		}
+
+		dec := NewExtJSONDecoder(strings.NewReader(`{"count": {"$numberLong": "5"}, "name": "foo"}`))
+
+		var got doc
+		err := dec.Decode(&got)
+		require.NoError(t, err, "Decode error")
+		assert.Equal(t, doc{Count: 5, Name: "foo"}, got, "expected and actual decode results do not match")
+	})
+}
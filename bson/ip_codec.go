@@ -0,0 +1,140 @@
+// Copyright (C) MongoDB, Inc. 2026-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bson
+
+import (
+	"fmt"
+	"net"
+	"reflect"
+	"strings"
+)
+
+// ipEncodeValue is the ValueEncoderFunc for net.IP. By default, IPs are encoded as BSON binary
+// values holding the IP's bytes, as with any other byte slice. If the EncodeContext has ipAsString
+// set, they are encoded as BSON strings in their textual form instead, and nil and empty IPs are
+// encoded as BSON null.
+func ipEncodeValue(ec EncodeContext, vw ValueWriter, val reflect.Value) error {
+	if !val.IsValid() || val.Type() != tIP {
+		return ValueEncoderError{Name: "IPEncodeValue", Types: []reflect.Type{tIP}, Received: val}
+	}
+
+	if !ec.ipAsString {
+		return (&sliceCodec{}).EncodeValue(ec, vw, val)
+	}
+
+	ip := val.Interface().(net.IP)
+	if len(ip) == 0 {
+		return vw.WriteNull()
+	}
+	return vw.WriteString(ip.String())
+}
+
+// ipDecodeValue is the ValueDecoderFunc for net.IP. It accepts BSON strings containing an IPv4 or
+// IPv6 address, and any value that can be decoded into a byte slice, such as BSON binary.
+func ipDecodeValue(dc DecodeContext, vr ValueReader, val reflect.Value) error {
+	if !val.CanSet() || val.Type() != tIP {
+		return ValueDecoderError{Name: "IPDecodeValue", Types: []reflect.Type{tIP}, Received: val}
+	}
+
+	if vr.Type() != TypeString {
+		return (&sliceCodec{}).DecodeValue(dc, vr, val)
+	}
+
+	str, err := vr.ReadString()
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(str)
+	if ip == nil {
+		return fmt.Errorf("cannot parse %q as an IP address", str)
+	}
+
+	val.Set(reflect.ValueOf(ip))
+	return nil
+}
+
+// ipNetEncodeValue is the ValueEncoderFunc for net.IPNet. By default, networks are encoded as
+// {ip: <binary>, mask: <binary>} documents. If the EncodeContext has ipAsString set, they are
+// encoded as BSON strings in CIDR notation instead, and the zero net.IPNet is encoded as BSON null.
+func ipNetEncodeValue(ec EncodeContext, vw ValueWriter, val reflect.Value) error {
+	if !val.IsValid() || val.Type() != tIPNet {
+		return ValueEncoderError{Name: "IPNetEncodeValue", Types: []reflect.Type{tIPNet}, Received: val}
+	}
+
+	if !ec.ipAsString {
+		return ipNetEncodeDocument(ec, vw, val)
+	}
+
+	ipNet := val.Interface().(net.IPNet)
+	if ipNet.IP == nil && ipNet.Mask == nil {
+		return vw.WriteNull()
+	}
+	return vw.WriteString(ipNet.String())
+}
+
+// ipNetDecodeValue is the ValueDecoderFunc for net.IPNet. It accepts BSON strings in CIDR notation
+// and {ip, mask} documents, and decodes BSON null and undefined to the zero net.IPNet.
+func ipNetDecodeValue(dc DecodeContext, vr ValueReader, val reflect.Value) error {
+	if !val.CanSet() || val.Type() != tIPNet {
+		return ValueDecoderError{Name: "IPNetDecodeValue", Types: []reflect.Type{tIPNet}, Received: val}
+	}
+
+	switch vrType := vr.Type(); vrType {
+	case TypeString:
+	case TypeNull:
+		val.Set(reflect.Zero(tIPNet))
+		return vr.ReadNull()
+	case TypeUndefined:
+		val.Set(reflect.Zero(tIPNet))
+		return vr.ReadUndefined()
+	case Type(0), TypeEmbeddedDocument:
+		if dc.zeroStructs {
+			val.Set(reflect.Zero(tIPNet))
+		}
+		return decodeLowercaseFields(dc, vr, val)
+	default:
+		return fmt.Errorf("cannot decode %v into a %s", vrType, tIPNet)
+	}
+
+	str, err := vr.ReadString()
+	if err != nil {
+		return err
+	}
+	_, ipNet, err := net.ParseCIDR(str)
+	if err != nil {
+		return err
+	}
+
+	val.Set(reflect.ValueOf(*ipNet))
+	return nil
+}
+
+// ipNetEncodeDocument encodes a net.IPNet as an {ip: ..., mask: ...} document, the form the struct
+// codec has always used for it. Each field is encoded with the encoder registered for its type.
+func ipNetEncodeDocument(ec EncodeContext, vw ValueWriter, val reflect.Value) error {
+	dw, err := vw.WriteDocument()
+	if err != nil {
+		return err
+	}
+
+	for _, name := range []string{"IP", "Mask"} {
+		field := val.FieldByName(name)
+		encoder, err := ec.LookupEncoder(field.Type())
+		if err != nil {
+			return err
+		}
+		fvw, err := dw.WriteDocumentElement(strings.ToLower(name))
+		if err != nil {
+			return err
+		}
+		if err := encoder.EncodeValue(ec, fvw, field); err != nil {
+			return err
+		}
+	}
+
+	return dw.WriteDocumentEnd()
+}
@@ -0,0 +1,169 @@
+// Copyright (C) MongoDB, Inc. 2026-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bson
+
+import (
+	"bytes"
+	"net"
+	"reflect"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+)
+
+type testIPDoc struct {
+	IP  net.IP     `bson:"ip"`
+	Net net.IPNet  `bson:"net"`
+	Ptr *net.IPNet `bson:"ptr"`
+}
+
+func mustParseCIDR(t *testing.T, s string) *net.IPNet {
+	t.Helper()
+
+	_, ipNet, err := net.ParseCIDR(s)
+	require.NoError(t, err, "ParseCIDR error")
+	return ipNet
+}
+
+func TestIPCodecs(t *testing.T) {
+	t.Parallel()
+
+	encode := func(t *testing.T, val interface{}, asString bool) Raw {
+		t.Helper()
+
+		buf := new(bytes.Buffer)
+		enc := NewEncoder(NewDocumentWriter(buf))
+		if asString {
+			enc.IPAsString()
+		}
+		require.NoError(t, enc.Encode(val), "Encode error")
+		return Raw(buf.Bytes())
+	}
+
+	testCases := []struct {
+		name string
+		ip   string
+		cidr string
+	}{
+		{"IPv4", "192.168.1.10", "192.168.1.0/24"},
+		{"IPv6", "2001:db8::1", "2001:db8::/32"},
+	}
+	for _, tc := range testCases {
+		tc := tc // Capture range variable.
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ipNet := mustParseCIDR(t, tc.cidr)
+			doc := testIPDoc{IP: net.ParseIP(tc.ip), Net: *ipNet, Ptr: ipNet}
+
+			t.Run("string", func(t *testing.T) {
+				raw := encode(t, doc, true)
+
+				ip := raw.Lookup("ip")
+				require.Equal(t, TypeString, ip.Type, "expected type %v, got %v", TypeString, ip.Type)
+				assert.Equal(t, tc.ip, ip.StringValue(), "expected ip %q, got %q", tc.ip, ip.StringValue())
+				cidr := raw.Lookup("net")
+				require.Equal(t, TypeString, cidr.Type, "expected type %v, got %v", TypeString, cidr.Type)
+				assert.Equal(t, tc.cidr, cidr.StringValue(), "expected net %q, got %q", tc.cidr, cidr.StringValue())
+
+				var got testIPDoc
+				require.NoError(t, Unmarshal(raw, &got), "Unmarshal error")
+				assert.True(t, doc.IP.Equal(got.IP), "expected ip %v, got %v", doc.IP, got.IP)
+				assert.Equal(t, doc.Net.String(), got.Net.String(), "expected net %v, got %v", doc.Net, got.Net)
+				require.NotNil(t, got.Ptr, "expected ptr to be set")
+				assert.Equal(t, doc.Ptr.String(), got.Ptr.String(), "expected ptr %v, got %v", doc.Ptr, got.Ptr)
+			})
+			t.Run("binary", func(t *testing.T) {
+				raw := encode(t, doc, false)
+
+				// The default encoding must match the byte slice and struct encoding that net.IP
+				// and net.IPNet had before they had their own codecs.
+				netDoc := D{{"ip", Binary{Data: ipNet.IP}}, {"mask", Binary{Data: ipNet.Mask}}}
+				want, err := Marshal(D{{"ip", Binary{Data: doc.IP}}, {"net", netDoc}, {"ptr", netDoc}})
+				require.NoError(t, err, "Marshal error")
+				assert.Equal(t, Raw(want), raw, "expected %v, got %v", Raw(want), raw)
+
+				var got testIPDoc
+				require.NoError(t, Unmarshal(raw, &got), "Unmarshal error")
+				assert.Equal(t, doc, got, "expected %v, got %v", doc, got)
+			})
+		})
+	}
+
+	t.Run("nil IP", func(t *testing.T) {
+		t.Parallel()
+
+		for _, asString := range []bool{false, true} {
+			raw := encode(t, testIPDoc{}, asString)
+			assert.Equal(t, TypeNull, raw.Lookup("ip").Type, "expected type %v, got %v", TypeNull, raw.Lookup("ip").Type)
+
+			var got testIPDoc
+			require.NoError(t, Unmarshal(raw, &got), "Unmarshal error")
+			assert.Nil(t, got.IP, "expected nil ip, got %v", got.IP)
+		}
+	})
+	t.Run("zero IPNet", func(t *testing.T) {
+		t.Parallel()
+
+		raw := encode(t, testIPDoc{}, false)
+		want, err := Marshal(D{{"ip", nil}, {"mask", nil}})
+		require.NoError(t, err, "Marshal error")
+		assert.Equal(t, Raw(want), raw.Lookup("net").Document(), "expected %v, got %v", Raw(want), raw.Lookup("net"))
+
+		var got testIPDoc
+		require.NoError(t, Unmarshal(raw, &got), "Unmarshal error")
+		assert.Equal(t, net.IPNet{}, got.Net, "expected zero net, got %v", got.Net)
+	})
+	t.Run("IPNet uses each registry's field encoders", func(t *testing.T) {
+		t.Parallel()
+
+		ipNet := mustParseCIDR(t, "10.0.0.0/8")
+		_, _, err := MarshalValue(*ipNet)
+		require.NoError(t, err, "MarshalValue error")
+
+		reg := NewRegistry()
+		reg.RegisterTypeEncoder(tIP, ValueEncoderFunc(func(_ EncodeContext, vw ValueWriter, val reflect.Value) error {
+			return vw.WriteString(val.Interface().(net.IP).String())
+		}))
+		typ, data, err := MarshalValueWithRegistry(reg, *ipNet)
+		require.NoError(t, err, "MarshalValueWithRegistry error")
+		require.Equal(t, TypeEmbeddedDocument, typ, "expected type %v, got %v", TypeEmbeddedDocument, typ)
+
+		got := Raw(data).Lookup("ip")
+		assert.Equal(t, TypeString, got.Type, "expected type %v, got %v", TypeString, got.Type)
+	})
+	t.Run("4-byte binary", func(t *testing.T) {
+		t.Parallel()
+
+		raw, err := Marshal(D{{"ip", Binary{Data: []byte{10, 0, 0, 1}}}})
+		require.NoError(t, err, "Marshal error")
+
+		var got testIPDoc
+		require.NoError(t, Unmarshal(raw, &got), "Unmarshal error")
+		want := net.IPv4(10, 0, 0, 1)
+		assert.True(t, want.Equal(got.IP), "expected ip %v, got %v", want, got.IP)
+	})
+	t.Run("invalid values", func(t *testing.T) {
+		t.Parallel()
+
+		for _, val := range []interface{}{"not an ip", int32(1)} {
+			raw, err := Marshal(D{{"ip", val}})
+			require.NoError(t, err, "Marshal error")
+
+			var got testIPDoc
+			assert.Error(t, Unmarshal(raw, &got), "expected Unmarshal error for %v", val)
+		}
+
+		raw, err := Marshal(D{{"net", "10.0.0.0/99"}})
+		require.NoError(t, err, "Marshal error")
+
+		var got testIPDoc
+		assert.Error(t, Unmarshal(raw, &got), "expected Unmarshal error for an invalid CIDR")
+	})
+}
@@ -88,12 +88,19 @@ func (mc *mapCodec) encodeMapElements(ec EncodeContext, dw DocumentWriter, val r
 
 	keys := val.MapKeys()
 	for _, key := range keys {
+		if err := ec.cancel.tick(); err != nil {
+			return err
+		}
+
 		keyStr, err := mc.encodeKey(key, ec.stringifyMapKeysWithFmt)
 		if err != nil {
 			return err
 		}
 
 		if collisionFn != nil && collisionFn(keyStr) {
+			if ec.squashConflict == SquashConflictStructFieldWins {
+				continue
+			}
 			return fmt.Errorf("Key %s of inlined map conflicts with a struct field name", key)
 		}
 
@@ -102,6 +109,13 @@ func (mc *mapCodec) encodeMapElements(ec EncodeContext, dw DocumentWriter, val r
 			return lookupErr
 		}
 
+		if ec.hook != nil {
+			if err := encodeHookedElement(ec, dw, keyStr, currEncoder, currVal); err != nil {
+				return err
+			}
+			continue
+		}
+
 		vw, err := dw.WriteDocumentElement(keyStr)
 		if err != nil {
 			return err
@@ -164,6 +178,10 @@ func (mc *mapCodec) DecodeValue(dc DecodeContext, vr ValueReader, val reflect.Va
 	keyType := val.Type().Key()
 
 	for {
+		if err := dc.cancel.tick(); err != nil {
+			return err
+		}
+
 		key, vr, err := dr.ReadElement()
 		if errors.Is(err, ErrEOD) {
 			break
@@ -92,6 +92,16 @@ func Marshal(val interface{}) ([]byte, error) {
 // MarshalValue will use bson.NewRegistry() to transform val into a BSON value. If val is a struct, this function will
 // inspect struct tags and alter the marshalling process accordingly.
 func MarshalValue(val interface{}) (Type, []byte, error) {
+	return MarshalValueWithRegistry(defaultRegistry, val)
+}
+
+// MarshalValueWithRegistry returns the BSON encoding of val using the provided registry. If reg is nil,
+// MarshalValueWithRegistry returns ErrNilRegistry.
+func MarshalValueWithRegistry(reg *Registry, val interface{}) (Type, []byte, error) {
+	if reg == nil {
+		return 0, nil, ErrNilRegistry
+	}
+
 	sw := bufPool.Get().(*bytes.Buffer)
 	defer func() {
 		// Proper usage of a sync.Pool requires each entry to have approximately
@@ -121,7 +131,7 @@ func MarshalValue(val interface{}) (Type, []byte, error) {
 	enc := encPool.Get().(*Encoder)
 	defer encPool.Put(enc)
 	enc.Reset(vw)
-	enc.SetRegistry(defaultRegistry)
+	enc.SetRegistry(reg)
 	if err := enc.Encode(val); err != nil {
 		return 0, nil, err
 	}
@@ -326,3 +326,84 @@ func TestMarshalConcurrently(t *testing.T) {
 	}
 	wg.Wait()
 }
+
+// customCodecStruct controls its entire BSON encoding, storing X under the
+// key "custom" instead of "x".
+type customCodecStruct struct {
+	X int32 `bson:"x"`
+}
+
+func (c customCodecStruct) MarshalBSON() ([]byte, error) {
+	return Marshal(D{{"custom", c.X}})
+}
+
+func (c *customCodecStruct) UnmarshalBSON(b []byte) error {
+	v, ok := Raw(b).Lookup("custom").Int32OK()
+	if !ok {
+		return errors.New(`missing int32 "custom" field`)
+	}
+	c.X = v
+	return nil
+}
+
+// marshalOnlyStruct implements Marshaler but not Unmarshaler, so it is
+// decoded by the struct codec.
+type marshalOnlyStruct struct {
+	X int32 `bson:"x"`
+}
+
+func (m marshalOnlyStruct) MarshalBSON() ([]byte, error) {
+	return Marshal(D{{"x", m.X * 10}})
+}
+
+func TestMarshalerStruct(t *testing.T) {
+	t.Run("Marshaler and Unmarshaler", func(t *testing.T) {
+		b, err := Marshal(customCodecStruct{X: 1})
+		require.NoError(t, err, "Marshal error")
+		assert.Equal(t, int32(1), Raw(b).Lookup("custom").Int32(), "expected custom encoding, got %v", Raw(b))
+
+		var got customCodecStruct
+		err = Unmarshal(b, &got)
+		require.NoError(t, err, "Unmarshal error")
+		assert.Equal(t, customCodecStruct{X: 1}, got, "expected %v, got %v", customCodecStruct{X: 1}, got)
+	})
+	t.Run("Marshaler only", func(t *testing.T) {
+		b, err := Marshal(marshalOnlyStruct{X: 1})
+		require.NoError(t, err, "Marshal error")
+
+		var got marshalOnlyStruct
+		err = Unmarshal(b, &got)
+		require.NoError(t, err, "Unmarshal error")
+		assert.Equal(t, marshalOnlyStruct{X: 10}, got, "expected %v, got %v", marshalOnlyStruct{X: 10}, got)
+	})
+	t.Run("nil pointer", func(t *testing.T) {
+		type container struct {
+			P *customCodecStruct `bson:"p"`
+		}
+
+		b, err := Marshal(container{})
+		require.NoError(t, err, "Marshal error")
+		assert.Equal(t, TypeNull, Raw(b).Lookup("p").Type, "expected null, got %v", Raw(b).Lookup("p").Type)
+
+		got := container{P: &customCodecStruct{X: 1}}
+		err = Unmarshal(b, &got)
+		require.NoError(t, err, "Unmarshal error")
+		assert.Nil(t, got.P, "expected nil pointer, got %v", got.P)
+	})
+	t.Run("type encoder takes precedence", func(t *testing.T) {
+		reg := NewRegistry()
+		reg.RegisterTypeEncoder(reflect.TypeOf(customCodecStruct{}), ValueEncoderFunc(
+			func(_ EncodeContext, vw ValueWriter, _ reflect.Value) error {
+				return vw.WriteString("type encoder")
+			}))
+
+		buf := new(bytes.Buffer)
+		enc := NewEncoder(NewDocumentWriter(buf))
+		enc.SetRegistry(reg)
+		err := enc.Encode(D{{"v", customCodecStruct{X: 1}}})
+		require.NoError(t, err, "Encode error")
+
+		got := Raw(buf.Bytes()).Lookup("v").StringValue()
+		assert.Equal(t, "type encoder", got, "expected type encoder to be used, got %q", got)
+	})
+}
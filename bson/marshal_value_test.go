@@ -7,6 +7,7 @@
 package bson
 
 import (
+	"reflect"
 	"slices"
 	"strings"
 	"testing"
@@ -67,6 +68,59 @@ func TestMarshalValue(t *testing.T) {
 	})
 }
 
+func TestMarshalValueWithRegistry(t *testing.T) {
+	t.Parallel()
+
+	t.Run("int", func(t *testing.T) {
+		t.Parallel()
+
+		typ, data, err := MarshalValueWithRegistry(NewRegistry(), 42)
+		require.NoError(t, err, "MarshalValueWithRegistry error")
+		assert.Equal(t, TypeInt32, typ, "expected type %v, got %v", TypeInt32, typ)
+		assert.Equal(t, []byte{42, 0, 0, 0}, data, "expected bytes %v, got %v", []byte{42, 0, 0, 0}, data)
+
+		var got int
+		err = UnmarshalValue(typ, data, &got)
+		require.NoError(t, err, "UnmarshalValue error")
+		assert.Equal(t, 42, got, "expected value %v, got %v", 42, got)
+	})
+	t.Run("custom registry", func(t *testing.T) {
+		t.Parallel()
+
+		type myString string
+
+		reg := NewRegistry()
+		reg.RegisterTypeEncoder(reflect.TypeOf(myString("")), ValueEncoderFunc(
+			func(_ EncodeContext, vw ValueWriter, val reflect.Value) error {
+				return vw.WriteString(strings.ToUpper(val.String()))
+			}))
+
+		typ, data, err := MarshalValueWithRegistry(reg, myString("foo"))
+		require.NoError(t, err, "MarshalValueWithRegistry error")
+		assert.Equal(t, TypeString, typ, "expected type %v, got %v", TypeString, typ)
+		want := bsoncore.AppendString(nil, "FOO")
+		assert.Equal(t, want, data, "expected bytes %v, got %v", want, data)
+	})
+	t.Run("nil registry", func(t *testing.T) {
+		t.Parallel()
+
+		_, _, err := MarshalValueWithRegistry(nil, 42)
+		assert.Equal(t, ErrNilRegistry, err, "expected error %v, got %v", ErrNilRegistry, err)
+	})
+
+	for _, tc := range marshalValueTestCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			valueType, valueBytes, err := MarshalValueWithRegistry(NewRegistry(), tc.val)
+			assert.Nil(t, err, "MarshalValueWithRegistry error: %v", err)
+			compareMarshalValueResults(t, tc, valueType, valueBytes)
+		})
+	}
+}
+
 func compareMarshalValueResults(t *testing.T, tc marshalValueTestCase, gotType Type, gotBytes []byte) {
 	t.Helper()
 	expectedValue := RawValue{Type: tc.bsontype, Value: tc.bytes}
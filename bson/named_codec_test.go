@@ -0,0 +1,102 @@
+// Copyright (C) MongoDB, Inc. 2026-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bson
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+)
+
+// upperCaseCodec encodes strings in upper case and decodes them unchanged.
+type upperCaseCodec struct{}
+
+func (upperCaseCodec) EncodeValue(_ EncodeContext, vw ValueWriter, val reflect.Value) error {
+	if val.Kind() != reflect.String {
+		return ValueEncoderError{Name: "upperCaseCodec.EncodeValue", Kinds: []reflect.Kind{reflect.String}, Received: val}
+	}
+	return vw.WriteString(strings.ToUpper(val.String()))
+}
+
+func (upperCaseCodec) DecodeValue(_ DecodeContext, vr ValueReader, val reflect.Value) error {
+	if !val.CanSet() || val.Kind() != reflect.String {
+		return ValueDecoderError{Name: "upperCaseCodec.DecodeValue", Kinds: []reflect.Kind{reflect.String}, Received: val}
+	}
+	s, err := vr.ReadString()
+	if err != nil {
+		return err
+	}
+	val.SetString(s)
+	return nil
+}
+
+type namedCodecDoc struct {
+	Code string `bson:"code,codec=upper"`
+	Name string `bson:"name"`
+}
+
+func TestNamedCodec(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRegistry()
+	reg.RegisterNamedEncoder("upper", upperCaseCodec{})
+	reg.RegisterNamedDecoder("upper", upperCaseCodec{})
+
+	marshal := func(t *testing.T, reg *Registry, val interface{}) ([]byte, error) {
+		t.Helper()
+
+		buf := new(bytes.Buffer)
+		enc := NewEncoder(NewDocumentWriter(buf))
+		enc.SetRegistry(reg)
+		err := enc.Encode(val)
+		return buf.Bytes(), err
+	}
+
+	t.Run("round trip", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := marshal(t, reg, namedCodecDoc{Code: "abc", Name: "abc"})
+		require.NoError(t, err, "Encode error")
+
+		want, err := Marshal(D{{"code", "ABC"}, {"name", "abc"}})
+		require.NoError(t, err, "Marshal error")
+		assert.Equal(t, Raw(want), Raw(data), "expected document %v, got %v", Raw(want), Raw(data))
+
+		var got namedCodecDoc
+		dec := NewDecoder(NewDocumentReader(bytes.NewReader(data)))
+		dec.SetRegistry(reg)
+		err = dec.Decode(&got)
+		require.NoError(t, err, "Decode error")
+
+		expected := namedCodecDoc{Code: "ABC", Name: "abc"}
+		assert.Equal(t, expected, got, "expected document %v, got %v", expected, got)
+	})
+	t.Run("encoder only", func(t *testing.T) {
+		t.Parallel()
+
+		reg := NewRegistry()
+		reg.RegisterNamedEncoder("upper", upperCaseCodec{})
+
+		data, err := marshal(t, reg, namedCodecDoc{Code: "abc"})
+		require.NoError(t, err, "Encode error")
+
+		code, err := Raw(data).LookupErr("code")
+		require.NoError(t, err, "LookupErr error")
+		assert.Equal(t, "ABC", code.StringValue(), "expected value %q, got %q", "ABC", code.StringValue())
+	})
+	t.Run("unknown name", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := marshal(t, NewRegistry(), namedCodecDoc{})
+		require.Error(t, err, "expected Encode error, got nil")
+		assert.Contains(t, err.Error(), `no codec registered with name "upper"`)
+	})
+}
@@ -44,15 +44,67 @@ func NewObjectID() ObjectID {
 
 // NewObjectIDFromTimestamp generates a new ObjectID based on the given time.
 func NewObjectIDFromTimestamp(timestamp time.Time) ObjectID {
+	return newObjectID(timestamp, processUnique, atomic.AddUint32(&objectIDCounter, 1))
+}
+
+// ObjectIDFromTime returns an ObjectID whose timestamp is the given time, truncated to the second,
+// and whose remaining bytes are all zero. Unlike NewObjectIDFromTimestamp, it does not fill in the
+// process-unique value and counter, so it is the smallest ObjectID for that second. That makes it
+// useful as a bound when querying _id values by creation time; see ObjectIDTimeRangeFilter. It
+// must not be used to generate _id values for new documents because it is not unique.
+func ObjectIDFromTime(t time.Time) ObjectID {
+	return newObjectID(t, [5]byte{}, 0)
+}
+
+// newObjectID lays out an ObjectID from its timestamp, process-unique value, and the low 24 bits
+// of counter.
+func newObjectID(timestamp time.Time, unique [5]byte, counter uint32) ObjectID {
 	var b [12]byte
 
 	binary.BigEndian.PutUint32(b[0:4], uint32(timestamp.Unix()))
-	copy(b[4:9], processUnique[:])
-	putUint24(b[9:12], atomic.AddUint32(&objectIDCounter, 1))
+	copy(b[4:9], unique[:])
+	putUint24(b[9:12], counter)
 
 	return b
 }
 
+// ObjectIDTimeRangeFilter returns a filter matching documents whose _id is an ObjectID created at
+// or after start and before end, both truncated to the second:
+//
+//	{"_id": {"$gte": ObjectIDFromTime(start), "$lt": ObjectIDFromTime(end)}}
+func ObjectIDTimeRangeFilter(start, end time.Time) D {
+	return D{{Key: "_id", Value: D{
+		{Key: "$gte", Value: ObjectIDFromTime(start)},
+		{Key: "$lt", Value: ObjectIDFromTime(end)},
+	}}}
+}
+
+// ObjectIDGenerator generates ObjectIDs from a caller-provided clock, process-unique value, and
+// counter. It is intended for tests that need reproducible ObjectIDs and can be installed on a
+// Client with options.ClientOptions.SetIDGenerator. NewObjectID should be used otherwise. An
+// ObjectIDGenerator is safe for concurrent use.
+type ObjectIDGenerator struct {
+	now           func() time.Time
+	processUnique [5]byte
+	counter       uint32
+}
+
+// NewObjectIDGenerator creates an ObjectIDGenerator that takes timestamps from now and uses the
+// given process-unique value. The counter of the first generated ObjectID is counter+1. If now is
+// nil, time.Now is used.
+func NewObjectIDGenerator(now func() time.Time, processUnique [5]byte, counter uint32) *ObjectIDGenerator {
+	if now == nil {
+		now = time.Now
+	}
+
+	return &ObjectIDGenerator{now: now, processUnique: processUnique, counter: counter}
+}
+
+// NewObjectID generates a new ObjectID.
+func (g *ObjectIDGenerator) NewObjectID() ObjectID {
+	return newObjectID(g.now(), g.processUnique, atomic.AddUint32(&g.counter, 1))
+}
+
 // Timestamp extracts the time part of the ObjectId.
 func (id ObjectID) Timestamp() time.Time {
 	unixSecs := binary.BigEndian.Uint32(id[0:4])
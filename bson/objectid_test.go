@@ -7,6 +7,7 @@
 package bson
 
 import (
+	"bytes"
 	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
@@ -291,3 +292,100 @@ func TestObjectID_UnmarshalText(t *testing.T) {
 	want := ObjectID{0x0, 0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8, 0x9, 0xA, 0xB}
 	assert.Equal(t, want, oid, "want %v, got %v", want, oid)
 }
+
+func TestNewObjectID_Unique(t *testing.T) {
+	const n = 10000
+
+	seen := make(map[ObjectID]struct{}, n)
+	prev := NewObjectID()
+	for i := 0; i < n; i++ {
+		id := NewObjectID()
+		_, dup := seen[id]
+		require.False(t, dup, "duplicate ObjectID %v", id)
+		seen[id] = struct{}{}
+
+		// Consecutive ObjectIDs share the process-unique bytes and increment the counter.
+		assert.Equal(t, prev[4:9], id[4:9], "expected process-unique bytes %x, got %x", prev[4:9], id[4:9])
+		prevCounter := uint32(prev[9])<<16 | uint32(prev[10])<<8 | uint32(prev[11])
+		counter := uint32(id[9])<<16 | uint32(id[10])<<8 | uint32(id[11])
+		assert.Equal(t, (prevCounter+1)&0xFFFFFF, counter, "expected counter %v, got %v", prevCounter+1, counter)
+		assert.False(t, id.Timestamp().Before(prev.Timestamp()), "expected timestamps to be non-decreasing")
+
+		prev = id
+	}
+}
+
+func TestObjectIDGenerator(t *testing.T) {
+	start := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	now := start
+	clock := func() time.Time { return now }
+	pu := [5]byte{1, 2, 3, 4, 5}
+
+	newIDs := func() []ObjectID {
+		gen := NewObjectIDGenerator(clock, pu, 0)
+		now = start
+		var ids []ObjectID
+		for i := 0; i < 3; i++ {
+			ids = append(ids, gen.NewObjectID())
+			now = now.Add(time.Second)
+		}
+		return ids
+	}
+
+	ids := newIDs()
+	assert.Equal(t, ids, newIDs(), "expected generators with the same inputs to produce the same ObjectIDs")
+
+	want, err := ObjectIDFromHex("695735a50102030405000001")
+	require.NoError(t, err, "ObjectIDFromHex error")
+	assert.Equal(t, want, ids[0], "expected %v, got %v", want, ids[0])
+	for i, id := range ids {
+		wantTime := start.Add(time.Duration(i) * time.Second)
+		assert.Equal(t, wantTime, id.Timestamp(), "expected timestamp %v, got %v", wantTime, id.Timestamp())
+		if i > 0 {
+			assert.Equal(t, 1, bytes.Compare(id[:], ids[i-1][:]), "expected %v to sort after %v", id, ids[i-1])
+		}
+	}
+}
+
+func TestObjectIDFromTime(t *testing.T) {
+	ts := time.Date(2026, 1, 2, 3, 4, 5, 999, time.UTC)
+	id := ObjectIDFromTime(ts)
+
+	assert.Equal(t, ts.Truncate(time.Second), id.Timestamp(), "expected timestamp %v, got %v", ts, id.Timestamp())
+	assert.Equal(t, make([]byte, 8), id[4:], "expected zeroed trailing bytes, got %x", id[4:])
+	generated := NewObjectIDFromTimestamp(ts)
+	assert.Equal(t, -1, bytes.Compare(id[:], generated[:]),
+		"expected ObjectIDFromTime to sort before generated ObjectIDs with the same timestamp")
+}
+
+func TestObjectIDTimeRangeFilter(t *testing.T) {
+	base := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	now := base
+	gen := NewObjectIDGenerator(func() time.Time { return now }, [5]byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF}, 0)
+
+	// Insert "documents" at base+0s through base+4s.
+	var ids []ObjectID
+	for i := 0; i < 5; i++ {
+		now = base.Add(time.Duration(i) * time.Second)
+		ids = append(ids, gen.NewObjectID())
+	}
+
+	filter := ObjectIDTimeRangeFilter(base.Add(time.Second), base.Add(3*time.Second))
+	require.Len(t, filter, 1, "expected 1 filter element, got %d", len(filter))
+	assert.Equal(t, "_id", filter[0].Key, "expected key %q, got %q", "_id", filter[0].Key)
+
+	bounds := filter[0].Value.(D)
+	gte := bounds[0].Value.(ObjectID)
+	lt := bounds[1].Value.(ObjectID)
+	assert.Equal(t, "$gte", bounds[0].Key, "expected $gte, got %q", bounds[0].Key)
+	assert.Equal(t, "$lt", bounds[1].Key, "expected $lt, got %q", bounds[1].Key)
+
+	// ObjectIDs compare by their bytes on the server.
+	var matched []ObjectID
+	for _, id := range ids {
+		if bytes.Compare(id[:], gte[:]) >= 0 && bytes.Compare(id[:], lt[:]) < 0 {
+			matched = append(matched, id)
+		}
+	}
+	assert.Equal(t, ids[1:3], matched, "expected %v to match, got %v", ids[1:3], matched)
+}
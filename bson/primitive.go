@@ -15,6 +15,8 @@ import (
 	"fmt"
 	"reflect"
 	"time"
+
+	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
 )
 
 // Zeroer allows custom struct types to implement a report of zero
@@ -254,6 +256,52 @@ func (d *D) UnmarshalJSON(b []byte) error {
 	return err
 }
 
+// ToRawValueMap marshals d and returns a map from each key to its value as a RawValue, which allows
+// typed access to fields by key:
+//
+//	m, err := d.ToRawValueMap()
+//	age := m["age"].Int32()
+//
+// If d contains duplicate keys, the first element with each key is used, matching Raw.Lookup.
+func (d D) ToRawValueMap() (map[string]RawValue, error) {
+	b, err := Marshal(d)
+	if err != nil {
+		return nil, err
+	}
+
+	elems, err := Raw(b).Elements()
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[string]RawValue, len(elems))
+	for _, elem := range elems {
+		key := elem.Key()
+		if _, ok := m[key]; ok {
+			continue
+		}
+		m[key] = elem.Value()
+	}
+	return m, nil
+}
+
+// GetAs decodes the value of the first element of d with the given key into target using the
+// default registry. If d has no element with the key, GetAs returns bsoncore.ErrElementNotFound.
+func (d D) GetAs(key string, target interface{}) error {
+	for _, e := range d {
+		if e.Key != key {
+			continue
+		}
+
+		b, err := Marshal(D{e})
+		if err != nil {
+			return err
+		}
+		return Raw(b).Lookup(key).Unmarshal(target)
+	}
+	return bsoncore.ErrElementNotFound
+}
+
 // E represents a BSON element for a D. It is usually used inside a D.
 type E struct {
 	Key   string
@@ -284,6 +332,125 @@ func (m M) String() string {
 //	bson.A{"bar", "world", 3.14159, bson.D{{"qux", 12345}}}
 type A []interface{}
 
+// NewDocument creates a D from alternating keys and values. Each key must be a string and may be followed by a value
+// of any type, including nil. An error is returned if the number of arguments is odd or if a key is not a string.
+//
+// Example usage:
+//
+//	bson.NewDocument("foo", "bar", "hello", "world", "pi", 3.14159)
+func NewDocument(keysAndValues ...interface{}) (D, error) {
+	if len(keysAndValues)%2 != 0 {
+		return nil, fmt.Errorf("bson.NewDocument requires an even number of arguments, got %d", len(keysAndValues))
+	}
+
+	d := make(D, 0, len(keysAndValues)/2)
+	for i := 0; i < len(keysAndValues); i += 2 {
+		key, ok := keysAndValues[i].(string)
+		if !ok {
+			return nil, fmt.Errorf("bson.NewDocument requires string keys, got %T at index %d", keysAndValues[i], i)
+		}
+		d = append(d, E{Key: key, Value: keysAndValues[i+1]})
+	}
+	return d, nil
+}
+
+// MustDocument is like NewDocument but panics if the arguments do not form a valid document.
+func MustDocument(keysAndValues ...interface{}) D {
+	d, err := NewDocument(keysAndValues...)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// NewArray creates an A containing the given values.
+//
+// Example usage:
+//
+//	bson.NewArray("bar", "world", 3.14159, bson.D{{"qux", 12345}})
+func NewArray(values ...interface{}) A {
+	return A(values)
+}
+
+// MergeDocuments returns a new D containing the elements of base followed by the elements of
+// override whose keys are not in base. If a key is in both documents, the value from override
+// replaces the value of the first element with that key in base, keeping its position. Neither
+// base nor override is modified, but element values are not copied.
+//
+// Example usage:
+//
+//	bson.MergeDocuments(bson.D{{"a", 1}, {"b", 2}}, bson.D{{"b", 3}, {"c", 4}})
+//	// bson.D{{"a", 1}, {"b", 3}, {"c", 4}}
+func MergeDocuments(base, override D) D {
+	return mergeDocuments(base, override, false, false)
+}
+
+// MergeDocumentsDeep is like MergeDocuments, but if a key is in both documents and both values are
+// of type D, the values are merged recursively instead of being replaced. If only one of the values
+// is a D, the value from override is used.
+func MergeDocumentsDeep(base, override D) D {
+	return mergeDocuments(base, override, true, false)
+}
+
+// MergeDocumentsCoalesce is like MergeDocuments, but if a key is in both documents and the value
+// from override is nil or the zero value of its type, the value from base is kept. A value is zero
+// if it implements Zeroer and IsZero returns true, or if it is the zero value of its Go type.
+func MergeDocumentsCoalesce(base, override D) D {
+	return mergeDocuments(base, override, false, true)
+}
+
+func mergeDocuments(base, override D, deep, coalesce bool) D {
+	merged := make(D, len(base), len(base)+len(override))
+	copy(merged, base)
+
+	index := make(map[string]int, len(base)+len(override))
+	for i, e := range merged {
+		if _, ok := index[e.Key]; !ok {
+			index[e.Key] = i
+		}
+	}
+
+	for _, e := range override {
+		i, ok := index[e.Key]
+		if !ok {
+			index[e.Key] = len(merged)
+			merged = append(merged, e)
+			continue
+		}
+
+		if coalesce && isZeroValue(e.Value) {
+			continue
+		}
+		if deep {
+			baseDoc, baseOK := merged[i].Value.(D)
+			overrideDoc, overrideOK := e.Value.(D)
+			if baseOK && overrideOK {
+				merged[i].Value = mergeDocuments(baseDoc, overrideDoc, true, false)
+				continue
+			}
+		}
+		merged[i].Value = e.Value
+	}
+
+	return merged
+}
+
+// isZeroValue reports whether v is nil, a Zeroer that reports itself as zero, or the zero value of
+// its type.
+func isZeroValue(v interface{}) bool {
+	if v == nil {
+		return true
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr && rv.IsNil() {
+		return true
+	}
+	if z, ok := v.(Zeroer); ok {
+		return z.IsZero()
+	}
+	return rv.IsZero()
+}
+
 func jsonDecodeD(dec *json.Decoder) (D, error) {
 	res := D{}
 	for {
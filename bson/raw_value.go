@@ -304,3 +304,124 @@ func (rv RawValue) Decimal128OK() (Decimal128, bool) {
 	h, l, ok := convertToCoreValue(rv).Decimal128OK()
 	return NewDecimal128(h, l), ok
 }
+
+// ToInterface returns the natural Go representation of the value:
+//
+//   - Double: float64
+//   - String, Symbol, and JavaScript: string
+//   - Embedded document: D
+//   - Array: A
+//   - Binary: Binary
+//   - Undefined and Null: nil
+//   - ObjectID: ObjectID
+//   - Boolean: bool
+//   - DateTime: time.Time
+//   - Regex: Regex
+//   - DBPointer: DBPointer
+//   - Code with scope: CodeWithScope with a D scope
+//   - Int32: int32
+//   - Timestamp: Timestamp
+//   - Int64: int64
+//   - Decimal128: Decimal128
+//   - MinKey: MinKey
+//   - MaxKey: MaxKey
+//
+// Embedded documents and arrays are converted recursively. An error is returned if the value is
+// malformed or its type is not a valid BSON type.
+func (rv RawValue) ToInterface() (interface{}, error) {
+	if err := rv.Validate(); err != nil {
+		return nil, err
+	}
+
+	switch rv.Type {
+	case TypeDouble:
+		return rv.Double(), nil
+	case TypeString:
+		return rv.StringValue(), nil
+	case TypeSymbol:
+		return rv.Symbol(), nil
+	case TypeJavaScript:
+		return rv.JavaScript(), nil
+	case TypeEmbeddedDocument:
+		return rawToD(rv.Document())
+	case TypeArray:
+		vals, err := rv.Array().Values()
+		if err != nil {
+			return nil, err
+		}
+		a := make(A, 0, len(vals))
+		for _, val := range vals {
+			v, err := val.ToInterface()
+			if err != nil {
+				return nil, err
+			}
+			a = append(a, v)
+		}
+		return a, nil
+	case TypeBinary:
+		subtype, data := rv.Binary()
+		return Binary{Subtype: subtype, Data: data}, nil
+	case TypeUndefined, TypeNull:
+		return nil, nil
+	case TypeObjectID:
+		return rv.ObjectID(), nil
+	case TypeBoolean:
+		return rv.Boolean(), nil
+	case TypeDateTime:
+		return rv.Time(), nil
+	case TypeRegex:
+		pattern, options := rv.Regex()
+		return Regex{Pattern: pattern, Options: options}, nil
+	case TypeDBPointer:
+		db, ptr := rv.DBPointer()
+		return DBPointer{DB: db, Pointer: ptr}, nil
+	case TypeCodeWithScope:
+		code, scope := rv.CodeWithScope()
+		d, err := rawToD(scope)
+		if err != nil {
+			return nil, err
+		}
+		return CodeWithScope{Code: JavaScript(code), Scope: d}, nil
+	case TypeInt32:
+		return rv.Int32(), nil
+	case TypeTimestamp:
+		t, i := rv.Timestamp()
+		return Timestamp{T: t, I: i}, nil
+	case TypeInt64:
+		return rv.Int64(), nil
+	case TypeDecimal128:
+		return rv.Decimal128(), nil
+	case TypeMinKey:
+		return MinKey{}, nil
+	case TypeMaxKey:
+		return MaxKey{}, nil
+	default:
+		return nil, fmt.Errorf("invalid BSON type %v", rv.Type)
+	}
+}
+
+// MustToInterface is the same as ToInterface, except that it panics if the value cannot be
+// converted.
+func (rv RawValue) MustToInterface() interface{} {
+	v, err := rv.ToInterface()
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func rawToD(doc Raw) (D, error) {
+	elems, err := doc.Elements()
+	if err != nil {
+		return nil, err
+	}
+	d := make(D, 0, len(elems))
+	for _, elem := range elems {
+		v, err := elem.Value().ToInterface()
+		if err != nil {
+			return nil, err
+		}
+		d = append(d, E{Key: elem.Key(), Value: v})
+	}
+	return d, nil
+}
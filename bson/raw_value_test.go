@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"go.mongodb.org/mongo-driver/v2/internal/assert"
 	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
@@ -204,4 +205,118 @@ func TestRawValue(t *testing.T) {
 			})
 		}
 	})
+	t.Run("ToInterface", func(t *testing.T) {
+		t.Parallel()
+
+		oid := NewObjectID()
+		doc := bsoncore.NewDocumentBuilder().AppendInt32("x", 1).AppendString("y", "z").Build()
+		arr := bsoncore.NewArrayBuilder().AppendInt64(1).AppendDocument(doc).Build()
+
+		tests := []struct {
+			name string
+			val  RawValue
+			want interface{}
+		}{
+			{"double", RawValue{Type: TypeDouble, Value: bsoncore.AppendDouble(nil, 3.14)}, 3.14},
+			{"string", RawValue{Type: TypeString, Value: bsoncore.AppendString(nil, "foo")}, "foo"},
+			{
+				"embedded document",
+				RawValue{Type: TypeEmbeddedDocument, Value: doc},
+				D{{"x", int32(1)}, {"y", "z"}},
+			},
+			{
+				"array",
+				RawValue{Type: TypeArray, Value: arr},
+				A{int64(1), D{{"x", int32(1)}, {"y", "z"}}},
+			},
+			{
+				"binary",
+				RawValue{Type: TypeBinary, Value: bsoncore.AppendBinary(nil, 0x80, []byte{0x01, 0x02})},
+				Binary{Subtype: 0x80, Data: []byte{0x01, 0x02}},
+			},
+			{"undefined", RawValue{Type: TypeUndefined}, nil},
+			{"objectID", RawValue{Type: TypeObjectID, Value: bsoncore.AppendObjectID(nil, oid)}, oid},
+			{"boolean", RawValue{Type: TypeBoolean, Value: bsoncore.AppendBoolean(nil, true)}, true},
+			{
+				"datetime",
+				RawValue{Type: TypeDateTime, Value: bsoncore.AppendDateTime(nil, 1234567890123)},
+				time.Unix(1234567890, 123000000),
+			},
+			{"null", RawValue{Type: TypeNull}, nil},
+			{
+				"regex",
+				RawValue{Type: TypeRegex, Value: bsoncore.AppendRegex(nil, "^foo", "i")},
+				Regex{Pattern: "^foo", Options: "i"},
+			},
+			{
+				"DBPointer",
+				RawValue{Type: TypeDBPointer, Value: bsoncore.AppendDBPointer(nil, "db.coll", oid)},
+				DBPointer{DB: "db.coll", Pointer: oid},
+			},
+			{
+				"JavaScript",
+				RawValue{Type: TypeJavaScript, Value: bsoncore.AppendJavaScript(nil, "var a = 1;")},
+				"var a = 1;",
+			},
+			{"symbol", RawValue{Type: TypeSymbol, Value: bsoncore.AppendSymbol(nil, "sym")}, "sym"},
+			{
+				"code with scope",
+				RawValue{Type: TypeCodeWithScope, Value: bsoncore.AppendCodeWithScope(nil, "var a = x;", doc)},
+				CodeWithScope{Code: "var a = x;", Scope: D{{"x", int32(1)}, {"y", "z"}}},
+			},
+			{"int32", RawValue{Type: TypeInt32, Value: bsoncore.AppendInt32(nil, 42)}, int32(42)},
+			{
+				"timestamp",
+				RawValue{Type: TypeTimestamp, Value: bsoncore.AppendTimestamp(nil, 12, 34)},
+				Timestamp{T: 12, I: 34},
+			},
+			{"int64", RawValue{Type: TypeInt64, Value: bsoncore.AppendInt64(nil, 42)}, int64(42)},
+			{
+				"decimal128",
+				RawValue{Type: TypeDecimal128, Value: bsoncore.AppendDecimal128(nil, 1, 2)},
+				NewDecimal128(1, 2),
+			},
+			{"min key", RawValue{Type: TypeMinKey}, MinKey{}},
+			{"max key", RawValue{Type: TypeMaxKey}, MaxKey{}},
+		}
+
+		for _, tt := range tests {
+			tt := tt // Capture the range variable
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				got, err := tt.val.ToInterface()
+				assert.NoError(t, err, "ToInterface error")
+				assert.Equal(t, tt.want, got, "expected %#v, got %#v", tt.want, got)
+				assert.Equal(t, tt.want, tt.val.MustToInterface(), "expected MustToInterface to match ToInterface")
+			})
+		}
+
+		errTests := []struct {
+			name string
+			val  RawValue
+		}{
+			{"truncated int32", RawValue{Type: TypeInt32, Value: []byte{0x01, 0x02}}},
+			{"truncated string", RawValue{Type: TypeString, Value: []byte{0x05, 0x00, 0x00, 0x00, 'f'}}},
+			{
+				"malformed nested value",
+				RawValue{Type: TypeEmbeddedDocument, Value: []byte{0x0A, 0x00, 0x00, 0x00, 0x10, 'x', 0x00, 0x01, 0x00, 0x00}},
+			},
+			{"invalid type", RawValue{Type: Type(0x42), Value: []byte{0x00}}},
+		}
+		for _, tt := range errTests {
+			tt := tt // Capture the range variable
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				_, err := tt.val.ToInterface()
+				assert.Error(t, err, "expected ToInterface to return an error")
+
+				defer func() {
+					assert.NotNil(t, recover(), "expected MustToInterface to panic")
+				}()
+				tt.val.MustToInterface()
+			})
+		}
+	})
 }
@@ -88,6 +88,9 @@ type Registry struct {
 	kindEncoders      *kindEncoderCache
 	kindDecoders      *kindDecoderCache
 	typeMap           sync.Map // map[Type]reflect.Type
+	stringEnumParsers sync.Map // map[reflect.Type]StringEnumParser
+	namedEncoders     sync.Map // map[string]ValueEncoder
+	namedDecoders     sync.Map // map[string]ValueDecoder
 }
 
 // NewRegistry creates a new empty Registry.
@@ -111,7 +114,7 @@ func NewRegistry() *Registry {
 //
 // If the given type is an interface, the encoder will be called when marshaling a type that is
 // that interface. It will not be called when marshaling a non-interface type that implements the
-// interface. To get the latter behavior, call RegisterHookEncoder instead.
+// interface. To get the latter behavior, call RegisterInterfaceEncoder instead.
 //
 // RegisterTypeEncoder should not be called concurrently with any other Registry method.
 func (r *Registry) RegisterTypeEncoder(valueType reflect.Type, enc ValueEncoder) {
@@ -125,7 +128,7 @@ func (r *Registry) RegisterTypeEncoder(valueType reflect.Type, enc ValueEncoder)
 //
 // If the given type is an interface, the decoder will be called when unmarshaling into a type that
 // is that interface. It will not be called when unmarshaling into a non-interface type that
-// implements the interface. To get the latter behavior, call RegisterHookDecoder instead.
+// implements the interface. To get the latter behavior, call RegisterInterfaceDecoder instead.
 //
 // RegisterTypeDecoder should not be called concurrently with any other Registry method.
 func (r *Registry) RegisterTypeDecoder(valueType reflect.Type, dec ValueDecoder) {
@@ -223,6 +226,77 @@ func (r *Registry) RegisterTypeMapEntry(bt Type, rt reflect.Type) {
 	r.typeMap.Store(bt, rt)
 }
 
+// RegisterStringEnum registers the provided parse function for the enum type valueType, which must
+// implement fmt.Stringer. Struct fields of type valueType tagged with the "stringenum" flag are
+// encoded as BSON strings using the String method and decoded using parse. The value returned by
+// parse must be assignable to valueType. If valueType does not implement fmt.Stringer, this method
+// will panic.
+//
+// For example, for an enum type Color with a String method and a ParseColor function:
+//
+//	reg.RegisterStringEnum(reflect.TypeOf(Color(0)), func(s string) (interface{}, error) {
+//	    return ParseColor(s)
+//	})
+//
+// RegisterStringEnum should not be called concurrently with any other Registry method.
+func (r *Registry) RegisterStringEnum(valueType reflect.Type, parse StringEnumParser) {
+	if !valueType.Implements(tStringer) {
+		panicStr := fmt.Errorf("RegisterStringEnum expects a type that implements fmt.Stringer, "+
+			"got type %s", valueType)
+		panic(panicStr)
+	}
+
+	r.stringEnumParsers.Store(valueType, parse)
+}
+
+func (r *Registry) lookupStringEnumParser(valueType reflect.Type) (StringEnumParser, bool) {
+	v, ok := r.stringEnumParsers.Load(valueType)
+	if !ok {
+		return nil, false
+	}
+	return v.(StringEnumParser), true
+}
+
+// RegisterNamedEncoder registers the provided ValueEncoder under name. Struct fields tagged with
+// "codec=<name>" are encoded using enc instead of the encoder registered for the field's type.
+//
+// For example, to encode a string field in upper case:
+//
+//	reg.RegisterNamedEncoder("upper", upperCaseCodec{})
+//
+//	type T struct {
+//	    Code string `bson:"code,codec=upper"`
+//	}
+//
+// RegisterNamedEncoder should not be called concurrently with any other Registry method.
+func (r *Registry) RegisterNamedEncoder(name string, enc ValueEncoder) {
+	r.namedEncoders.Store(name, enc)
+}
+
+// RegisterNamedDecoder registers the provided ValueDecoder under name. Struct fields tagged with
+// "codec=<name>" are decoded using dec instead of the decoder registered for the field's type.
+//
+// RegisterNamedDecoder should not be called concurrently with any other Registry method.
+func (r *Registry) RegisterNamedDecoder(name string, dec ValueDecoder) {
+	r.namedDecoders.Store(name, dec)
+}
+
+func (r *Registry) lookupNamedEncoder(name string) (ValueEncoder, bool) {
+	v, ok := r.namedEncoders.Load(name)
+	if !ok {
+		return nil, false
+	}
+	return v.(ValueEncoder), true
+}
+
+func (r *Registry) lookupNamedDecoder(name string) (ValueDecoder, bool) {
+	v, ok := r.namedDecoders.Load(name)
+	if !ok {
+		return nil, false
+	}
+	return v.(ValueDecoder), true
+}
+
 // LookupEncoder returns the first matching encoder in the Registry. It uses the following lookup
 // order:
 //
@@ -370,6 +444,64 @@ func (r *Registry) LookupTypeMapEntry(bt Type) (reflect.Type, error) {
 	return v.(reflect.Type), nil
 }
 
+// StructCacheStats describes the struct description cache used by a Registry's default struct
+// encoder and decoder. See Registry.SetStructCacheSize.
+type StructCacheStats struct {
+	// Entries is the number of struct types whose descriptions are currently cached.
+	Entries int
+
+	// Evictions is the number of descriptions evicted because the cache was full since the cache
+	// size was last set. It is always 0 for an unbounded cache.
+	Evictions uint64
+}
+
+// SetStructCacheSize sets the maximum number of struct types whose descriptions (field names,
+// struct tag options, and field codecs) are cached by the Registry's default struct encoder and
+// decoder. Once the limit is exceeded, the least recently used description is evicted and is
+// rebuilt the next time its type is encoded or decoded. A size of 0, the default, means the cache
+// is unbounded. Setting the size clears the cache and resets the eviction count.
+//
+// A bounded cache takes a lock on every lookup, so it should only be used by processes that encode
+// or decode a very large number of distinct struct types. SetStructCacheSize has no effect if the
+// struct kind encoder and decoder have been replaced with RegisterKindEncoder and
+// RegisterKindDecoder.
+//
+// SetStructCacheSize should not be called concurrently with any other Registry method.
+func (r *Registry) SetStructCacheSize(size int) {
+	for _, sc := range r.structCodecs() {
+		sc.cache.setLimit(size)
+	}
+}
+
+// StructCacheStats returns the combined statistics of the struct description caches used by the
+// Registry's default struct encoder and decoder.
+func (r *Registry) StructCacheStats() StructCacheStats {
+	var stats StructCacheStats
+	for _, sc := range r.structCodecs() {
+		entries, evictions := sc.cache.stats()
+		stats.Entries += entries
+		stats.Evictions += evictions
+	}
+	return stats
+}
+
+// structCodecs returns the distinct struct codecs registered as the struct kind encoder and
+// decoder.
+func (r *Registry) structCodecs() []*structCodec {
+	var codecs []*structCodec
+	if enc, ok := r.kindEncoders.Load(reflect.Struct); ok {
+		if sc, ok := enc.(*structCodec); ok {
+			codecs = append(codecs, sc)
+		}
+	}
+	if dec, ok := r.kindDecoders.Load(reflect.Struct); ok {
+		if sc, ok := dec.(*structCodec); ok && (len(codecs) == 0 || codecs[0] != sc) {
+			codecs = append(codecs, sc)
+		}
+	}
+	return codecs
+}
+
 type interfaceValueEncoder struct {
 	i  reflect.Type
 	ve ValueEncoder
@@ -7,12 +7,15 @@
 package bson
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
 	"reflect"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
 )
 
 // newTestRegistry creates a new Registry.
@@ -576,3 +579,177 @@ var _ testInterface3 = (*testInterface3Impl)(nil)
 func (*testInterface3Impl) test3() {}
 
 func typeComparer(i1, i2 reflect.Type) bool { return i1 == i2 }
+
+func TestRegistry_SetStructCacheSize(t *testing.T) {
+	t.Parallel()
+
+	const numTypes = 5
+	const cacheSize = 2
+
+	reg := NewRegistry()
+	reg.SetStructCacheSize(cacheSize)
+
+	// Build distinct struct types so each one needs its own description.
+	typs := make([]reflect.Type, numTypes)
+	for i := range typs {
+		typs[i] = reflect.StructOf([]reflect.StructField{
+			{Name: "A", Type: reflect.TypeOf(int32(0)), Tag: reflect.StructTag(fmt.Sprintf(`bson:"a%d"`, i))},
+			{Name: "B", Type: reflect.TypeOf(""), Tag: `bson:"b,omitempty"`},
+		})
+	}
+
+	// Round-trip every type twice so that evicted descriptions are rebuilt.
+	for round := 0; round < 2; round++ {
+		for i, rt := range typs {
+			val := reflect.New(rt).Elem()
+			val.Field(0).SetInt(int64(i))
+			val.Field(1).SetString("foo")
+
+			buf := new(bytes.Buffer)
+			enc := NewEncoder(NewDocumentWriter(buf))
+			enc.SetRegistry(reg)
+			require.NoError(t, enc.Encode(val.Interface()), "Encode error")
+
+			key := fmt.Sprintf("a%d", i)
+			got := Raw(buf.Bytes()).Lookup(key).Int32()
+			assert.Equal(t, int32(i), got, "expected %q to be %v, got %v", key, i, got)
+
+			out := reflect.New(rt)
+			dec := NewDecoder(NewDocumentReader(bytes.NewReader(buf.Bytes())))
+			dec.SetRegistry(reg)
+			require.NoError(t, dec.Decode(out.Interface()), "Decode error")
+			assert.Equal(t, val.Interface(), out.Elem().Interface(), "expected decoded value to match")
+		}
+	}
+
+	// The encoder and decoder each cache at most cacheSize descriptions and evict one for every
+	// other struct description they build.
+	stats := reg.StructCacheStats()
+	assert.Equal(t, 2*cacheSize, stats.Entries, "expected %d entries, got %d", 2*cacheSize, stats.Entries)
+	wantEvictions := uint64(2 * (2*numTypes - cacheSize))
+	assert.Equal(t, wantEvictions, stats.Evictions, "expected %d evictions, got %d", wantEvictions, stats.Evictions)
+
+	reg.SetStructCacheSize(0)
+	stats = reg.StructCacheStats()
+	assert.Equal(t, StructCacheStats{}, stats, "expected stats to be reset, got %+v", stats)
+}
+
+type testMoney interface {
+	Cents() int64
+	SetCents(int64)
+}
+
+type testUSD struct{ amount int64 }
+
+func (u *testUSD) Cents() int64     { return u.amount }
+func (u *testUSD) SetCents(c int64) { u.amount = c }
+
+type testEUR struct{ amount int64 }
+
+func (e *testEUR) Cents() int64     { return e.amount }
+func (e *testEUR) SetCents(c int64) { e.amount = c }
+
+// testMoneyCodec encodes any testMoney as a {cents: <int64>} document.
+type testMoneyCodec struct{}
+
+func (testMoneyCodec) EncodeValue(_ EncodeContext, vw ValueWriter, val reflect.Value) error {
+	if val.Kind() != reflect.Ptr {
+		if !val.CanAddr() {
+			return ValueEncoderError{Name: "testMoneyCodec.EncodeValue", Received: val}
+		}
+		val = val.Addr()
+	}
+	money := val.Interface().(testMoney)
+
+	dw, err := vw.WriteDocument()
+	if err != nil {
+		return err
+	}
+	evw, err := dw.WriteDocumentElement("cents")
+	if err != nil {
+		return err
+	}
+	if err := evw.WriteInt64(money.Cents()); err != nil {
+		return err
+	}
+	return dw.WriteDocumentEnd()
+}
+
+func (testMoneyCodec) DecodeValue(_ DecodeContext, vr ValueReader, val reflect.Value) error {
+	if val.Kind() == reflect.Ptr {
+		if val.IsNil() {
+			val.Set(reflect.New(val.Type().Elem()))
+		}
+	} else {
+		val = val.Addr()
+	}
+	money := val.Interface().(testMoney)
+
+	dr, err := vr.ReadDocument()
+	if err != nil {
+		return err
+	}
+	for {
+		key, evr, err := dr.ReadElement()
+		if errors.Is(err, ErrEOD) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if key != "cents" {
+			if err := evr.Skip(); err != nil {
+				return err
+			}
+			continue
+		}
+		cents, err := evr.ReadInt64()
+		if err != nil {
+			return err
+		}
+		money.SetCents(cents)
+	}
+}
+
+func TestRegistry_InterfaceCodecImplementers(t *testing.T) {
+	t.Parallel()
+
+	tMoney := reflect.TypeOf((*testMoney)(nil)).Elem()
+	reg := NewRegistry()
+	reg.RegisterInterfaceEncoder(tMoney, testMoneyCodec{})
+	reg.RegisterInterfaceDecoder(tMoney, testMoneyCodec{})
+
+	type wallet struct {
+		USD testUSD  `bson:"usd"`
+		EUR *testEUR `bson:"eur"`
+	}
+
+	for _, rt := range []reflect.Type{reflect.TypeOf(&testUSD{}), reflect.TypeOf(&testEUR{})} {
+		enc, err := reg.LookupEncoder(rt)
+		require.NoError(t, err, "LookupEncoder error for %s", rt)
+		assert.Equal(t, testMoneyCodec{}, enc, "expected %s to resolve to the interface encoder, got %T", rt, enc)
+
+		dec, err := reg.LookupDecoder(rt)
+		require.NoError(t, err, "LookupDecoder error for %s", rt)
+		assert.Equal(t, testMoneyCodec{}, dec, "expected %s to resolve to the interface decoder, got %T", rt, dec)
+	}
+
+	want := wallet{USD: testUSD{amount: 1250}, EUR: &testEUR{amount: 990}}
+
+	buf := new(bytes.Buffer)
+	enc := NewEncoder(NewDocumentWriter(buf))
+	enc.SetRegistry(reg)
+	require.NoError(t, enc.Encode(&want), "Encode error")
+
+	doc := Raw(buf.Bytes())
+	usd := doc.Lookup("usd", "cents").Int64()
+	assert.Equal(t, int64(1250), usd, "expected usd cents %v, got %v", 1250, usd)
+	eur := doc.Lookup("eur", "cents").Int64()
+	assert.Equal(t, int64(990), eur, "expected eur cents %v, got %v", 990, eur)
+
+	var got wallet
+	dec := NewDecoder(NewDocumentReader(bytes.NewReader(buf.Bytes())))
+	dec.SetRegistry(reg)
+	require.NoError(t, dec.Decode(&got), "Decode error")
+	assert.Equal(t, want, got, "expected %+v, got %+v", want, got)
+}
@@ -67,6 +67,10 @@ func (sc *sliceCodec) EncodeValue(ec EncodeContext, vw ValueWriter, val reflect.
 	}
 
 	for idx := 0; idx < val.Len(); idx++ {
+		if err := ec.cancel.tick(); err != nil {
+			return err
+		}
+
 		currEncoder, currVal, lookupErr := lookupElementEncoder(ec, encoder, val.Index(idx))
 		if lookupErr != nil && !errors.Is(lookupErr, errInvalidValue) {
 			return lookupErr
@@ -0,0 +1,86 @@
+// Copyright (C) MongoDB, Inc. 2026-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bson
+
+import (
+	"database/sql"
+	"reflect"
+)
+
+// sqlNullTypes are the database/sql Null* types handled by sqlNullCodec. Each is a struct whose first field holds the
+// value and whose Valid field reports whether the value is non-NULL.
+var sqlNullTypes = []reflect.Type{
+	reflect.TypeOf(sql.NullBool{}),
+	reflect.TypeOf(sql.NullByte{}),
+	reflect.TypeOf(sql.NullFloat64{}),
+	reflect.TypeOf(sql.NullInt16{}),
+	reflect.TypeOf(sql.NullInt32{}),
+	reflect.TypeOf(sql.NullInt64{}),
+	reflect.TypeOf(sql.NullString{}),
+	reflect.TypeOf(sql.NullTime{}),
+}
+
+// sqlNullCodec is the Codec used for a database/sql Null* type. A valid value is encoded as the wrapped value using the
+// encoder registered for its type, and an invalid value is encoded as BSON null. Decoding is symmetric: BSON null and
+// undefined decode to an invalid value, and any other BSON value is decoded into the wrapped value, which is then
+// marked valid. Embedded documents are decoded in the legacy {<value field>: ..., valid: ...} form that the struct
+// codec wrote for these types before sqlNullCodec existed, so previously stored documents still decode.
+type sqlNullCodec struct {
+	t reflect.Type
+}
+
+var (
+	_ ValueEncoder = &sqlNullCodec{}
+	_ ValueDecoder = &sqlNullCodec{}
+)
+
+// EncodeValue is the ValueEncoder for a database/sql Null* type.
+func (sc *sqlNullCodec) EncodeValue(ec EncodeContext, vw ValueWriter, val reflect.Value) error {
+	if !val.IsValid() || val.Type() != sc.t {
+		return ValueEncoderError{Name: "SQLNullEncodeValue", Types: []reflect.Type{sc.t}, Received: val}
+	}
+
+	if !val.FieldByName("Valid").Bool() {
+		return vw.WriteNull()
+	}
+
+	inner := val.Field(0)
+	encoder, err := ec.LookupEncoder(inner.Type())
+	if err != nil {
+		return err
+	}
+	return encoder.EncodeValue(ec, vw, inner)
+}
+
+// DecodeValue is the ValueDecoder for a database/sql Null* type.
+func (sc *sqlNullCodec) DecodeValue(dctx DecodeContext, vr ValueReader, val reflect.Value) error {
+	if !val.CanSet() || val.Type() != sc.t {
+		return ValueDecoderError{Name: "SQLNullDecodeValue", Types: []reflect.Type{sc.t}, Received: val}
+	}
+
+	val.Set(reflect.Zero(sc.t))
+	switch vr.Type() {
+	case TypeNull:
+		return vr.ReadNull()
+	case TypeUndefined:
+		return vr.ReadUndefined()
+	case TypeEmbeddedDocument:
+		return decodeLowercaseFields(dctx, vr, val)
+	}
+
+	inner := val.Field(0)
+	decoder, err := dctx.LookupDecoder(inner.Type())
+	if err != nil {
+		return err
+	}
+	if err := decoder.DecodeValue(dctx, vr, inner); err != nil {
+		return err
+	}
+
+	val.FieldByName("Valid").SetBool(true)
+	return nil
+}
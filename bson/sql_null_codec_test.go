@@ -0,0 +1,163 @@
+// Copyright (C) MongoDB, Inc. 2026-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bson
+
+import (
+	"bytes"
+	"database/sql"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
+)
+
+type testSQLNullDoc struct {
+	Name  sql.NullString `bson:"name"`
+	Count sql.NullInt64  `bson:"count"`
+}
+
+func TestSQLNullCodec(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+		doc  testSQLNullDoc
+		want []byte
+	}{
+		{
+			name: "valid",
+			doc: testSQLNullDoc{
+				Name:  sql.NullString{String: "alice", Valid: true},
+				Count: sql.NullInt64{Int64: 42, Valid: true},
+			},
+			want: bsoncore.NewDocumentBuilder().
+				AppendString("name", "alice").
+				AppendInt64("count", 42).
+				Build(),
+		},
+		{
+			name: "invalid",
+			doc: testSQLNullDoc{
+				Name:  sql.NullString{String: "ignored"},
+				Count: sql.NullInt64{},
+			},
+			want: bsoncore.NewDocumentBuilder().
+				AppendNull("name").
+				AppendNull("count").
+				Build(),
+		},
+		{
+			name: "valid zero values",
+			doc: testSQLNullDoc{
+				Name:  sql.NullString{Valid: true},
+				Count: sql.NullInt64{Valid: true},
+			},
+			want: bsoncore.NewDocumentBuilder().
+				AppendString("name", "").
+				AppendInt64("count", 0).
+				Build(),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc // Capture range variable.
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Marshal(tc.doc)
+			require.NoError(t, err, "Marshal error")
+			assert.Equal(t, Raw(tc.want), Raw(got), "expected %v, got %v", Raw(tc.want), Raw(got))
+
+			// Decode into a document with stale values to verify that null resets the Valid flag.
+			decoded := testSQLNullDoc{
+				Name:  sql.NullString{String: "stale", Valid: true},
+				Count: sql.NullInt64{Int64: 7, Valid: true},
+			}
+			require.NoError(t, Unmarshal(got, &decoded), "Unmarshal error")
+
+			// The value of an invalid Null* type is not encoded, so it decodes as the zero value.
+			want := tc.doc
+			if !want.Name.Valid {
+				want.Name = sql.NullString{}
+			}
+			assert.Equal(t, want, decoded, "expected %v, got %v", want, decoded)
+		})
+	}
+
+	t.Run("uses registered encoders for the value", func(t *testing.T) {
+		t.Parallel()
+
+		buf := new(bytes.Buffer)
+		enc := NewEncoder(NewDocumentWriter(buf))
+		enc.IntMinSize()
+		require.NoError(t, enc.Encode(testSQLNullDoc{Count: sql.NullInt64{Int64: 3, Valid: true}}), "Encode error")
+
+		got := Raw(buf.Bytes()).Lookup("count")
+		assert.Equal(t, TypeInt32, got.Type, "expected type %v, got %v", TypeInt32, got.Type)
+	})
+	t.Run("other types", func(t *testing.T) {
+		t.Parallel()
+
+		type doc struct {
+			Bool    sql.NullBool
+			Byte    sql.NullByte
+			Float64 sql.NullFloat64
+			Int16   sql.NullInt16
+			Int32   sql.NullInt32
+			Time    sql.NullTime
+			Missing sql.NullInt32
+		}
+		want := doc{
+			Bool:    sql.NullBool{Bool: true, Valid: true},
+			Byte:    sql.NullByte{Byte: 7, Valid: true},
+			Float64: sql.NullFloat64{Float64: 1.5, Valid: true},
+			Int16:   sql.NullInt16{Int16: -3, Valid: true},
+			Int32:   sql.NullInt32{Int32: 100, Valid: true},
+			Time:    sql.NullTime{Time: time.Unix(1700000000, 0).UTC(), Valid: true},
+		}
+
+		data, err := Marshal(want)
+		require.NoError(t, err, "Marshal error")
+
+		timeVal := Raw(data).Lookup("time")
+		assert.Equal(t, TypeDateTime, timeVal.Type, "expected type %v, got %v", TypeDateTime, timeVal.Type)
+
+		var got doc
+		require.NoError(t, Unmarshal(data, &got), "Unmarshal error")
+		assert.Equal(t, want, got, "expected %v, got %v", want, got)
+	})
+	t.Run("legacy subdocuments", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := Marshal(D{
+			{"name", D{{"string", "alice"}, {"valid", true}}},
+			{"count", D{{"int64", int64(42)}, {"valid", false}}},
+		})
+		require.NoError(t, err, "Marshal error")
+
+		var got testSQLNullDoc
+		require.NoError(t, Unmarshal(data, &got), "Unmarshal error")
+
+		want := testSQLNullDoc{
+			Name:  sql.NullString{String: "alice", Valid: true},
+			Count: sql.NullInt64{Int64: 42},
+		}
+		assert.Equal(t, want, got, "expected %v, got %v", want, got)
+	})
+	t.Run("decode error", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := Marshal(D{{"count", "not a number"}})
+		require.NoError(t, err, "Marshal error")
+
+		var got testSQLNullDoc
+		assert.Error(t, Unmarshal(data, &got), "expected Unmarshal error")
+		assert.False(t, got.Count.Valid, "expected Count to be invalid after a decode error")
+	})
+}
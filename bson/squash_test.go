@@ -0,0 +1,380 @@
+// Copyright (C) MongoDB, Inc. 2026-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bson
+
+import (
+	"bytes"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+)
+
+type squashMapDoc struct {
+	Name  string                 `bson:"name"`
+	Extra map[string]interface{} `bson:",squash"`
+}
+
+type squashDDoc struct {
+	Name  string `bson:"name"`
+	Extra D      `bson:",squash"`
+}
+
+type squashInner struct {
+	Inner string `bson:"inner"`
+	Extra M      `bson:",squash"`
+}
+
+type squashNestedDoc struct {
+	Name  string       `bson:"name"`
+	Inner squashInner  `bson:",squash"`
+	Ptr   *squashInner `bson:"ptr,omitempty"`
+}
+
+type squashNestedPtrDoc struct {
+	Name  string `bson:"name"`
+	Inner *struct {
+		Extra D `bson:",squash"`
+	} `bson:",squash"`
+}
+
+type squashOther struct {
+	Other string `bson:"other"`
+	Extra D      `bson:",squash"`
+}
+
+type squashOuterMapDoc struct {
+	Inner squashInner `bson:",squash"`
+	Extra M           `bson:",squash"`
+}
+
+type squashAmbiguousDoc struct {
+	Inner squashInner `bson:",squash"`
+	Other squashOther `bson:",squash"`
+}
+
+func TestSquash(t *testing.T) {
+	t.Parallel()
+
+	marshal := func(t *testing.T, val interface{}, conflict SquashConflict) ([]byte, error) {
+		t.Helper()
+
+		buf := new(bytes.Buffer)
+		enc := NewEncoder(NewDocumentWriter(buf))
+		enc.SquashConflict(conflict)
+		err := enc.Encode(val)
+		return buf.Bytes(), err
+	}
+
+	t.Run("encode", func(t *testing.T) {
+		t.Parallel()
+
+		testCases := []struct {
+			name string
+			val  interface{}
+			want D
+		}{
+			{
+				name: "map",
+				val:  squashMapDoc{Name: "a", Extra: map[string]interface{}{"x": int32(1)}},
+				want: D{{"name", "a"}, {"x", int32(1)}},
+			},
+			{
+				name: "nil map",
+				val:  squashMapDoc{Name: "a"},
+				want: D{{"name", "a"}},
+			},
+			{
+				name: "bson.D",
+				val:  squashDDoc{Name: "a", Extra: D{{"y", "b"}, {"x", int32(1)}, {"z", nil}}},
+				want: D{{"name", "a"}, {"y", "b"}, {"x", int32(1)}, {"z", nil}},
+			},
+			{
+				name: "nested",
+				val: squashNestedDoc{
+					Name:  "a",
+					Inner: squashInner{Inner: "b", Extra: M{"x": int32(1)}},
+					Ptr:   &squashInner{Inner: "c", Extra: M{"y": int32(2)}},
+				},
+				want: D{
+					{"name", "a"},
+					{"inner", "b"},
+					{"ptr", D{{"inner", "c"}, {"y", int32(2)}}},
+					{"x", int32(1)},
+				},
+			},
+			{
+				name: "nested nil pointer",
+				val:  squashNestedPtrDoc{Name: "a"},
+				want: D{{"name", "a"}},
+			},
+		}
+		for _, tc := range testCases {
+			tc := tc // Capture range variable.
+
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				got, err := marshal(t, tc.val, SquashConflictError)
+				require.NoError(t, err, "Encode error")
+
+				want, err := Marshal(tc.want)
+				require.NoError(t, err, "Marshal error")
+				assert.Equal(t, Raw(want), Raw(got), "expected document %v, got %v", Raw(want), Raw(got))
+			})
+		}
+	})
+	t.Run("decode", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := Marshal(D{{"name", "a"}, {"inner", "b"}, {"y", "c"}, {"x", int32(1)}})
+		require.NoError(t, err, "Marshal error")
+
+		t.Run("map", func(t *testing.T) {
+			t.Parallel()
+
+			var got squashMapDoc
+			err := Unmarshal(data, &got)
+			require.NoError(t, err, "Unmarshal error")
+
+			want := squashMapDoc{
+				Name:  "a",
+				Extra: map[string]interface{}{"inner": "b", "y": "c", "x": int32(1)},
+			}
+			assert.Equal(t, want, got, "expected %v, got %v", want, got)
+		})
+		t.Run("bson.D keeps document order", func(t *testing.T) {
+			t.Parallel()
+
+			got := squashDDoc{Extra: D{{"w", true}}}
+			err := Unmarshal(data, &got)
+			require.NoError(t, err, "Unmarshal error")
+
+			want := squashDDoc{
+				Name:  "a",
+				Extra: D{{"w", true}, {"inner", "b"}, {"y", "c"}, {"x", int32(1)}},
+			}
+			assert.Equal(t, want, got, "expected %v, got %v", want, got)
+		})
+		t.Run("nested", func(t *testing.T) {
+			t.Parallel()
+
+			var got squashNestedDoc
+			err := Unmarshal(data, &got)
+			require.NoError(t, err, "Unmarshal error")
+
+			want := squashNestedDoc{
+				Name:  "a",
+				Inner: squashInner{Inner: "b", Extra: M{"y": "c", "x": int32(1)}},
+			}
+			assert.Equal(t, want, got, "expected %v, got %v", want, got)
+		})
+		t.Run("nested nil pointer", func(t *testing.T) {
+			t.Parallel()
+
+			var got squashNestedPtrDoc
+			err := Unmarshal(data, &got)
+			require.NoError(t, err, "Unmarshal error")
+			require.NotNil(t, got.Inner, "expected inlined pointer to be allocated")
+
+			want := D{{"inner", "b"}, {"y", "c"}, {"x", int32(1)}}
+			assert.Equal(t, want, got.Inner.Extra, "expected %v, got %v", want, got.Inner.Extra)
+
+			var onlyKnown squashNestedPtrDoc
+			known, err := Marshal(D{{"name", "a"}})
+			require.NoError(t, err, "Marshal error")
+			err = Unmarshal(known, &onlyKnown)
+			require.NoError(t, err, "Unmarshal error")
+			assert.Nil(t, onlyKnown.Inner, "expected inlined pointer to stay nil without unknown keys")
+		})
+	})
+	t.Run("conflict", func(t *testing.T) {
+		t.Parallel()
+
+		testCases := []struct {
+			name     string
+			val      interface{}
+			conflict SquashConflict
+			want     D
+			wantErr  string
+		}{
+			{
+				name:     "map error",
+				val:      squashMapDoc{Name: "a", Extra: map[string]interface{}{"name": "b"}},
+				conflict: SquashConflictError,
+				wantErr:  "Key name of inlined map conflicts with a struct field name",
+			},
+			{
+				name:     "map struct field wins",
+				val:      squashMapDoc{Name: "a", Extra: map[string]interface{}{"name": "b", "x": int32(1)}},
+				conflict: SquashConflictStructFieldWins,
+				want:     D{{"name", "a"}, {"x", int32(1)}},
+			},
+			{
+				name:     "map field wins",
+				val:      squashMapDoc{Name: "a", Extra: map[string]interface{}{"name": "b"}},
+				conflict: SquashConflictMapFieldWins,
+				want:     D{{"name", "b"}},
+			},
+			{
+				name:     "bson.D error",
+				val:      squashDDoc{Name: "a", Extra: D{{"name", "b"}}},
+				conflict: SquashConflictError,
+				wantErr:  "Key name of inlined bson.D conflicts with a struct field name",
+			},
+			{
+				name:     "bson.D struct field wins",
+				val:      squashDDoc{Name: "a", Extra: D{{"x", int32(1)}, {"name", "b"}}},
+				conflict: SquashConflictStructFieldWins,
+				want:     D{{"name", "a"}, {"x", int32(1)}},
+			},
+			{
+				name:     "bson.D map field wins",
+				val:      squashDDoc{Name: "a", Extra: D{{"x", int32(1)}, {"name", "b"}}},
+				conflict: SquashConflictMapFieldWins,
+				want:     D{{"x", int32(1)}, {"name", "b"}},
+			},
+			{
+				name: "nested map field wins",
+				val: squashNestedDoc{
+					Name:  "a",
+					Inner: squashInner{Inner: "b", Extra: M{"name": "c"}},
+				},
+				conflict: SquashConflictMapFieldWins,
+				want:     D{{"inner", "b"}, {"name", "c"}},
+			},
+		}
+		for _, tc := range testCases {
+			tc := tc // Capture range variable.
+
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				got, err := marshal(t, tc.val, tc.conflict)
+				if tc.wantErr != "" {
+					require.Error(t, err, "expected Encode error, got nil")
+					assert.Contains(t, err.Error(), tc.wantErr)
+					return
+				}
+				require.NoError(t, err, "Encode error")
+
+				want, err := Marshal(tc.want)
+				require.NoError(t, err, "Marshal error")
+				assert.Equal(t, Raw(want), Raw(got), "expected document %v, got %v", Raw(want), Raw(got))
+			})
+		}
+	})
+	t.Run("nested inline maps", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := Marshal(D{{"inner", "b"}, {"other", "c"}, {"x", int32(1)}})
+		require.NoError(t, err, "Marshal error")
+
+		t.Run("outer map wins", func(t *testing.T) {
+			t.Parallel()
+
+			got, err := marshal(t, squashOuterMapDoc{
+				Inner: squashInner{Inner: "b", Extra: M{"x": int32(1)}},
+				Extra: M{"y": int32(2)},
+			}, SquashConflictError)
+			require.NoError(t, err, "Encode error")
+
+			want, err := Marshal(D{{"inner", "b"}, {"y", int32(2)}})
+			require.NoError(t, err, "Marshal error")
+			assert.Equal(t, Raw(want), Raw(got), "expected document %v, got %v", Raw(want), Raw(got))
+
+			var decoded squashOuterMapDoc
+			err = Unmarshal(data, &decoded)
+			require.NoError(t, err, "Unmarshal error")
+
+			wantDecoded := squashOuterMapDoc{
+				Inner: squashInner{Inner: "b"},
+				Extra: M{"other": "c", "x": int32(1)},
+			}
+			assert.Equal(t, wantDecoded, decoded, "expected %v, got %v", wantDecoded, decoded)
+		})
+		t.Run("map and nested map", func(t *testing.T) {
+			t.Parallel()
+
+			// An outer inline map next to an inlined struct with its own inline map used to be rejected with
+			// "multiple inline maps". The outer map is now used and the nested one is ignored.
+			val := struct {
+				Inner squashInner `bson:",squash"`
+				M     M           `bson:",squash"`
+			}{
+				Inner: squashInner{Inner: "b", Extra: M{"x": int32(1)}},
+				M:     M{"y": int32(2)},
+			}
+
+			got, err := marshal(t, val, SquashConflictError)
+			require.NoError(t, err, "Encode error")
+
+			want, err := Marshal(D{{"inner", "b"}, {"y", int32(2)}})
+			require.NoError(t, err, "Marshal error")
+			assert.Equal(t, Raw(want), Raw(got), "expected document %v, got %v", Raw(want), Raw(got))
+		})
+		t.Run("ambiguous maps are ignored", func(t *testing.T) {
+			t.Parallel()
+
+			got, err := marshal(t, squashAmbiguousDoc{
+				Inner: squashInner{Inner: "b", Extra: M{"x": int32(1)}},
+				Other: squashOther{Other: "c", Extra: D{{"y", int32(2)}}},
+			}, SquashConflictError)
+			require.NoError(t, err, "Encode error")
+
+			want, err := Marshal(D{{"inner", "b"}, {"other", "c"}})
+			require.NoError(t, err, "Marshal error")
+			assert.Equal(t, Raw(want), Raw(got), "expected document %v, got %v", Raw(want), Raw(got))
+
+			var decoded squashAmbiguousDoc
+			err = Unmarshal(data, &decoded)
+			require.NoError(t, err, "Unmarshal error")
+
+			wantDecoded := squashAmbiguousDoc{
+				Inner: squashInner{Inner: "b"},
+				Other: squashOther{Other: "c"},
+			}
+			assert.Equal(t, wantDecoded, decoded, "expected %v, got %v", wantDecoded, decoded)
+		})
+	})
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		testCases := []struct {
+			name    string
+			val     interface{}
+			wantErr string
+		}{
+			{
+				name: "slice other than bson.D",
+				val: struct {
+					Extra []E `bson:",squash"`
+				}{},
+				wantErr: "inline fields must be a struct, a struct pointer, a map, a bson.D, or an interface",
+			},
+			{
+				name: "map and bson.D",
+				val: struct {
+					M M `bson:",squash"`
+					D D `bson:",squash"`
+				}{},
+				wantErr: "multiple inline maps",
+			},
+		}
+		for _, tc := range testCases {
+			tc := tc // Capture range variable.
+
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				_, err := Marshal(tc.val)
+				require.Error(t, err, "expected Marshal error, got nil")
+				assert.Contains(t, err.Error(), tc.wantErr)
+			})
+		}
+	})
+}
@@ -0,0 +1,95 @@
+// Copyright (C) MongoDB, Inc. 2026-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bson
+
+import (
+	"fmt"
+	"reflect"
+)
+
+var tStringer = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+
+// StringEnumParser parses the string representation of an enum value into a value of the enum
+// type. It is the inverse of the enum type's String method.
+type StringEnumParser func(string) (interface{}, error)
+
+// stringEnumCodec is the Codec used for struct fields tagged with "stringenum". It encodes values
+// as BSON strings using their fmt.Stringer implementation and decodes BSON strings using a
+// StringEnumParser registered with the Registry.
+type stringEnumCodec struct {
+	t     reflect.Type
+	parse StringEnumParser
+}
+
+func newStringEnumCodec(r *Registry, t reflect.Type) (*stringEnumCodec, error) {
+	if !t.Implements(tStringer) {
+		return nil, fmt.Errorf("stringenum field type %s does not implement fmt.Stringer", t)
+	}
+
+	parse, ok := r.lookupStringEnumParser(t)
+	if !ok {
+		return nil, fmt.Errorf("no string enum parser registered for type %s", t)
+	}
+
+	return &stringEnumCodec{t: t, parse: parse}, nil
+}
+
+// EncodeValue is the ValueEncoder for string enum types.
+func (sec *stringEnumCodec) EncodeValue(_ EncodeContext, vw ValueWriter, val reflect.Value) error {
+	if !val.IsValid() || val.Type() != sec.t {
+		return ValueEncoderError{Name: "StringEnumEncodeValue", Types: []reflect.Type{sec.t}, Received: val}
+	}
+
+	// A nil pointer enum has no String value to call, so encode it as null. DecodeValue turns null
+	// back into the zero value, which keeps the round trip symmetric.
+	if val.Kind() == reflect.Ptr && val.IsNil() {
+		return vw.WriteNull()
+	}
+
+	return vw.WriteString(val.Interface().(fmt.Stringer).String())
+}
+
+// DecodeValue is the ValueDecoder for string enum types.
+func (sec *stringEnumCodec) DecodeValue(_ DecodeContext, vr ValueReader, val reflect.Value) error {
+	if !val.CanSet() || val.Type() != sec.t {
+		return ValueDecoderError{Name: "StringEnumDecodeValue", Types: []reflect.Type{sec.t}, Received: val}
+	}
+
+	switch vrType := vr.Type(); vrType {
+	case TypeString:
+		str, err := vr.ReadString()
+		if err != nil {
+			return err
+		}
+
+		parsed, err := sec.parse(str)
+		if err != nil {
+			return fmt.Errorf("error parsing %q as %s: %w", str, sec.t, err)
+		}
+
+		rv := reflect.ValueOf(parsed)
+		if !rv.IsValid() || !rv.Type().AssignableTo(sec.t) {
+			return fmt.Errorf("string enum parser for %s returned a value of type %T", sec.t, parsed)
+		}
+
+		val.Set(rv)
+		return nil
+	case TypeNull:
+		if err := vr.ReadNull(); err != nil {
+			return err
+		}
+	case TypeUndefined:
+		if err := vr.ReadUndefined(); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("cannot decode %v into a string enum type %s", vrType, sec.t)
+	}
+
+	val.Set(reflect.Zero(sec.t))
+	return nil
+}
@@ -0,0 +1,168 @@
+// Copyright (C) MongoDB, Inc. 2026-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bson
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+)
+
+type testColor int
+
+const (
+	testColorRed testColor = iota
+	testColorGreen
+)
+
+func (c testColor) String() string {
+	switch c {
+	case testColorRed:
+		return "red"
+	case testColorGreen:
+		return "green"
+	}
+	return fmt.Sprintf("testColor(%d)", int(c))
+}
+
+func parseTestColor(s string) (interface{}, error) {
+	switch s {
+	case "red":
+		return testColorRed, nil
+	case "green":
+		return testColorGreen, nil
+	}
+	return nil, fmt.Errorf("unknown color %q", s)
+}
+
+type testColorDoc struct {
+	Color testColor `bson:"color,stringenum"`
+	Other testColor `bson:"other"`
+}
+
+func TestStringEnumCodec(t *testing.T) {
+	t.Parallel()
+
+	reg := NewRegistry()
+	reg.RegisterStringEnum(reflect.TypeOf(testColor(0)), parseTestColor)
+
+	marshal := func(t *testing.T, reg *Registry, val interface{}) ([]byte, error) {
+		t.Helper()
+
+		buf := new(bytes.Buffer)
+		enc := NewEncoder(NewDocumentWriter(buf))
+		enc.SetRegistry(reg)
+		err := enc.Encode(val)
+		return buf.Bytes(), err
+	}
+	unmarshal := func(t *testing.T, reg *Registry, data []byte, val interface{}) error {
+		t.Helper()
+
+		dec := NewDecoder(NewDocumentReader(bytes.NewReader(data)))
+		dec.SetRegistry(reg)
+		return dec.Decode(val)
+	}
+
+	t.Run("round trip", func(t *testing.T) {
+		t.Parallel()
+
+		doc := testColorDoc{Color: testColorGreen, Other: testColorGreen}
+
+		data, err := marshal(t, reg, doc)
+		require.NoError(t, err, "Encode error")
+
+		color, err := Raw(data).LookupErr("color")
+		require.NoError(t, err, "LookupErr error")
+		assert.Equal(t, TypeString, color.Type, "expected type %v, got %v", TypeString, color.Type)
+		assert.Equal(t, "green", color.StringValue(), "expected value %q, got %q", "green", color.StringValue())
+
+		// Fields without the stringenum tag are still encoded as numbers.
+		other, err := Raw(data).LookupErr("other")
+		require.NoError(t, err, "LookupErr error")
+		assert.True(t, other.IsNumber(), "expected a number, got %v", other.Type)
+
+		var got testColorDoc
+		err = unmarshal(t, reg, data, &got)
+		require.NoError(t, err, "Decode error")
+		assert.Equal(t, doc, got, "expected document %v, got %v", doc, got)
+	})
+	t.Run("nil pointer", func(t *testing.T) {
+		t.Parallel()
+
+		type ptrColorDoc struct {
+			Color *testColor `bson:"color,stringenum"`
+		}
+
+		ptrReg := NewRegistry()
+		ptrReg.RegisterStringEnum(reflect.TypeOf((*testColor)(nil)), func(s string) (interface{}, error) {
+			c, err := parseTestColor(s)
+			if err != nil {
+				return nil, err
+			}
+			color := c.(testColor)
+			return &color, nil
+		})
+
+		data, err := marshal(t, ptrReg, ptrColorDoc{})
+		require.NoError(t, err, "Encode error")
+
+		color, err := Raw(data).LookupErr("color")
+		require.NoError(t, err, "LookupErr error")
+		assert.Equal(t, TypeNull, color.Type, "expected type %v, got %v", TypeNull, color.Type)
+
+		got := ptrColorDoc{Color: new(testColor)}
+		err = unmarshal(t, ptrReg, data, &got)
+		require.NoError(t, err, "Decode error")
+		assert.Nil(t, got.Color, "expected nil color, got %v", got.Color)
+
+		green := testColorGreen
+		data, err = marshal(t, ptrReg, ptrColorDoc{Color: &green})
+		require.NoError(t, err, "Encode error")
+
+		err = unmarshal(t, ptrReg, data, &got)
+		require.NoError(t, err, "Decode error")
+		require.NotNil(t, got.Color, "expected a color, got nil")
+		assert.Equal(t, testColorGreen, *got.Color, "expected color %v, got %v", testColorGreen, *got.Color)
+	})
+	t.Run("unknown string", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := Marshal(D{{"color", "blue"}})
+		require.NoError(t, err, "Marshal error")
+
+		var got testColorDoc
+		err = unmarshal(t, reg, data, &got)
+		require.Error(t, err, "expected Decode error, got nil")
+
+		var de *DecodeError
+		require.True(t, errors.As(err, &de), "expected a DecodeError, got %T", err)
+		assert.Equal(t, []string{"color"}, de.Keys(), "expected keys %v, got %v", []string{"color"}, de.Keys())
+		assert.Contains(t, err.Error(), `unknown color "blue"`)
+	})
+	t.Run("no registered parser", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := marshal(t, NewRegistry(), testColorDoc{})
+		assert.Error(t, err, "expected Encode error, got nil")
+	})
+	t.Run("register non-Stringer panics", func(t *testing.T) {
+		t.Parallel()
+
+		defer func() {
+			assert.NotNil(t, recover(), "expected RegisterStringEnum to panic")
+		}()
+
+		NewRegistry().RegisterStringEnum(reflect.TypeOf(0), func(string) (interface{}, error) {
+			return 0, nil
+		})
+	})
+}
@@ -12,7 +12,6 @@ import (
 	"reflect"
 	"sort"
 	"strings"
-	"sync"
 	"time"
 )
 
@@ -47,6 +46,31 @@ func (de *DecodeError) Keys() []string {
 	return reversedKeys
 }
 
+// errMissingField is returned when a required struct field has no corresponding BSON element.
+var errMissingField = errors.New("required field is missing")
+
+// ErrNilInterface is returned when a document field can only be decoded into a struct's inlined interface field and
+// that field is nil. An inlined interface field must hold a pointer to a struct before decoding so the decoder knows
+// which concrete type to decode into.
+var ErrNilInterface = errors.New("cannot decode into a nil inlined interface field")
+
+// SquashConflict determines how the keys of a map or bson.D field with the "inline" or "squash" struct tag option are
+// encoded when they collide with the names of other struct fields. It only affects encoding: when decoding, a key that
+// names a struct field is always decoded into that field.
+type SquashConflict int
+
+// These constants specify the valid SquashConflict values.
+const (
+	// SquashConflictError returns an error when a key collides with a struct field name. This is the default.
+	SquashConflictError SquashConflict = iota
+
+	// SquashConflictStructFieldWins encodes the struct field and omits the colliding key.
+	SquashConflictStructFieldWins
+
+	// SquashConflictMapFieldWins encodes the colliding key and omits the struct field.
+	SquashConflictMapFieldWins
+)
+
 // mapElementsEncoder handles encoding of the values of an inline  map.
 type mapElementsEncoder interface {
 	encodeMapElements(EncodeContext, DocumentWriter, reflect.Value, func(string) bool) error
@@ -54,7 +78,7 @@ type mapElementsEncoder interface {
 
 // structCodec is the Codec used for struct values.
 type structCodec struct {
-	cache            sync.Map // map[reflect.Type]*structDescription
+	cache            structDescriptionCache
 	inlineMapEncoder mapElementsEncoder
 
 	// decodeZeroStruct causes DecodeValue to delete any existing values from Go structs in the
@@ -107,8 +131,61 @@ func (sc *structCodec) EncodeValue(ec EncodeContext, vw ValueWriter, val reflect
 	if err != nil {
 		return err
 	}
+
+	if err := sc.encodeElements(ec, dw, sd, val, nil); err != nil {
+		return err
+	}
+
+	return dw.WriteDocumentEnd()
+}
+
+// encodeElements writes the fields of val, described by sd, to dw. Fields whose names are reported by skip are
+// omitted; this is used to give fields of an outer struct precedence over fields of an inlined interface value.
+func (sc *structCodec) encodeElements(
+	ec EncodeContext,
+	dw DocumentWriter,
+	sd *structDescription,
+	val reflect.Value,
+	skip func(string) bool,
+) error {
+	var inlineMap reflect.Value
+	if sd.inlineMap != nil {
+		if rv, err := fieldByIndexErr(val, sd.inlineMap); err == nil {
+			inlineMap = rv
+		}
+	}
+
+	// Keys of the inline map collide with the fields of val and of any inlined interface values. If the map wins,
+	// skip those fields; otherwise the map encoder reports or skips the colliding keys.
+	mapCollisionFn := func(key string) bool {
+		if _, exists := sd.fm[key]; exists {
+			return true
+		}
+		return skip != nil && skip(key)
+	}
+	if inlineMap.IsValid() && ec.squashConflict == SquashConflictMapFieldWins {
+		mapCollisionFn = skip
+		if keys := inlineMapKeys(inlineMap); len(keys) > 0 {
+			outer := skip
+			skip = func(name string) bool {
+				if _, ok := keys[name]; ok {
+					return true
+				}
+				return outer != nil && outer(name)
+			}
+		}
+	}
+
 	var rv reflect.Value
+	var err error
 	for _, desc := range sd.fl {
+		if skip != nil && skip(desc.name) {
+			continue
+		}
+		if err := ec.cancel.tick(); err != nil {
+			return err
+		}
+
 		if desc.inline == nil {
 			rv = val.Field(desc.idx)
 		} else {
@@ -132,6 +209,12 @@ func (sc *structCodec) EncodeValue(ec EncodeContext, vw ValueWriter, val reflect
 			if desc.omitEmpty {
 				continue
 			}
+			if ec.hook != nil {
+				if err := encodeHookedElement(ec, dw, desc.name, nil, reflect.Value{}); err != nil {
+					return err
+				}
+				continue
+			}
 			vw2, err := dw.WriteDocumentElement(desc.name)
 			if err != nil {
 				return err
@@ -161,11 +244,6 @@ func (sc *structCodec) EncodeValue(ec EncodeContext, vw ValueWriter, val reflect
 			continue
 		}
 
-		vw2, err := dw.WriteDocumentElement(desc.name)
-		if err != nil {
-			return err
-		}
-
 		ectx := EncodeContext{
 			Registry:                ec.Registry,
 			minSize:                 desc.minSize || ec.minSize,
@@ -174,8 +252,25 @@ func (sc *structCodec) EncodeValue(ec EncodeContext, vw ValueWriter, val reflect
 			nilMapAsEmpty:           ec.nilMapAsEmpty,
 			nilSliceAsEmpty:         ec.nilSliceAsEmpty,
 			nilByteSliceAsEmpty:     ec.nilByteSliceAsEmpty,
+			ipAsString:              ec.ipAsString,
+			durationUnit:            ec.durationUnit,
+			durationAsString:        ec.durationAsString,
+			squashConflict:          ec.squashConflict,
 			omitZeroStruct:          ec.omitZeroStruct,
 			useJSONStructTags:       ec.useJSONStructTags,
+			cancel:                  ec.cancel,
+			hook:                    ec.hook,
+		}
+		if ec.hook != nil {
+			if err := encodeHookedElement(ectx, dw, desc.name, encoder, rv); err != nil {
+				return err
+			}
+			continue
+		}
+
+		vw2, err := dw.WriteDocumentElement(desc.name)
+		if err != nil {
+			return err
 		}
 		err = encoder.EncodeValue(ectx, vw2, rv)
 		if err != nil {
@@ -183,20 +278,112 @@ func (sc *structCodec) EncodeValue(ec EncodeContext, vw ValueWriter, val reflect
 		}
 	}
 
-	if sd.inlineMap >= 0 {
-		rv := val.Field(sd.inlineMap)
-		collisionFn := func(key string) bool {
-			_, exists := sd.fm[key]
-			return exists
+	collisionFn := func(key string) bool {
+		if _, exists := sd.fm[key]; exists {
+			return true
+		}
+		return skip != nil && skip(key)
+	}
+
+	for _, ii := range sd.inlineInterfaces {
+		rv, err := fieldByIndexErr(val, ii.index)
+		if err != nil || rv.IsNil() {
+			continue
+		}
+		rv = rv.Elem()
+		if rv.Kind() == reflect.Ptr {
+			if rv.IsNil() {
+				continue
+			}
+			rv = rv.Elem()
+		}
+		if rv.Kind() != reflect.Struct {
+			return fmt.Errorf("inline interface field %s must hold a struct or a struct pointer, got %s",
+				ii.fieldName, rv.Type())
+		}
+
+		isd, err := sc.describeStruct(ec.Registry, rv.Type(), ec.useJSONStructTags, ec.errorOnInlineDuplicates)
+		if err != nil {
+			return err
+		}
+		if err := sc.encodeElements(ec, dw, isd, rv, collisionFn); err != nil {
+			return err
+		}
+	}
+
+	if inlineMap.IsValid() {
+		if inlineMap.Type() == tD {
+			err = encodeInlineD(ec, dw, inlineMap.Interface().(D), mapCollisionFn)
+		} else {
+			err = sc.inlineMapEncoder.encodeMapElements(ec, dw, inlineMap, mapCollisionFn)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// inlineMapKeys returns the keys of v, the value of an inline map or bson.D field.
+func inlineMapKeys(v reflect.Value) map[string]struct{} {
+	keys := make(map[string]struct{})
+	if d, ok := v.Interface().(D); ok {
+		for _, e := range d {
+			keys[e.Key] = struct{}{}
+		}
+		return keys
+	}
+	for _, key := range v.MapKeys() {
+		keys[key.String()] = struct{}{}
+	}
+	return keys
+}
+
+// encodeInlineD writes the elements of d, the value of an inline bson.D field, to dw.
+func encodeInlineD(ec EncodeContext, dw DocumentWriter, d D, collisionFn func(string) bool) error {
+	for _, e := range d {
+		if err := ec.cancel.tick(); err != nil {
+			return err
+		}
+
+		if collisionFn != nil && collisionFn(e.Key) {
+			if ec.squashConflict == SquashConflictStructFieldWins {
+				continue
+			}
+			return fmt.Errorf("Key %s of inlined bson.D conflicts with a struct field name", e.Key)
+		}
+
+		if ec.hook != nil {
+			if err := encodeHookedElement(ec, dw, e.Key, nil, reflect.ValueOf(e.Value)); err != nil {
+				return err
+			}
+			continue
+		}
+
+		vw, err := dw.WriteDocumentElement(e.Key)
+		if err != nil {
+			return err
 		}
 
-		err = sc.inlineMapEncoder.encodeMapElements(ec, dw, rv, collisionFn)
+		if e.Value == nil {
+			if err := vw.WriteNull(); err != nil {
+				return err
+			}
+			continue
+		}
+
+		rv := reflect.ValueOf(e.Value)
+		encoder, err := ec.LookupEncoder(rv.Type())
 		if err != nil {
 			return err
 		}
+		if err := encoder.EncodeValue(ec, vw, rv); err != nil {
+			return err
+		}
 	}
 
-	return dw.WriteDocumentEnd()
+	return nil
 }
 
 func newDecodeError(key string, original error) error {
@@ -252,11 +439,17 @@ func (sc *structCodec) DecodeValue(dc DecodeContext, vr ValueReader, val reflect
 		val.Set(deepZero(val.Type()))
 	}
 
+	// The inline map or bson.D field is only resolved when the first unknown key is found so that nil pointers to
+	// inlined structs that contain it are not allocated unnecessarily.
 	var decoder ValueDecoder
+	var elemType reflect.Type
 	var inlineMap reflect.Value
-	if sd.inlineMap >= 0 {
-		inlineMap = val.Field(sd.inlineMap)
-		decoder, err = dc.LookupDecoder(inlineMap.Type().Elem())
+	if sd.inlineMap != nil {
+		elemType = tEmpty
+		if mapType := val.Type().FieldByIndex(sd.inlineMap).Type; mapType.Kind() == reflect.Map {
+			elemType = mapType.Elem()
+		}
+		decoder, err = dc.LookupDecoder(elemType)
 		if err != nil {
 			return err
 		}
@@ -267,7 +460,16 @@ func (sc *structCodec) DecodeValue(dc DecodeContext, vr ValueReader, val reflect
 		return err
 	}
 
+	var seen map[string]struct{}
+	if dc.requireAllFields {
+		seen = make(map[string]struct{}, len(sd.fl))
+	}
+
 	for {
+		if err := dc.cancel.tick(); err != nil {
+			return err
+		}
+
 		name, vr, err := dr.ReadElement()
 		if errors.Is(err, ErrEOD) {
 			break
@@ -284,8 +486,18 @@ func (sc *structCodec) DecodeValue(dc DecodeContext, vr ValueReader, val reflect
 			fd, exists = sd.fm[strings.ToLower(name)]
 		}
 
+		if !exists && len(sd.inlineInterfaces) > 0 {
+			found, err := sc.decodeInlineInterfaceElement(dc, vr, sd, val, name)
+			if err != nil {
+				return err
+			}
+			if found {
+				continue
+			}
+		}
+
 		if !exists {
-			if sd.inlineMap < 0 {
+			if sd.inlineMap == nil {
 				// The encoding/json package requires a flag to return on error for non-existent fields.
 				// This functionality seems appropriate for the struct codec.
 				err = vr.Skip()
@@ -295,76 +507,177 @@ func (sc *structCodec) DecodeValue(dc DecodeContext, vr ValueReader, val reflect
 				continue
 			}
 
-			if inlineMap.IsNil() {
-				inlineMap.Set(reflect.MakeMap(inlineMap.Type()))
+			if !inlineMap.IsValid() {
+				inlineMap, err = getInlineField(val, sd.inlineMap)
+				if err != nil {
+					return err
+				}
 			}
 
-			elem := reflect.New(inlineMap.Type().Elem()).Elem()
+			elem := reflect.New(elemType).Elem()
 			err = decoder.DecodeValue(dc, vr, elem)
 			if err != nil {
 				return err
 			}
+
+			if inlineMap.Kind() == reflect.Slice {
+				inlineMap.Set(reflect.Append(inlineMap, reflect.ValueOf(E{Key: name, Value: elem.Interface()})))
+				continue
+			}
+			if inlineMap.IsNil() {
+				inlineMap.Set(reflect.MakeMap(inlineMap.Type()))
+			}
 			inlineMap.SetMapIndex(reflect.ValueOf(name), elem)
 			continue
 		}
 
-		var field reflect.Value
-		if fd.inline == nil {
-			field = val.Field(fd.idx)
-		} else {
-			field, err = getInlineField(val, fd.inline)
-			if err != nil {
-				return err
-			}
+		if seen != nil {
+			seen[fd.name] = struct{}{}
 		}
 
-		if field.Kind() == reflect.Interface && !field.IsNil() && field.Elem().Kind() == reflect.Ptr {
-			v := field.Elem().Elem()
-			decoder, err = dc.LookupDecoder(v.Type())
-			if err != nil {
-				return err
+		if err := sc.decodeElement(dc, vr, val, fd); err != nil {
+			return err
+		}
+	}
+
+	if seen != nil {
+		for _, fd := range sd.fl {
+			if fd.omitEmpty || fd.pointer {
+				continue
 			}
-			err = decoder.DecodeValue(dc, vr, v)
-			if err != nil {
-				return newDecodeError(fd.name, err)
+			if _, ok := seen[fd.name]; !ok {
+				return newDecodeError(fd.name, errMissingField)
 			}
-			continue
 		}
+	}
 
-		if !field.CanSet() { // Being settable is a super set of being addressable.
-			innerErr := fmt.Errorf("field %v is not settable", field)
-			return newDecodeError(fd.name, innerErr)
-		}
-		if field.Kind() == reflect.Ptr && field.IsNil() {
-			field.Set(reflect.New(field.Type().Elem()))
-		}
-		field = field.Addr()
+	return nil
+}
 
-		dctx := DecodeContext{
-			Registry:            dc.Registry,
-			truncate:            fd.truncate || dc.truncate,
-			defaultDocumentType: dc.defaultDocumentType,
-			binaryAsSlice:       dc.binaryAsSlice,
-			objectIDAsHexString: dc.objectIDAsHexString,
-			useJSONStructTags:   dc.useJSONStructTags,
-			useLocalTimeZone:    dc.useLocalTimeZone,
-			zeroMaps:            dc.zeroMaps,
-			zeroStructs:         dc.zeroStructs,
+// decodeElement decodes the value read from vr into the field of val described by fd.
+func (sc *structCodec) decodeElement(dc DecodeContext, vr ValueReader, val reflect.Value, fd fieldDescription) error {
+	var field reflect.Value
+	if fd.inline == nil {
+		field = val.Field(fd.idx)
+	} else {
+		var err error
+		field, err = getInlineField(val, fd.inline)
+		if err != nil {
+			return err
 		}
+	}
 
-		if fd.decoder == nil {
-			return newDecodeError(fd.name, errNoDecoder{Type: field.Elem().Type()})
+	if field.Kind() == reflect.Interface && !field.IsNil() && field.Elem().Kind() == reflect.Ptr {
+		v := field.Elem().Elem()
+		decoder, err := dc.LookupDecoder(v.Type())
+		if err != nil {
+			return err
 		}
-
-		err = fd.decoder.DecodeValue(dctx, vr, field.Elem())
+		err = decoder.DecodeValue(dc, vr, v)
 		if err != nil {
 			return newDecodeError(fd.name, err)
 		}
+		return nil
+	}
+
+	if !field.CanSet() { // Being settable is a super set of being addressable.
+		innerErr := fmt.Errorf("field %v is not settable", field)
+		return newDecodeError(fd.name, innerErr)
+	}
+	if field.Kind() == reflect.Ptr && field.IsNil() {
+		field.Set(reflect.New(field.Type().Elem()))
+	}
+	field = field.Addr()
+
+	dctx := DecodeContext{
+		Registry:            dc.Registry,
+		truncate:            fd.truncate || dc.truncate,
+		defaultDocumentType: dc.defaultDocumentType,
+		binaryAsSlice:       dc.binaryAsSlice,
+		objectIDAsHexString: dc.objectIDAsHexString,
+		useJSONStructTags:   dc.useJSONStructTags,
+		useLocalTimeZone:    dc.useLocalTimeZone,
+		zeroMaps:            dc.zeroMaps,
+		zeroStructs:         dc.zeroStructs,
+		requireAllFields:    dc.requireAllFields,
+		durationUnit:        dc.durationUnit,
+		cancel:              dc.cancel,
 	}
 
+	if fd.decoder == nil {
+		return newDecodeError(fd.name, errNoDecoder{Type: field.Elem().Type()})
+	}
+
+	err := fd.decoder.DecodeValue(dctx, vr, field.Elem())
+	if err != nil {
+		return newDecodeError(fd.name, err)
+	}
 	return nil
 }
 
+// inlineInterfaceStruct returns the struct held by the inlined interface field ii of val. The interface must hold a
+// non-nil pointer to a struct so the struct can be decoded into.
+func inlineInterfaceStruct(val reflect.Value, ii inlineInterface) (reflect.Value, error) {
+	field, err := fieldByIndexErr(val, ii.index)
+	if err != nil || field.IsNil() {
+		return reflect.Value{}, newDecodeError(ii.fieldName, ErrNilInterface)
+	}
+
+	elem := field.Elem()
+	if elem.Kind() != reflect.Ptr || elem.Type().Elem().Kind() != reflect.Struct {
+		err := fmt.Errorf("inline interface field must hold a struct pointer to be decoded into, got %s", elem.Type())
+		return reflect.Value{}, newDecodeError(ii.fieldName, err)
+	}
+	if elem.IsNil() {
+		return reflect.Value{}, newDecodeError(ii.fieldName, ErrNilInterface)
+	}
+	return elem.Elem(), nil
+}
+
+// decodeInlineInterfaceElement decodes the value read from vr into the field named name of a struct held by one of
+// the inlined interface fields of val. It reports whether such a field was found. Inlined interfaces that cannot be
+// decoded into (e.g. nil ones) are skipped, and their error is only returned if no other interface has the field.
+func (sc *structCodec) decodeInlineInterfaceElement(
+	dc DecodeContext,
+	vr ValueReader,
+	sd *structDescription,
+	val reflect.Value,
+	name string,
+) (bool, error) {
+	var firstErr error
+	for _, ii := range sd.inlineInterfaces {
+		target, err := inlineInterfaceStruct(val, ii)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+
+		isd, err := sc.describeStruct(dc.Registry, target.Type(), dc.useJSONStructTags, false)
+		if err != nil {
+			return false, err
+		}
+
+		fd, exists := isd.fm[name]
+		if !exists {
+			fd, exists = isd.fm[strings.ToLower(name)]
+		}
+		if exists {
+			return true, sc.decodeElement(dc, vr, target, fd)
+		}
+
+		found, err := sc.decodeInlineInterfaceElement(dc, vr, isd, target, name)
+		if found {
+			return true, err
+		}
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return false, firstErr
+}
+
 func isEmpty(v reflect.Value, omitZeroStruct bool) bool {
 	kind := v.Kind()
 	if (kind != reflect.Ptr || !v.IsNil()) && v.Type().Implements(tZeroer) {
@@ -397,10 +710,18 @@ func isEmpty(v reflect.Value, omitZeroStruct bool) bool {
 }
 
 type structDescription struct {
-	fm        map[string]fieldDescription
-	fl        []fieldDescription
-	inlineMap int
-	inline    bool
+	fm               map[string]fieldDescription
+	fl               []fieldDescription
+	inlineMap        []int // index sequence of the inline map or bson.D field in the top level struct, or nil
+	inline           bool
+	inlineInterfaces []inlineInterface
+}
+
+// inlineInterface describes an interface field with the inline struct tag. The fields of its concrete value are only
+// known at encode and decode time.
+type inlineInterface struct {
+	fieldName string // struct field name
+	index     []int  // index sequence of the field in the top level struct
 }
 
 type fieldDescription struct {
@@ -410,6 +731,7 @@ type fieldDescription struct {
 	omitEmpty bool
 	minSize   bool
 	truncate  bool
+	pointer   bool
 	inline    []int
 	encoder   ValueEncoder
 	decoder   ValueDecoder
@@ -452,8 +774,8 @@ func (sc *structCodec) describeStruct(
 ) (*structDescription, error) {
 	// We need to analyze the struct, including getting the tags, collecting
 	// information about inlining, and create a map of the field name to the field.
-	if v, ok := sc.cache.Load(t); ok {
-		return v.(*structDescription), nil
+	if sd, ok := sc.cache.Load(t); ok {
+		return sd, nil
 	}
 	// TODO(charlie): Only describe the struct once when called
 	// concurrently with the same type.
@@ -461,10 +783,7 @@ func (sc *structCodec) describeStruct(
 	if err != nil {
 		return nil, err
 	}
-	if v, loaded := sc.cache.LoadOrStore(t, ds); loaded {
-		ds = v.(*structDescription)
-	}
-	return ds, nil
+	return sc.cache.LoadOrStore(t, ds), nil
 }
 
 func (sc *structCodec) describeStructSlow(
@@ -475,11 +794,15 @@ func (sc *structCodec) describeStructSlow(
 ) (*structDescription, error) {
 	numFields := t.NumField()
 	sd := &structDescription{
-		fm:        make(map[string]fieldDescription, numFields),
-		fl:        make([]fieldDescription, 0, numFields),
-		inlineMap: -1,
+		fm: make(map[string]fieldDescription, numFields),
+		fl: make([]fieldDescription, 0, numFields),
 	}
 
+	// An inline map of an inlined struct is only used if the struct has no inline map of its own. As with
+	// struct fields, the shallowest one wins and ones that are ambiguous at the same depth are ignored.
+	var nestedInlineMap []int
+	var nestedInlineMapAmbiguous bool
+
 	var fields []fieldDescription
 	for i := 0; i < numFields; i++ {
 		sf := t.Field(i)
@@ -523,18 +846,55 @@ func (sc *structCodec) describeStructSlow(
 		description.omitEmpty = stags.OmitEmpty
 		description.minSize = stags.MinSize
 		description.truncate = stags.Truncate
+		description.pointer = sfType.Kind() == reflect.Ptr
+
+		if stags.StringEnum {
+			sec, err := newStringEnumCodec(r, sfType)
+			if err != nil {
+				return nil, fmt.Errorf("(struct %s) field %s: %w", t.String(), sf.Name, err)
+			}
+			description.encoder = sec
+			description.decoder = sec
+		}
+
+		if stags.Timestamp {
+			ttc, err := newTimestampTimeCodec(sfType)
+			if err != nil {
+				return nil, fmt.Errorf("(struct %s) field %s: %w", t.String(), sf.Name, err)
+			}
+			description.encoder = ttc
+			description.decoder = ttc
+		}
+
+		if stags.Codec != "" {
+			enc, encOK := r.lookupNamedEncoder(stags.Codec)
+			dec, decOK := r.lookupNamedDecoder(stags.Codec)
+			if !encOK && !decOK {
+				return nil, fmt.Errorf("(struct %s) field %s: no codec registered with name %q",
+					t.String(), sf.Name, stags.Codec)
+			}
+			if encOK {
+				description.encoder = enc
+			}
+			if decOK {
+				description.decoder = dec
+			}
+		}
 
 		if stags.Inline {
 			sd.inline = true
 			switch sfType.Kind() {
-			case reflect.Map:
-				if sd.inlineMap >= 0 {
+			case reflect.Map, reflect.Slice:
+				if sfType.Kind() == reflect.Slice && sfType != tD {
+					return nil, fmt.Errorf("(struct %s) inline fields must be a struct, a struct pointer, a map, a bson.D, or an interface", t.String())
+				}
+				if sd.inlineMap != nil {
 					return nil, errors.New("(struct " + t.String() + ") multiple inline maps")
 				}
-				if sfType.Key() != tString {
+				if sfType.Kind() == reflect.Map && sfType.Key() != tString {
 					return nil, errors.New("(struct " + t.String() + ") inline map must have a string keys")
 				}
-				sd.inlineMap = description.idx
+				sd.inlineMap = []int{i}
 			case reflect.Ptr:
 				sfType = sfType.Elem()
 				if sfType.Kind() != reflect.Struct {
@@ -555,14 +915,37 @@ func (sc *structCodec) describeStructSlow(
 					fields = append(fields, fd)
 
 				}
+				for _, ii := range inlinesf.inlineInterfaces {
+					ii.index = append([]int{i}, ii.index...)
+					sd.inlineInterfaces = append(sd.inlineInterfaces, ii)
+				}
+				if inlinesf.inlineMap != nil {
+					index := append([]int{i}, inlinesf.inlineMap...)
+					switch {
+					case nestedInlineMap == nil || len(index) < len(nestedInlineMap):
+						nestedInlineMap = index
+						nestedInlineMapAmbiguous = false
+					case len(index) == len(nestedInlineMap):
+						nestedInlineMapAmbiguous = true
+					}
+				}
+			case reflect.Interface:
+				sd.inlineInterfaces = append(sd.inlineInterfaces, inlineInterface{
+					fieldName: sf.Name,
+					index:     []int{i},
+				})
 			default:
-				return nil, fmt.Errorf("(struct %s) inline fields must be a struct, a struct pointer, or a map", t.String())
+				return nil, fmt.Errorf("(struct %s) inline fields must be a struct, a struct pointer, a map, a bson.D, or an interface", t.String())
 			}
 			continue
 		}
 		fields = append(fields, description)
 	}
 
+	if sd.inlineMap == nil && !nestedInlineMapAmbiguous {
+		sd.inlineMap = nestedInlineMap
+	}
+
 	// Sort fieldDescriptions by name and use dominance rules to determine which should be added for each name
 	sort.Slice(fields, func(i, j int) bool {
 		x := fields
@@ -7,11 +7,15 @@
 package bson
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
 	"reflect"
 	"testing"
 	"time"
 
 	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
 )
 
 func TestIsZero(t *testing.T) {
@@ -158,3 +162,113 @@ func TestIsZero(t *testing.T) {
 		})
 	}
 }
+
+type inlineStringer struct {
+	Name  string `bson:"name"`
+	Count int32  `bson:"count"`
+}
+
+func (is *inlineStringer) String() string { return is.Name }
+
+type inlineInterfaceDoc struct {
+	ID           int32 `bson:"_id"`
+	fmt.Stringer `bson:",inline"`
+}
+
+type valueStringer struct {
+	Name string `bson:"name"`
+}
+
+func (vs valueStringer) String() string { return vs.Name }
+
+func TestStructCodec_InlineInterface(t *testing.T) {
+	t.Parallel()
+
+	t.Run("encode and decode", func(t *testing.T) {
+		t.Parallel()
+
+		in := inlineInterfaceDoc{ID: 1, Stringer: &inlineStringer{Name: "foo", Count: 2}}
+		b, err := Marshal(in)
+		require.NoError(t, err, "Marshal error")
+
+		want, err := Marshal(D{{"_id", int32(1)}, {"name", "foo"}, {"count", int32(2)}})
+		require.NoError(t, err, "Marshal error")
+		assert.Equal(t, Raw(want), Raw(b), "expected %v, got %v", Raw(want), Raw(b))
+
+		out := inlineInterfaceDoc{Stringer: &inlineStringer{}}
+		err = Unmarshal(b, &out)
+		require.NoError(t, err, "Unmarshal error")
+		assert.Equal(t, in, out, "expected %v, got %v", in, out)
+	})
+	t.Run("nil value is omitted", func(t *testing.T) {
+		t.Parallel()
+
+		b, err := Marshal(inlineInterfaceDoc{ID: 1})
+		require.NoError(t, err, "Marshal error")
+
+		want, err := Marshal(D{{"_id", int32(1)}})
+		require.NoError(t, err, "Marshal error")
+		assert.Equal(t, Raw(want), Raw(b), "expected %v, got %v", Raw(want), Raw(b))
+	})
+	t.Run("outer fields take precedence", func(t *testing.T) {
+		t.Parallel()
+
+		type doc struct {
+			Name         string `bson:"name"`
+			fmt.Stringer `bson:",inline"`
+		}
+
+		b, err := Marshal(doc{Name: "outer", Stringer: &inlineStringer{Name: "inner", Count: 3}})
+		require.NoError(t, err, "Marshal error")
+
+		want, err := Marshal(D{{"name", "outer"}, {"count", int32(3)}})
+		require.NoError(t, err, "Marshal error")
+		assert.Equal(t, Raw(want), Raw(b), "expected %v, got %v", Raw(want), Raw(b))
+	})
+	t.Run("decode into nil interface", func(t *testing.T) {
+		t.Parallel()
+
+		b, err := Marshal(D{{"_id", int32(1)}, {"name", "foo"}})
+		require.NoError(t, err, "Marshal error")
+
+		var out inlineInterfaceDoc
+		err = Unmarshal(b, &out)
+		assert.ErrorIs(t, err, ErrNilInterface)
+	})
+	t.Run("decode into nil interface without interface fields", func(t *testing.T) {
+		t.Parallel()
+
+		b, err := Marshal(D{{"_id", int32(1)}})
+		require.NoError(t, err, "Marshal error")
+
+		var out inlineInterfaceDoc
+		err = Unmarshal(b, &out)
+		require.NoError(t, err, "Unmarshal error")
+		assert.Equal(t, inlineInterfaceDoc{ID: 1}, out, "expected %v, got %v", inlineInterfaceDoc{ID: 1}, out)
+	})
+	t.Run("ZeroStructs without interface fields", func(t *testing.T) {
+		t.Parallel()
+
+		b, err := Marshal(D{{"_id", int32(1)}})
+		require.NoError(t, err, "Marshal error")
+
+		dec := NewDecoder(NewDocumentReader(bytes.NewReader(b)))
+		dec.ZeroStructs()
+
+		out := inlineInterfaceDoc{ID: 2, Stringer: &inlineStringer{Name: "foo"}}
+		err = dec.Decode(&out)
+		require.NoError(t, err, "Decode error")
+		assert.Equal(t, inlineInterfaceDoc{ID: 1}, out, "expected %v, got %v", inlineInterfaceDoc{ID: 1}, out)
+	})
+	t.Run("decode into non-pointer value", func(t *testing.T) {
+		t.Parallel()
+
+		b, err := Marshal(D{{"_id", int32(1)}, {"name", "foo"}})
+		require.NoError(t, err, "Marshal error")
+
+		out := inlineInterfaceDoc{Stringer: valueStringer{}}
+		err = Unmarshal(b, &out)
+		assert.Error(t, err, "expected Unmarshal error")
+		assert.False(t, errors.Is(err, ErrNilInterface), "expected error other than %v, got %v", ErrNilInterface, err)
+	})
+}
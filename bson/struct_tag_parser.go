@@ -29,19 +29,34 @@ import (
 //	Truncate   When unmarshaling a BSON double, it is permitted to lose precision to fit within
 //	           a float32.
 //
-//	Inline     Inline the field, which must be a struct or a map, causing all of its fields
-//	           or keys to be processed as if they were part of the outer struct. For maps,
-//	           keys must not conflict with the bson keys of other struct fields.
+//	Inline     Inline the field, which must be a struct, a map, or a bson.D, causing all of its
+//	           fields or keys to be processed as if they were part of the outer struct. For maps
+//	           and bson.D, keys that conflict with the bson keys of other struct fields are
+//	           resolved according to the Encoder's SquashConflict setting. The "squash" flag is
+//	           an alias for "inline".
+//
+//	StringEnum Marshal the field as a BSON string using its fmt.Stringer implementation and
+//	           unmarshal it using the parser registered with Registry.RegisterStringEnum.
+//
+//	Timestamp  Marshal a time.Time field as a BSON timestamp with the Unix seconds as T and an
+//	           increment of zero instead of as a BSON datetime.
+//
+//	Codec      The name of a codec registered with Registry.RegisterNamedEncoder or
+//	           Registry.RegisterNamedDecoder to use for the field instead of the codec
+//	           registered for the field's type.
 //
 //	Skip       This struct field should be skipped. This is usually denoted by parsing a "-"
 //	           for the name.
 type structTags struct {
-	Name      string
-	OmitEmpty bool
-	MinSize   bool
-	Truncate  bool
-	Inline    bool
-	Skip      bool
+	Name       string
+	OmitEmpty  bool
+	MinSize    bool
+	Truncate   bool
+	Inline     bool
+	StringEnum bool
+	Timestamp  bool
+	Codec      string
+	Skip       bool
 }
 
 // DefaultStructTagParser is the StructTagParser used by the StructCodec by default.
@@ -112,8 +127,16 @@ func parseTags(key string, tag string) (*structTags, error) {
 			st.MinSize = true
 		case "truncate":
 			st.Truncate = true
-		case "inline":
+		case "inline", "squash":
 			st.Inline = true
+		case "stringenum":
+			st.StringEnum = true
+		case "timestamp":
+			st.Timestamp = true
+		default:
+			if idx > 0 && strings.HasPrefix(str, "codec=") {
+				st.Codec = strings.TrimPrefix(str, "codec=")
+			}
 		}
 	}
 
@@ -50,6 +50,24 @@ func TestStructTagParsers(t *testing.T) {
 			&structTags{Name: "bar", OmitEmpty: true, MinSize: true, Truncate: true, Inline: true},
 			parseStructTags,
 		},
+		{
+			"default stringenum",
+			reflect.StructField{Name: "foo", Tag: reflect.StructTag(`bson:"bar,stringenum"`)},
+			&structTags{Name: "bar", StringEnum: true},
+			parseStructTags,
+		},
+		{
+			"default squash",
+			reflect.StructField{Name: "foo", Tag: reflect.StructTag(`bson:",squash"`)},
+			&structTags{Name: "foo", Inline: true},
+			parseStructTags,
+		},
+		{
+			"default codec",
+			reflect.StructField{Name: "foo", Tag: reflect.StructTag(`bson:"bar,omitempty,codec=upper"`)},
+			&structTags{Name: "bar", OmitEmpty: true, Codec: "upper"},
+			parseStructTags,
+		},
 		{
 			"default all options default name",
 			reflect.StructField{Name: "foo", Tag: reflect.StructTag(`,omitempty,minsize,truncate,inline`)},
@@ -0,0 +1,52 @@
+// Copyright (C) MongoDB, Inc. 2026-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bson
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+)
+
+// timestampTimeCodec is the Codec used for time.Time struct fields tagged with "timestamp". It
+// encodes values as BSON timestamps with the Unix seconds as T and an increment of zero, and
+// decodes them the same way the default time.Time codec does.
+type timestampTimeCodec struct {
+	timeCodec
+}
+
+func newTimestampTimeCodec(t reflect.Type) (*timestampTimeCodec, error) {
+	if t != tTime {
+		return nil, fmt.Errorf("timestamp field type must be %s, got %s", tTime, t)
+	}
+
+	return &timestampTimeCodec{}, nil
+}
+
+// EncodeValue is the ValueEncoder for time.Time values encoded as BSON timestamps.
+func (ttc *timestampTimeCodec) EncodeValue(_ EncodeContext, vw ValueWriter, val reflect.Value) error {
+	if !val.IsValid() || val.Type() != tTime {
+		return ValueEncoderError{Name: "TimestampTimeEncodeValue", Types: []reflect.Type{tTime}, Received: val}
+	}
+
+	secs := val.Interface().(time.Time).Unix()
+	if secs < 0 || secs > math.MaxUint32 {
+		return fmt.Errorf("%v is out of range for a BSON timestamp", val.Interface())
+	}
+
+	return vw.WriteTimestamp(uint32(secs), 0)
+}
+
+// DecodeValue is the ValueDecoder for time.Time values encoded as BSON timestamps.
+func (ttc *timestampTimeCodec) DecodeValue(dc DecodeContext, vr ValueReader, val reflect.Value) error {
+	if !val.CanSet() || val.Type() != tTime {
+		return ValueDecoderError{Name: "TimestampTimeDecodeValue", Types: []reflect.Type{tTime}, Received: val}
+	}
+
+	return ttc.timeCodec.DecodeValue(dc, vr, val)
+}
@@ -0,0 +1,91 @@
+// Copyright (C) MongoDB, Inc. 2026-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package bson
+
+import (
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+)
+
+type testOplogDoc struct {
+	TS   time.Time `bson:"ts,timestamp"`
+	Wall time.Time `bson:"wall"`
+}
+
+func TestTimestampTimeCodec(t *testing.T) {
+	t.Parallel()
+
+	t.Run("round trip", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Date(2026, 10, 16, 12, 30, 45, 0, time.UTC)
+		doc := testOplogDoc{TS: now, Wall: now}
+
+		data, err := Marshal(doc)
+		require.NoError(t, err, "Marshal error")
+
+		ts, err := Raw(data).LookupErr("ts")
+		require.NoError(t, err, "LookupErr error")
+		require.Equal(t, TypeTimestamp, ts.Type, "expected type %v, got %v", TypeTimestamp, ts.Type)
+		gotT, gotI := ts.Timestamp()
+		assert.Equal(t, uint32(now.Unix()), gotT, "expected T %v, got %v", now.Unix(), gotT)
+		assert.Equal(t, uint32(0), gotI, "expected I 0, got %v", gotI)
+
+		// Fields without the timestamp tag are still encoded as datetimes.
+		wall, err := Raw(data).LookupErr("wall")
+		require.NoError(t, err, "LookupErr error")
+		assert.Equal(t, TypeDateTime, wall.Type, "expected type %v, got %v", TypeDateTime, wall.Type)
+
+		var got testOplogDoc
+		err = Unmarshal(data, &got)
+		require.NoError(t, err, "Unmarshal error")
+		assert.Equal(t, doc, got, "expected document %v, got %v", doc, got)
+	})
+	t.Run("decode from timestamp", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := Marshal(D{{"ts", Timestamp{T: 1700000000, I: 7}}})
+		require.NoError(t, err, "Marshal error")
+
+		var got testOplogDoc
+		err = Unmarshal(data, &got)
+		require.NoError(t, err, "Unmarshal error")
+		want := time.Unix(1700000000, 0).UTC()
+		assert.Equal(t, want, got.TS, "expected time %v, got %v", want, got.TS)
+	})
+	t.Run("sub-second precision is discarded", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Date(2026, 10, 16, 12, 30, 45, 999999999, time.UTC)
+		data, err := Marshal(testOplogDoc{TS: now})
+		require.NoError(t, err, "Marshal error")
+
+		var got testOplogDoc
+		err = Unmarshal(data, &got)
+		require.NoError(t, err, "Unmarshal error")
+		want := now.Truncate(time.Second)
+		assert.Equal(t, want, got.TS, "expected time %v, got %v", want, got.TS)
+	})
+	t.Run("out of range", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := Marshal(testOplogDoc{TS: time.Date(1969, 12, 31, 0, 0, 0, 0, time.UTC)})
+		assert.Error(t, err, "expected Marshal error, got nil")
+	})
+	t.Run("non-time field", func(t *testing.T) {
+		t.Parallel()
+
+		type badDoc struct {
+			TS int64 `bson:"ts,timestamp"`
+		}
+		_, err := Marshal(badDoc{})
+		assert.Error(t, err, "expected Marshal error, got nil")
+	})
+}
@@ -8,6 +8,7 @@ package bson
 
 import (
 	"encoding/json"
+	"net"
 	"net/url"
 	"reflect"
 	"time"
@@ -87,6 +88,8 @@ var tEmpty = reflect.TypeOf((*interface{})(nil)).Elem()
 var tByteSlice = reflect.TypeOf([]byte(nil))
 var tByte = reflect.TypeOf(byte(0x00))
 var tURL = reflect.TypeOf(url.URL{})
+var tIP = reflect.TypeOf(net.IP(nil))
+var tIPNet = reflect.TypeOf(net.IPNet{})
 var tJSONNumber = reflect.TypeOf(json.Number(""))
 
 var tValueMarshaler = reflect.TypeOf((*ValueMarshaler)(nil)).Elem()
@@ -113,6 +116,7 @@ var tMaxKey = reflect.TypeOf(MaxKey{})
 var tD = reflect.TypeOf(D{})
 var tA = reflect.TypeOf(A{})
 var tE = reflect.TypeOf(E{})
+var tMapStringInterface = reflect.TypeOf(map[string]interface{}(nil))
 
 var tCoreDocument = reflect.TypeOf(bsoncore.Document{})
 var tCoreArray = reflect.TypeOf(bsoncore.Array{})
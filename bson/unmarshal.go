@@ -9,6 +9,9 @@ package bson
 import (
 	"bytes"
 	"fmt"
+	"reflect"
+
+	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
 )
 
 // Unmarshaler is the interface implemented by types that can unmarshal a BSON
@@ -51,6 +54,64 @@ func Unmarshal(data []byte, val interface{}) error {
 	return unmarshalFromReader(DecodeContext{Registry: defaultRegistry}, vr, val)
 }
 
+// UnmarshalFields parses only the top-level fields of the BSON document data that are named by the keys of
+// fields and stores each value in the destination mapped to its key. Each destination must be a non-nil pointer or
+// map, as for Unmarshal. Values are decoded using the default registry.
+//
+// Other fields are skipped without parsing their contents, and scanning stops as soon as every requested field has
+// been found. If a key occurs more than once in data, only its first occurrence is decoded.
+//
+// UnmarshalFields returns the set of requested keys that were found in data. The destinations of keys that are not
+// in the returned map are left unchanged, so callers that require a field should check for it.
+func UnmarshalFields(data []byte, fields map[string]interface{}) (map[string]bool, error) {
+	for key, val := range fields {
+		rval := reflect.ValueOf(val)
+		if (rval.Kind() != reflect.Ptr && rval.Kind() != reflect.Map) || rval.IsNil() {
+			return nil, fmt.Errorf("destination for field %q must be a non-nil pointer or map, got %T", key, val)
+		}
+	}
+
+	length, rem, ok := bsoncore.ReadLength(data)
+	if !ok {
+		return nil, bsoncore.NewInsufficientBytesError(data, rem)
+	}
+	if int(length) != len(data) {
+		return nil, fmt.Errorf("invalid document length")
+	}
+	length -= 4
+
+	found := make(map[string]bool, len(fields))
+	dc := DecodeContext{Registry: defaultRegistry}
+
+	var elem bsoncore.Element
+	for length > 1 && len(found) < len(fields) {
+		elem, rem, ok = bsoncore.ReadElement(rem)
+		length -= int32(len(elem))
+		if !ok {
+			return found, bsoncore.NewInsufficientBytesError(data, rem)
+		}
+
+		// Use KeyBytes rather than Key to avoid allocating a string for skipped fields.
+		val, requested := fields[string(elem.KeyBytes())]
+		if !requested {
+			continue
+		}
+		key := elem.Key()
+		if found[key] {
+			continue
+		}
+
+		value := elem.Value()
+		vr := newValueReader(Type(value.Type), bytes.NewReader(value.Data))
+		if err := unmarshalFromReader(dc, vr, val); err != nil {
+			return found, newDecodeError(key, err)
+		}
+		found[key] = true
+	}
+
+	return found, nil
+}
+
 // UnmarshalValue parses the BSON value of type t with bson.NewRegistry() and
 // stores the result in the value pointed to by val. If val is nil or not a pointer,
 // UnmarshalValue returns an error.
@@ -8,12 +8,14 @@ package bson
 
 import (
 	"bytes"
+	"errors"
 	"math/rand"
 	"reflect"
 	"sync"
 	"testing"
 
 	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
 	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
 )
 
@@ -817,3 +819,87 @@ func TestUnmarshalConcurrently(t *testing.T) {
 	}
 	wg.Wait()
 }
+
+func TestUnmarshalFields(t *testing.T) {
+	t.Parallel()
+
+	type nested struct {
+		A int32  `bson:"a"`
+		B string `bson:"b"`
+	}
+
+	data, err := Marshal(D{
+		{"name", "widget"},
+		{"count", int64(42)},
+		{"skipped", D{{"deep", A{D{{"x", 1}}}}}},
+		{"tags", A{"a", "b", "c"}},
+		{"nested", D{{"a", int32(1)}, {"b", "two"}}},
+		{"name", "duplicate"},
+	})
+	require.NoError(t, err, "Marshal error")
+
+	t.Run("selected fields", func(t *testing.T) {
+		t.Parallel()
+
+		var name string
+		var count int64
+		var tags []string
+		var n *nested
+		missing := "unchanged"
+
+		found, err := UnmarshalFields(data, map[string]interface{}{
+			"name":    &name,
+			"count":   &count,
+			"tags":    &tags,
+			"nested":  &n,
+			"missing": &missing,
+		})
+		require.NoError(t, err, "UnmarshalFields error")
+
+		wantFound := map[string]bool{"name": true, "count": true, "tags": true, "nested": true}
+		assert.Equal(t, wantFound, found, "expected found keys %v, got %v", wantFound, found)
+		assert.Equal(t, "widget", name, "expected first occurrence of duplicated key to be decoded")
+		assert.Equal(t, int64(42), count, "expected count %d, got %d", 42, count)
+		assert.Equal(t, []string{"a", "b", "c"}, tags, "expected tags %v, got %v", []string{"a", "b", "c"}, tags)
+		assert.Equal(t, &nested{A: 1, B: "two"}, n, "expected nested %v, got %v", &nested{A: 1, B: "two"}, n)
+		assert.Equal(t, "unchanged", missing, "expected missing field destination to be unchanged")
+	})
+	t.Run("map destination", func(t *testing.T) {
+		t.Parallel()
+
+		m := map[string]interface{}{}
+		found, err := UnmarshalFields(data, map[string]interface{}{"nested": m})
+		require.NoError(t, err, "UnmarshalFields error")
+
+		assert.True(t, found["nested"], "expected nested to be found")
+		assert.Equal(t, map[string]interface{}{"a": int32(1), "b": "two"}, m, "unexpected map contents %v", m)
+	})
+	t.Run("decode error", func(t *testing.T) {
+		t.Parallel()
+
+		var count string
+		found, err := UnmarshalFields(data, map[string]interface{}{"count": &count})
+		require.Error(t, err, "expected UnmarshalFields error, got nil")
+		assert.False(t, found["count"], "expected count not to be reported as found")
+
+		var de *DecodeError
+		require.True(t, errors.As(err, &de), "expected a DecodeError, got %T", err)
+		assert.Equal(t, []string{"count"}, de.Keys(), "expected keys %v, got %v", []string{"count"}, de.Keys())
+	})
+	t.Run("invalid destination", func(t *testing.T) {
+		t.Parallel()
+
+		var nilPtr *string
+		for _, dst := range []interface{}{"not a pointer", nilPtr, nil} {
+			_, err := UnmarshalFields(data, map[string]interface{}{"name": dst})
+			assert.Error(t, err, "expected UnmarshalFields error for destination %v, got nil", dst)
+		}
+	})
+	t.Run("invalid document length", func(t *testing.T) {
+		t.Parallel()
+
+		var name string
+		_, err := UnmarshalFields(data[:len(data)-1], map[string]interface{}{"name": &name})
+		assert.Error(t, err, "expected UnmarshalFields error, got nil")
+	})
+}
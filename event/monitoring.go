@@ -108,6 +108,27 @@ type PoolEvent struct {
 	ServiceID    *bson.ObjectID `json:"serviceId"`
 	Interruption bool           `json:"interruptInUseConnections"`
 	Error        error          `json:"error"`
+	// Compression contains the negotiated compressor and byte counters for the connection. It is only set if the
+	// Type is ConnectionReady, ConnectionCheckedIn, or ConnectionClosed.
+	Compression *CompressionStats `json:"compression"`
+}
+
+// CompressionStats contains counters for the number of bytes sent and received over a single connection. The
+// difference between the uncompressed and wire counts is the number of bytes saved by wire compression.
+type CompressionStats struct {
+	// Compressor is the wire compressor negotiated for the connection during the handshake (e.g. "zlib"). It is
+	// empty if no compressor was negotiated.
+	Compressor string `json:"compressor"`
+	// BytesSent is the number of bytes written to the network.
+	BytesSent int64 `json:"bytesSent"`
+	// BytesReceived is the number of bytes read from the network.
+	BytesReceived int64 `json:"bytesReceived"`
+	// UncompressedBytesSent is the number of bytes that would have been written to the network if no messages had
+	// been compressed.
+	UncompressedBytesSent int64 `json:"uncompressedBytesSent"`
+	// UncompressedBytesReceived is the number of bytes that would have been read from the network if no messages
+	// had been compressed.
+	UncompressedBytesReceived int64 `json:"uncompressedBytesReceived"`
 }
 
 // PoolMonitor is a function that allows the user to gain access to events occurring in the pool
@@ -464,6 +464,48 @@ func BenchmarkMultiFindMany(b *testing.B) {
 	reportMetrics(b, metrics)
 }
 
+// Test driver performance retrieving multiple documents with Collection.FindAll
+// compared to calling Find and Cursor.All directly.
+func BenchmarkMultiFindAll(b *testing.B) {
+	coll, teardown := setupBench(b)
+	defer teardown(b)
+
+	doc := loadSourceDocument(b, true, testdataPerfDir(b), singleAndMultiDataDir, tweetData)
+
+	const docCount = 1_000
+
+	docsToInsert := make([]bson.D, docCount)
+	for i := range docsToInsert {
+		docsToInsert[i] = doc
+	}
+
+	_, err := coll.InsertMany(context.Background(), docsToInsert)
+	require.NoError(b, err, "failed to insert docs")
+
+	b.Run("FindAll", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var results []bson.Raw
+			err := coll.FindAll(context.Background(), bson.D{}, &results)
+			require.NoError(b, err, "failed to find all")
+			require.Len(b, results, docCount)
+		}
+	})
+
+	b.Run("Find and All", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			cursor, err := coll.Find(context.Background(), bson.D{})
+			require.NoError(b, err, "failed to find data")
+
+			var results []bson.Raw
+			err = cursor.All(context.Background(), &results)
+			require.NoError(b, err, "failed to decode results")
+			require.Len(b, results, docCount)
+		}
+	})
+}
+
 func benchmarkMultiInsert(b *testing.B, source string) {
 	b.Helper()
 
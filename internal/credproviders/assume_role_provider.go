@@ -13,6 +13,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"time"
 
 	"go.mongodb.org/mongo-driver/v2/internal/aws/credentials"
@@ -23,7 +24,7 @@ const (
 	// assumeRoleProviderName provides a name of assume role provider
 	assumeRoleProviderName = "AssumeRoleProvider"
 
-	stsURI = `https://sts.amazonaws.com/?Action=AssumeRoleWithWebIdentity&RoleSessionName=%s&RoleArn=%s&WebIdentityToken=%s&Version=2011-06-15`
+	stsURI = "https://sts.amazonaws.com/"
 )
 
 // An AssumeRoleProvider retrieves credentials for assume role with web identity.
@@ -89,7 +90,13 @@ func (a *AssumeRoleProvider) RetrieveWithContext(ctx context.Context) (credentia
 		sessionName = id.String()
 	}
 
-	fullURI := fmt.Sprintf(stsURI, sessionName, roleArn, string(token))
+	query := url.Values{}
+	query.Set("Action", "AssumeRoleWithWebIdentity")
+	query.Set("RoleSessionName", sessionName)
+	query.Set("RoleArn", roleArn)
+	query.Set("WebIdentityToken", string(token))
+	query.Set("Version", "2011-06-15")
+	fullURI := stsURI + "?" + query.Encode()
 
 	req, err := http.NewRequest(http.MethodPost, fullURI, nil)
 	if err != nil {
@@ -1999,7 +1999,7 @@ func WithTransactionExample(ctx context.Context) error {
 	}
 
 	// Step 2: Start a session and run the callback using WithTransaction.
-	session, err := client.StartSession()
+	session, err := client.StartSessionWithContext(ctx)
 	if err != nil {
 		return err
 	}
@@ -2565,7 +2565,7 @@ func CausalConsistencyExamples(client *mongo.Client) error {
 
 	// Use a causally-consistent session to run some operations
 	opts := options.Session().SetDefaultTransactionOptions(txnOpts)
-	session1, err := client.StartSession(opts)
+	session1, err := client.StartSessionWithContext(ctx, opts)
 	if err != nil {
 		return err
 	}
@@ -2599,7 +2599,7 @@ func CausalConsistencyExamples(client *mongo.Client) error {
 
 	// Make a new session that is causally consistent with session1 so session2 reads what session1 writes
 	opts = options.Session().SetDefaultTransactionOptions(txnOpts)
-	session2, err := client.StartSession(opts)
+	session2, err := client.StartSessionWithContext(ctx, opts)
 	if err != nil {
 		return err
 	}
@@ -2989,7 +2989,7 @@ func snapshotQueryPetExample(mt *mtest.T) error {
 	// Start Snapshot Query Example 1
 	ctx := context.TODO()
 
-	sess, err := client.StartSession(options.Session().SetSnapshot(true))
+	sess, err := client.StartSessionWithContext(ctx, options.Session().SetSnapshot(true))
 	if err != nil {
 		return err
 	}
@@ -3053,7 +3053,7 @@ func snapshotQueryRetailExample(mt *mtest.T) error {
 	// Start Snapshot Query Example 2
 	ctx := context.TODO()
 
-	sess, err := client.StartSession(options.Session().SetSnapshot(true))
+	sess, err := client.StartSessionWithContext(ctx, options.Session().SetSnapshot(true))
 	if err != nil {
 		return err
 	}
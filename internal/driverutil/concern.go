@@ -0,0 +1,43 @@
+// Copyright (C) MongoDB, Inc. 2026-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package driverutil
+
+import "context"
+
+// Sources of the read and write concerns used by an operation. They are
+// reported in command log messages to help debug concern inheritance.
+const (
+	ConcernSourceDefault     = "default"
+	ConcernSourceClient      = "client"
+	ConcernSourceDatabase    = "database"
+	ConcernSourceCollection  = "collection"
+	ConcernSourceBucket      = "bucket"
+	ConcernSourceOperation   = "operation"
+	ConcernSourceTransaction = "transaction"
+)
+
+// ConcernSources records where the read and write concerns of an operation
+// were configured.
+type ConcernSources struct {
+	ReadConcern  string
+	WriteConcern string
+}
+
+type concernSourcesKey struct{}
+
+// WithConcernSources returns a copy of parent that holds the given concern
+// sources.
+func WithConcernSources(parent context.Context, sources ConcernSources) context.Context {
+	return context.WithValue(parent, concernSourcesKey{}, sources)
+}
+
+// ConcernSourcesFromContext returns the concern sources stored in ctx using
+// WithConcernSources, if any.
+func ConcernSourcesFromContext(ctx context.Context) (ConcernSources, bool) {
+	sources, ok := ctx.Value(concernSourcesKey{}).(ConcernSources)
+	return sources, ok
+}
@@ -11,11 +11,15 @@ import (
 	"testing"
 
 	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/event"
 	"go.mongodb.org/mongo-driver/v2/internal/assert"
 	"go.mongodb.org/mongo-driver/v2/internal/integration/mtest"
+	"go.mongodb.org/mongo-driver/v2/internal/integtest"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
 	"go.mongodb.org/mongo-driver/v2/mongo"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 	"go.mongodb.org/mongo-driver/v2/mongo/readconcern"
+	"go.mongodb.org/mongo-driver/v2/mongo/readpref"
 )
 
 // set of operations that support read concerns taken from read/write concern spec.
@@ -199,6 +203,63 @@ func TestCausalConsistency_Supported(t *testing.T) {
 		_, err := evt.Command.LookupErr("$clusterTime")
 		assert.Nil(mt, err, "expected $clusterTime in command, got nil")
 	})
+	mt.RunOpts("causal times passed between clients", mtest.NewOptions().Topologies(mtest.ReplicaSet), func(mt *mtest.T) {
+		// causal times exported from a session of one client and advanced on a session of another client should
+		// make the second client's reads observe the first client's writes, even when reading from a secondary
+
+		sess1, err := mt.Client.StartSession()
+		require.NoError(mt, err, "StartSession error")
+		defer sess1.EndSession(context.Background())
+
+		err = mongo.WithSession(context.Background(), sess1, func(ctx context.Context) error {
+			_, err := mt.Coll.InsertOne(ctx, bson.D{{"x", 1}})
+			return err
+		})
+		require.NoError(mt, err, "InsertOne error")
+
+		text, err := sess1.CausalTimes().MarshalText()
+		require.NoError(mt, err, "MarshalText error")
+
+		var started []*event.CommandStartedEvent
+		monitor := &event.CommandMonitor{
+			Started: func(_ context.Context, evt *event.CommandStartedEvent) {
+				if evt.CommandName == "find" {
+					started = append(started, evt)
+				}
+			},
+		}
+		clientOpts := options.Client().ApplyURI(mtest.ClusterURI()).SetMonitor(monitor)
+		integtest.AddTestServerAPIVersion(clientOpts)
+		client2, err := mongo.Connect(clientOpts)
+		require.NoError(mt, err, "Connect error")
+		defer func() { _ = client2.Disconnect(context.Background()) }()
+
+		sess2, err := client2.StartSession(options.Session().SetCausalConsistency(true))
+		require.NoError(mt, err, "StartSession error")
+		defer sess2.EndSession(context.Background())
+
+		var times mongo.CausalTimes
+		err = times.UnmarshalText(text)
+		require.NoError(mt, err, "UnmarshalText error")
+		err = sess2.AdvanceCausalTimes(times)
+		require.NoError(mt, err, "AdvanceCausalTimes error")
+
+		coll2 := client2.Database(mt.DB.Name()).Collection(mt.Coll.Name(),
+			options.Collection().
+				SetReadPreference(readpref.Secondary()).
+				SetReadConcern(readconcern.Majority()))
+		var res bson.Raw
+		err = mongo.WithSession(context.Background(), sess2, func(ctx context.Context) error {
+			return coll2.FindOne(ctx, bson.D{{"x", 1}}).Decode(&res)
+		})
+		require.NoError(mt, err, "FindOne error")
+
+		require.Len(mt, started, 1, "expected 1 find command, got %d", len(started))
+		_, sentOptime := getReadConcernFields(mt, started[0].Command)
+		assert.NotNil(mt, sentOptime, "expected operation time on command, got nil")
+		assert.True(mt, sess1.OperationTime().Equal(*sentOptime),
+			"expected operation time %v, got %v", sess1.OperationTime(), sentOptime)
+	})
 }
 
 func TestCausalConsistency_NotSupported(t *testing.T) {
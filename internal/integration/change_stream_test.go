@@ -8,6 +8,7 @@ package integration
 
 import (
 	"context"
+	"path/filepath"
 	"strings"
 	"sync"
 	"testing"
@@ -17,11 +18,12 @@ import (
 	"go.mongodb.org/mongo-driver/v2/event"
 	"go.mongodb.org/mongo-driver/v2/internal/assert"
 	"go.mongodb.org/mongo-driver/v2/internal/eventtest"
-	"go.mongodb.org/mongo-driver/v2/internal/failpoint"
 	"go.mongodb.org/mongo-driver/v2/internal/integration/mtest"
 	"go.mongodb.org/mongo-driver/v2/internal/require"
 	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/checkpoint"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.mongodb.org/mongo-driver/v2/mongo/testutil/failpoint"
 )
 
 type resumeType int
@@ -617,6 +619,95 @@ func TestChangeStream_ReplicaSet(t *testing.T) {
 		// next call to cs.Next should return False since cursor is closed
 		assert.False(mt, cs.Next(context.Background()), "expected to return false, but returned true")
 	})
+	mt.RunOpts("checkpoint store", mtest.NewOptions().MinServerVersion("4.0"), func(mt *mtest.T) {
+		testCases := []struct {
+			name string
+			sync bool
+		}{
+			{"async", false},
+			{"sync", true},
+		}
+		for _, tc := range testCases {
+			mt.Run(tc.name, func(mt *mtest.T) {
+				store := checkpoint.FileStore(filepath.Join(mt.TempDir(), "checkpoint.json"))
+				csOpts := options.ChangeStream().SetCheckpointStore(store).SetCheckpointSync(tc.sync)
+
+				cs, err := mt.Coll.Watch(context.Background(), mongo.Pipeline{}, csOpts)
+				require.NoError(mt, err, "Watch error")
+
+				generateEvents(mt, 3)
+
+				// Process the first two events and then close the change stream. Closing signals that the second event
+				// has been processed, so its resume token is saved.
+				for i := 0; i < 2; i++ {
+					require.True(mt, cs.Next(context.Background()), "Next returned false at index %d; iteration error: %v", i, cs.Err())
+				}
+				err = cs.Close(context.Background())
+				require.NoError(mt, err, "Close error")
+
+				token, err := store.Load(context.Background())
+				require.NoError(mt, err, "Load error")
+				assert.NotNil(mt, token, "expected a saved resume token, got nil")
+
+				// A new change stream using the same store should resume after the second event.
+				cs, err = mt.Coll.Watch(context.Background(), mongo.Pipeline{}, csOpts)
+				require.NoError(mt, err, "Watch error")
+				defer closeStream(cs)
+
+				for i := 2; i < 3; i++ {
+					require.True(mt, cs.Next(context.Background()), "Next returned false at index %d; iteration error: %v", i, cs.Err())
+					x := cs.Current.Lookup("fullDocument", "x").Int32()
+					assert.Equal(mt, int32(i), x, "expected event for document %d, got %d", i, x)
+				}
+			})
+		}
+	})
+	mt.RunOpts("collation", mtest.NewOptions().MinServerVersion("4.0"), func(mt *mtest.T) {
+		collation := options.Collation{Locale: "en", Strength: 2}
+		pipeline := mongo.Pipeline{{{"$match", bson.D{{"fullDocument.name", "alice"}}}}}
+
+		// Close the connection on the first getMore so the change stream resumes with a new aggregate.
+		mt.SetFailPoint(failpoint.FailPoint{
+			ConfigureFailPoint: "failCommand",
+			Mode: failpoint.Mode{
+				Times: 1,
+			},
+			Data: failpoint.Data{
+				FailCommands:    []string{"getMore"},
+				CloseConnection: true,
+			},
+		})
+
+		mt.ClearEvents()
+		cs, err := mt.Coll.Watch(context.Background(), pipeline, options.ChangeStream().SetCollation(collation))
+		require.NoError(mt, err, "Watch error")
+		defer closeStream(cs)
+
+		docs := []interface{}{bson.D{{"name", "ALICE"}}, bson.D{{"name", "bob"}}, bson.D{{"name", "Alice"}}}
+		_, err = mt.Coll.InsertMany(context.Background(), docs)
+		require.NoError(mt, err, "InsertMany error")
+
+		// With strength 2, the $match compares case-insensitively, so only the "bob" event is filtered out.
+		for _, want := range []string{"ALICE", "Alice"} {
+			require.True(mt, cs.Next(context.Background()), "expected Next to return true, got false (iteration error %v)", cs.Err())
+			name := cs.Current.Lookup("fullDocument", "name").StringValue()
+			assert.Equal(mt, want, name, "expected event for %q, got %q", want, name)
+		}
+
+		var aggregates int
+		for evt := mt.GetStartedEvent(); evt != nil; evt = mt.GetStartedEvent() {
+			if evt.CommandName != "aggregate" {
+				continue
+			}
+			aggregates++
+
+			val, err := evt.Command.LookupErr("collation")
+			require.NoError(mt, err, "expected aggregate to have a collation")
+			strength := val.Document().Lookup("strength").Int32()
+			assert.Equal(mt, int32(2), strength, "expected collation strength 2, got %d", strength)
+		}
+		assert.Equal(mt, 2, aggregates, "expected 2 aggregate commands, got %d", aggregates)
+	})
 	mt.Run("getMore commands are monitored", func(mt *mtest.T) {
 		cs, err := mt.Coll.Watch(context.Background(), mongo.Pipeline{})
 		assert.Nil(mt, err, "Watch error: %v", err)
@@ -685,6 +776,31 @@ func TestChangeStream_ReplicaSet(t *testing.T) {
 		assert.True(mt, ok, "expected field 'allChangesForCluster' to be boolean, got %v", acfcVal.Type.String())
 		assert.False(mt, acfc, "expected field 'allChangesForCluster' to be false, got %v", acfc)
 	})
+	mt.RunOpts("showExpandedEvents", mtest.NewOptions().MinServerVersion("6.0"), func(mt *mtest.T) {
+		// Expanded events, such as createIndexes, are only reported if showExpandedEvents is set.
+		opts := options.ChangeStream().SetShowExpandedEvents(true)
+
+		mt.ClearEvents()
+		cs, err := mt.Coll.Watch(context.Background(), mongo.Pipeline{}, opts)
+		require.NoError(mt, err, "Watch error")
+		defer closeStream(cs)
+
+		evt := mt.GetStartedEvent()
+		see, err := evt.Command.LookupErr("pipeline", "0", "$changeStream", "showExpandedEvents")
+		require.NoError(mt, err, "expected field 'showExpandedEvents' in $changeStream stage")
+		assert.True(mt, see.Boolean(), "expected field 'showExpandedEvents' to be true, got false")
+
+		_, err = mt.Coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{Keys: bson.D{{"x", 1}}})
+		require.NoError(mt, err, "CreateOne error")
+
+		// Creating the index may implicitly create the collection, which is reported first.
+		var opType string
+		for i := 0; i < 2 && opType != "createIndexes"; i++ {
+			require.True(mt, cs.Next(context.Background()), "Next returned false with error %v", cs.Err())
+			opType = cs.Current.Lookup("operationType").StringValue()
+		}
+		assert.Equal(mt, "createIndexes", opType, "expected operationType 'createIndexes', got %q", opType)
+	})
 
 	withBSONOpts := mtest.NewOptions().ClientOptions(
 		options.Client().SetBSONOptions(&options.BSONOptions{
@@ -8,8 +8,10 @@ package integration
 
 import (
 	"context"
+	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/event"
@@ -17,6 +19,8 @@ import (
 	"go.mongodb.org/mongo-driver/v2/internal/integration/mtest"
 	"go.mongodb.org/mongo-driver/v2/mongo"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.mongodb.org/mongo-driver/v2/mongo/readpref"
+	"go.mongodb.org/mongo-driver/v2/mongo/testutil/failpoint"
 	"go.mongodb.org/mongo-driver/v2/mongo/writeconcern"
 	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/drivertest"
@@ -350,6 +354,18 @@ func TestCollection(t *testing.T) {
 			_, err := mt.Coll.DeleteOne(context.Background(), bson.D{{"x", 0}}, opts)
 			assert.Equal(mt, mongo.ErrMapForOrderedArgument{"hint"}, err, "expected error %v, got %v", mongo.ErrMapForOrderedArgument{"hint"}, err)
 		})
+		mt.RunOpts("let", mtest.NewOptions().MinServerVersion("5.0"), func(mt *mtest.T) {
+			initCollection(mt, mt.Coll)
+			filter := bson.D{{"$expr", bson.D{{"$eq", bson.A{"$x", "$$target"}}}}}
+			opts := options.DeleteOne().SetLet(bson.D{{"target", 3}})
+			res, err := mt.Coll.DeleteOne(context.Background(), filter, opts)
+			assert.Nil(mt, err, "DeleteOne error: %v", err)
+			assert.Equal(mt, int64(1), res.DeletedCount, "expected DeletedCount 1, got %v", res.DeletedCount)
+
+			count, err := mt.Coll.CountDocuments(context.Background(), bson.D{{"x", 3}})
+			assert.Nil(mt, err, "CountDocuments error: %v", err)
+			assert.Equal(mt, int64(0), count, "expected count 0, got %v", count)
+		})
 	})
 	mt.RunOpts("delete many", noClientOpts, func(mt *mtest.T) {
 		mt.Run("found", func(mt *mtest.T) {
@@ -419,6 +435,14 @@ func TestCollection(t *testing.T) {
 			_, err := mt.Coll.DeleteMany(context.Background(), bson.D{{"x", 0}}, opts)
 			assert.Equal(mt, mongo.ErrMapForOrderedArgument{"hint"}, err, "expected error %v, got %v", mongo.ErrMapForOrderedArgument{"hint"}, err)
 		})
+		mt.RunOpts("let", mtest.NewOptions().MinServerVersion("5.0"), func(mt *mtest.T) {
+			initCollection(mt, mt.Coll)
+			filter := bson.D{{"$expr", bson.D{{"$gte", bson.A{"$x", "$$min"}}}}}
+			opts := options.DeleteMany().SetLet(bson.D{{"min", 3}})
+			res, err := mt.Coll.DeleteMany(context.Background(), filter, opts)
+			assert.Nil(mt, err, "DeleteMany error: %v", err)
+			assert.Equal(mt, int64(3), res.DeletedCount, "expected DeletedCount 3, got %v", res.DeletedCount)
+		})
 	})
 	mt.RunOpts("update one", noClientOpts, func(mt *mtest.T) {
 		mt.Run("empty update", func(mt *mtest.T) {
@@ -514,6 +538,67 @@ func TestCollection(t *testing.T) {
 				})
 			}
 		})
+		mt.RunOpts("pipeline stages", mtest.NewOptions().MinServerVersion("4.2"), func(mt *mtest.T) {
+			testCases := []struct {
+				name   string
+				stage  bson.D
+				wantFn func(doc bson.Raw) bool
+			}{
+				{
+					"$addFields",
+					bson.D{{"$addFields", bson.D{{"y", 2}}}},
+					func(doc bson.Raw) bool { return doc.Lookup("y").Int32() == 2 },
+				},
+				{
+					"$set",
+					bson.D{{"$set", bson.D{{"y", 2}}}},
+					func(doc bson.Raw) bool { return doc.Lookup("y").Int32() == 2 },
+				},
+				{
+					"$project",
+					bson.D{{"$project", bson.D{{"y", "$x"}}}},
+					func(doc bson.Raw) bool {
+						_, err := doc.LookupErr("x")
+						return err != nil && doc.Lookup("y").Int32() == 1
+					},
+				},
+				{
+					"$unset",
+					bson.D{{"$unset", "x"}},
+					func(doc bson.Raw) bool {
+						_, err := doc.LookupErr("x")
+						return err != nil
+					},
+				},
+				{
+					"$replaceRoot",
+					bson.D{{"$replaceRoot", bson.D{{"newRoot", bson.D{{"y", "$x"}}}}}},
+					func(doc bson.Raw) bool { return doc.Lookup("y").Int32() == 1 },
+				},
+				{
+					"$replaceWith",
+					bson.D{{"$replaceWith", bson.D{{"y", "$x"}}}},
+					func(doc bson.Raw) bool { return doc.Lookup("y").Int32() == 1 },
+				},
+			}
+			for _, tc := range testCases {
+				mt.Run(tc.name, func(mt *mtest.T) {
+					res, err := mt.Coll.InsertOne(context.Background(), bson.D{{"x", int32(1)}})
+					assert.Nil(mt, err, "InsertOne error: %v", err)
+					filter := bson.D{{"_id", res.InsertedID}}
+
+					assert.True(mt, mongo.IsPipelineUpdate(mongo.Pipeline{tc.stage}), "expected a pipeline update")
+					updateRes, err := mt.Coll.UpdateOne(context.Background(), filter, mongo.Pipeline{tc.stage})
+					assert.Nil(mt, err, "UpdateOne error: %v", err)
+					assert.Equal(mt, int64(1), updateRes.ModifiedCount, "expected modified count 1, got %v",
+						updateRes.ModifiedCount)
+
+					doc, err := mt.Coll.FindOne(context.Background(), filter).Raw()
+					assert.Nil(mt, err, "FindOne error: %v", err)
+					assert.True(mt, tc.wantFn(doc), "unexpected document after update: %v", doc)
+				})
+			}
+		})
 	})
 	mt.RunOpts("update by id", noClientOpts, func(mt *mtest.T) {
 		mt.Run("empty update", func(mt *mtest.T) {
@@ -756,10 +841,66 @@ func TestCollection(t *testing.T) {
 				assert.Equal(mt, int32(i), num.Int32(), "expected x value %v, got %v", i, num.Int32())
 			}
 		})
+		mt.Run("max results", func(mt *mtest.T) {
+			initCollection(mt, mt.Coll)
+			pipeline := mongo.Pipeline{{{"$sort", bson.D{{"x", 1}}}}}
+
+			mt.ClearEvents()
+			cursor, err := mt.Coll.Aggregate(context.Background(), pipeline, options.Aggregate().SetMaxResults(2))
+			assert.Nil(mt, err, "Aggregate error: %v", err)
+
+			var docs []bson.Raw
+			err = cursor.All(context.Background(), &docs)
+			assert.Nil(mt, err, "All error: %v", err)
+			assert.Equal(mt, 2, len(docs), "expected 2 documents, got %v", len(docs))
+
+			evt := mt.GetStartedEvent()
+			assert.Equal(mt, "aggregate", evt.CommandName, "expected command 'aggregate', got %q", evt.CommandName)
+			stages, _ := evt.Command.Lookup("pipeline").Array().Values()
+			assert.Equal(mt, 2, len(stages), "expected 2 pipeline stages, got %v", len(stages))
+			limit := stages[len(stages)-1].Document().Lookup("$limit").Int64()
+			assert.Equal(mt, int64(2), limit, "expected $limit 2, got %v", limit)
+		})
 		mt.RunOpts("index hint", mtest.NewOptions().MinServerVersion("3.6"), func(mt *mtest.T) {
 			hint := bson.D{{"x", 1}}
 			testAggregateWithOptions(mt, true, options.Aggregate().SetHint(hint))
 		})
+		mt.Run("explain", func(mt *mtest.T) {
+			initCollection(mt, mt.Coll)
+			pipeline := mongo.Pipeline{{{"$match", bson.D{{"x", bson.D{{"$gte", 2}}}}}}}
+
+			mt.ClearEvents()
+			res, err := mt.Coll.ExplainAggregate(context.Background(), pipeline, mongo.QueryPlanner).Raw()
+			assert.Nil(mt, err, "ExplainAggregate error: %v", err)
+
+			// Depending on the server version and topology, the explain output for a single-stage pipeline either
+			// contains the query plan directly or a list of stages.
+			_, plannerErr := res.LookupErr("queryPlanner")
+			_, stagesErr := res.LookupErr("stages")
+			_, shardsErr := res.LookupErr("shards")
+			assert.True(mt, plannerErr == nil || stagesErr == nil || shardsErr == nil,
+				"expected queryPlanner, stages, or shards in explain output %v", res)
+
+			evt := mt.GetStartedEvent()
+			assert.Equal(mt, "explain", evt.CommandName, "expected command 'explain', got %q", evt.CommandName)
+		})
+		mt.RunOpts("$out to another database", mtest.NewOptions().MinServerVersion("4.4"), func(mt *mtest.T) {
+			initCollection(mt, mt.Coll)
+			outDB := mt.Client.Database(mtest.TestDb + "_out")
+			defer func() { _ = outDB.Drop(context.Background()) }()
+
+			pipeline := mongo.Pipeline{
+				{{"$match", bson.D{{"x", bson.D{{"$gte", 3}}}}}},
+				mongo.Out(mongo.OutStage{Database: outDB.Name(), Collection: mt.Coll.Name()}),
+			}
+			cursor, err := mt.Coll.Aggregate(context.Background(), pipeline)
+			assert.Nil(mt, err, "Aggregate error: %v", err)
+			_ = cursor.Close(context.Background())
+
+			count, err := outDB.Collection(mt.Coll.Name()).CountDocuments(context.Background(), bson.D{})
+			assert.Nil(mt, err, "CountDocuments error: %v", err)
+			assert.Equal(mt, int64(3), count, "expected 3 documents in output collection, got %v", count)
+		})
 		mt.Run("options", func(mt *mtest.T) {
 			testAggregateWithOptions(mt, false, options.Aggregate().SetAllowDiskUse(true))
 		})
@@ -871,6 +1012,94 @@ func TestCollection(t *testing.T) {
 			})
 		}
 	})
+	mt.RunOpts("estimated document count with $collStats", noClientOpts, func(mt *mtest.T) {
+		collStatsOpts := options.EstimatedDocumentCount().SetUseCollStats(true)
+
+		mt.Run("matches count command", func(mt *mtest.T) {
+			initCollection(mt, mt.Coll)
+
+			want, err := mt.Coll.EstimatedDocumentCount(context.Background())
+			assert.Nil(mt, err, "EstimatedDocumentCount error: %v", err)
+
+			mt.ClearEvents()
+			got, err := mt.Coll.EstimatedDocumentCount(context.Background(), collStatsOpts)
+			assert.Nil(mt, err, "EstimatedDocumentCount error: %v", err)
+			assert.Equal(mt, want, got, "expected $collStats count %v to match count command %v", got, want)
+
+			evt := mt.GetStartedEvent()
+			assert.Equal(mt, "aggregate", evt.CommandName, "expected command 'aggregate', got %q", evt.CommandName)
+		})
+		mt.Run("missing collection", func(mt *mtest.T) {
+			coll := mt.DB.Collection("doesNotExist")
+
+			count, err := coll.EstimatedDocumentCount(context.Background(), collStatsOpts)
+			assert.Nil(mt, err, "EstimatedDocumentCount error: %v", err)
+			assert.Equal(mt, int64(0), count, "expected count 0, got %v", count)
+		})
+	})
+	mt.RunOpts("count summary", mtest.NewOptions().MinServerVersion("4.2"), func(mt *mtest.T) {
+		testCases := []struct {
+			name      string
+			filter    bson.D
+			opts      *options.CountSummaryOptionsBuilder
+			exact     int64
+			estimated int64
+		}{
+			{"no filter", bson.D{}, nil, 5, 0},
+			{"filter", bson.D{{"x", bson.D{{"$gt", 2}}}}, nil, 3, 0},
+			{"no matches", bson.D{{"x", bson.D{{"$gt", 10}}}}, nil, 0, 0},
+			{"include estimate", bson.D{{"x", bson.D{{"$gt", 2}}}}, options.CountSummary().SetIncludeEstimate(true), 3, 5},
+		}
+		for _, tc := range testCases {
+			mt.Run(tc.name, func(mt *mtest.T) {
+				initCollection(mt, mt.Coll)
+				before := time.Now().Add(-time.Minute)
+
+				summary, err := mt.Coll.CountSummary(context.Background(), tc.filter, tc.opts)
+				assert.Nil(mt, err, "CountSummary error: %v", err)
+				assert.Equal(mt, tc.exact, summary.Exact, "expected exact count %v, got %v", tc.exact, summary.Exact)
+				assert.Equal(mt, tc.estimated, summary.Estimated,
+					"expected estimated count %v, got %v", tc.estimated, summary.Estimated)
+				assert.True(mt, summary.StoredAt.After(before), "expected stored at after %v, got %v", before, summary.StoredAt)
+			})
+		}
+	})
+	readPrefOpts := mtest.NewOptions().Topologies(mtest.Sharded).MinServerVersion("3.6")
+	mt.RunOpts("context read preference", readPrefOpts, func(mt *mtest.T) {
+		ctx := mongo.WithReadPreference(context.Background(), readpref.SecondaryPreferred())
+
+		testCases := []struct {
+			name string
+			fn   func(*mongo.Collection) error
+		}{
+			{"find", func(coll *mongo.Collection) error {
+				return coll.FindOne(ctx, bson.D{}).Err()
+			}},
+			{"aggregate", func(coll *mongo.Collection) error {
+				_, err := coll.Aggregate(ctx, mongo.Pipeline{})
+				return err
+			}},
+			{"count documents", func(coll *mongo.Collection) error {
+				_, err := coll.CountDocuments(ctx, bson.D{})
+				return err
+			}},
+		}
+		for _, tc := range testCases {
+			mt.Run(tc.name, func(mt *mtest.T) {
+				// The context read preference takes precedence over the collection read preference.
+				coll := mt.Coll.Clone(options.Collection().SetReadPreference(readpref.Nearest()))
+				err := tc.fn(coll)
+				if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+					mt.Fatalf("operation error: %v", err)
+				}
+
+				evt := mt.GetStartedEvent()
+				mode, ok := evt.Command.Lookup("$readPreference", "mode").StringValueOK()
+				assert.True(mt, ok, "expected command %v to contain a $readPreference mode", evt.Command)
+				assert.Equal(mt, "secondaryPreferred", mode, "expected mode %q, got %q", "secondaryPreferred", mode)
+			})
+		}
+	})
 	mt.RunOpts("distinct", noClientOpts, func(mt *mtest.T) {
 		all := []int32{1, 2, 3, 4, 5}
 
@@ -1117,6 +1346,148 @@ func TestCollection(t *testing.T) {
 				return mt.Coll.Find(context.Background(), bson.D{}, options.Find().SetBatchSize(3))
 			})
 		})
+
+		base := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+		now := base
+		gen := bson.NewObjectIDGenerator(func() time.Time { return now }, [5]byte{1, 2, 3, 4, 5}, 0)
+		idGenOpts := mtest.NewOptions().ClientOptions(options.Client().SetIDGenerator(func() interface{} {
+			return gen.NewObjectID()
+		}))
+		mt.RunOpts("ObjectID time range filter", idGenOpts, func(mt *mtest.T) {
+			// Insert documents with generated _id values at base+0s through base+4s.
+			for i := 0; i < 5; i++ {
+				now = base.Add(time.Duration(i) * time.Second)
+				_, err := mt.Coll.InsertOne(context.Background(), bson.D{{"x", int32(i)}})
+				assert.Nil(mt, err, "InsertOne error: %v", err)
+			}
+
+			filter := bson.ObjectIDTimeRangeFilter(base.Add(time.Second), base.Add(3*time.Second))
+			cursor, err := mt.Coll.Find(context.Background(), filter, options.Find().SetSort(bson.D{{"x", 1}}))
+			assert.Nil(mt, err, "Find error: %v", err)
+
+			var docs []struct {
+				X int32 `bson:"x"`
+			}
+			err = cursor.All(context.Background(), &docs)
+			assert.Nil(mt, err, "All error: %v", err)
+
+			got := make([]int32, 0, len(docs))
+			for _, doc := range docs {
+				got = append(got, doc.X)
+			}
+			want := []int32{1, 2}
+			assert.Equal(mt, want, got, "expected x values %v, got %v", want, got)
+		})
+	})
+	mt.RunOpts("find all", noClientOpts, func(mt *mtest.T) {
+		mt.Run("found", func(mt *mtest.T) {
+			initCollection(mt, mt.Coll)
+
+			var results []bson.D
+			err := mt.Coll.FindAll(context.Background(), bson.D{}, &results,
+				options.Find().SetSort(bson.D{{"x", 1}}).SetProjection(bson.D{{"_id", 0}}))
+			assert.Nil(mt, err, "FindAll error: %v", err)
+
+			assert.Equal(mt, 5, len(results), "expected 5 results, got %v", len(results))
+			for i, doc := range results {
+				expected := bson.D{{"x", int32(i + 1)}}
+				assert.Equal(mt, expected, doc, "expected document %v, got %v", expected, doc)
+			}
+		})
+		mt.Run("generic", func(mt *mtest.T) {
+			initCollection(mt, mt.Coll)
+
+			type doc struct {
+				X int32 `bson:"x"`
+			}
+			results, err := mongo.FindAll[doc](context.Background(), mt.Coll, bson.D{{"x", bson.D{{"$gt", 3}}}},
+				options.Find().SetSort(bson.D{{"x", 1}}))
+			assert.Nil(mt, err, "FindAll error: %v", err)
+
+			expected := []doc{{X: 4}, {X: 5}}
+			assert.Equal(mt, expected, results, "expected results %v, got %v", expected, results)
+		})
+		mt.Run("find error", func(mt *mtest.T) {
+			var results []bson.D
+			err := mt.Coll.FindAll(context.Background(), bson.D{{"$foo", 1}}, &results)
+			assert.NotNil(mt, err, "expected error for invalid identifier, got nil")
+			assert.Nil(mt, results, "expected nil results, got %v", results)
+		})
+		mt.Run("cursor closed on normal return", func(mt *mtest.T) {
+			initCollection(mt, mt.Coll)
+			mt.ClearEvents()
+
+			var results []bson.D
+			err := mt.Coll.FindAll(context.Background(), bson.D{}, &results, options.Find().SetBatchSize(2))
+			assert.Nil(mt, err, "FindAll error: %v", err)
+			assert.Equal(mt, 5, len(results), "expected 5 results, got %v", len(results))
+
+			// The cursor is exhausted by getMore commands, so no killCursors should be necessary.
+			for evt := mt.GetStartedEvent(); evt != nil; evt = mt.GetStartedEvent() {
+				assert.NotEqual(mt, "killCursors", evt.CommandName, "unexpected killCursors command")
+			}
+		})
+		mt.Run("cursor closed on decode error", func(mt *mtest.T) {
+			initCollection(mt, mt.Coll)
+			mt.ClearEvents()
+
+			var results []struct {
+				X string `bson:"x"`
+			}
+			err := mt.Coll.FindAll(context.Background(), bson.D{}, &results, options.Find().SetBatchSize(2))
+			assert.NotNil(mt, err, "expected decode error, got nil")
+
+			evt := mt.GetStartedEvent()
+			assert.Equal(mt, "find", evt.CommandName, "expected command 'find', got %q", evt.CommandName)
+			evt = mt.GetStartedEvent()
+			assert.Equal(mt, "killCursors", evt.CommandName, "expected command 'killCursors', got %q", evt.CommandName)
+		})
+		mt.Run("cursor closed on invalid results", func(mt *mtest.T) {
+			initCollection(mt, mt.Coll)
+			mt.ClearEvents()
+
+			var results []bson.D
+			err := mt.Coll.FindAll(context.Background(), bson.D{}, results, options.Find().SetBatchSize(2))
+			assert.NotNil(mt, err, "expected error for non-pointer results, got nil")
+
+			evt := mt.GetStartedEvent()
+			assert.Equal(mt, "find", evt.CommandName, "expected command 'find', got %q", evt.CommandName)
+			evt = mt.GetStartedEvent()
+			assert.Equal(mt, "killCursors", evt.CommandName, "expected command 'killCursors', got %q", evt.CommandName)
+		})
+		mt.RunOpts("cursor closed on context cancellation",
+			// Blocking failpoints don't work on pre-4.2 and sharded clusters.
+			mtest.NewOptions().Topologies(mtest.Single, mtest.ReplicaSet).MinServerVersion("4.2"),
+			func(mt *mtest.T) {
+				initCollection(mt, mt.Coll)
+				mt.SetFailPoint(failpoint.FailPoint{
+					ConfigureFailPoint: "failCommand",
+					Mode: failpoint.Mode{
+						Times: 1,
+					},
+					Data: failpoint.Data{
+						FailCommands:    []string{"getMore"},
+						BlockConnection: true,
+						BlockTimeMS:     500,
+					},
+				})
+				mt.ClearEvents()
+
+				ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+				defer cancel()
+
+				var results []bson.D
+				err := mt.Coll.FindAll(ctx, bson.D{}, &results, options.Find().SetBatchSize(2))
+				assert.NotNil(mt, err, "expected context error, got nil")
+
+				var killCursorsSent bool
+				for evt := mt.GetStartedEvent(); evt != nil; evt = mt.GetStartedEvent() {
+					if evt.CommandName == "killCursors" {
+						killCursorsSent = true
+					}
+				}
+				assert.True(mt, killCursorsSent, "expected killCursors command to be sent")
+			})
 	})
 	mt.RunOpts("find one", noClientOpts, func(mt *mtest.T) {
 		mt.Run("limit", func(mt *mtest.T) {
@@ -1141,6 +1512,18 @@ func TestCollection(t *testing.T) {
 			got := x.Int32()
 			assert.Equal(mt, int32(1), got, "expected x value 1, got %v", got)
 		})
+		mt.Run("assert single match", func(mt *mtest.T) {
+			initCollection(mt, mt.Coll)
+			opts := options.FindOne().SetAssertSingleMatch(true)
+
+			err := mt.Coll.FindOne(context.Background(), bson.D{{"x", bson.D{{"$gt", 3}}}}, opts).Err()
+			assert.ErrorIs(mt, err, mongo.ErrMultipleDocuments, "expected error %v, got %v", mongo.ErrMultipleDocuments, err)
+
+			res, err := mt.Coll.FindOne(context.Background(), bson.D{{"x", 5}}, opts).Raw()
+			assert.Nil(mt, err, "FindOne error: %v", err)
+			got := res.Lookup("x").Int32()
+			assert.Equal(mt, int32(5), got, "expected x value 5, got %v", got)
+		})
 		mt.RunOpts("options", mtest.NewOptions().MinServerVersion("3.4"), func(mt *mtest.T) {
 			initCollection(mt, mt.Coll)
 
@@ -1628,16 +2011,19 @@ func TestCollection(t *testing.T) {
 				name           string
 				ordered        bool
 				insertedCount  int64
+				insertedIDs    map[int64]interface{}
 				numWriteErrors int
 			}{
-				{"ordered", true, 1, 1},
-				{"unordered", false, 2, 2},
+				{"ordered", true, 1, map[int64]interface{}{0: "x"}, 1},
+				{"unordered", false, 2, map[int64]interface{}{0: "x", 2: "y"}, 2},
 			}
 			for _, tc := range testCases {
 				mt.Run(tc.name, func(mt *mtest.T) {
 					res, err := mt.Coll.BulkWrite(context.Background(), models, options.BulkWrite().SetOrdered(tc.ordered))
 					assert.Equal(mt, tc.insertedCount, res.InsertedCount,
 						"expected inserted count %v, got %v", tc.insertedCount, res.InsertedCount)
+					assert.Equal(mt, tc.insertedIDs, res.InsertedIDs,
+						"expected inserted IDs %v, got %v", tc.insertedIDs, res.InsertedIDs)
 
 					bwe, ok := err.(mongo.BulkWriteException)
 					assert.True(mt, ok, "expected error type %v, got %v", mongo.BulkWriteException{}, err)
@@ -1649,6 +2035,31 @@ func TestCollection(t *testing.T) {
 				})
 			}
 		})
+		mt.Run("inserted IDs", func(mt *mtest.T) {
+			_, err := mt.Coll.InsertOne(context.Background(), bson.D{{"_id", 1}, {"x", 1}})
+			assert.Nil(mt, err, "InsertOne error: %v", err)
+
+			models := []mongo.WriteModel{
+				mongo.NewInsertOneModel().SetDocument(bson.D{{"_id", "a"}}),
+				mongo.NewUpdateOneModel().SetFilter(bson.D{{"_id", 1}}).SetUpdate(bson.D{{"$inc", bson.D{{"x", 1}}}}),
+				mongo.NewInsertOneModel().SetDocument(bson.D{{"x", 2}}),
+				mongo.NewUpdateOneModel().SetFilter(bson.D{{"_id", 2}}).SetUpdate(bson.D{{"$set", bson.D{{"x", 3}}}}).
+					SetUpsert(true),
+			}
+			res, err := mt.Coll.BulkWrite(context.Background(), models)
+			assert.Nil(mt, err, "BulkWrite error: %v", err)
+
+			assert.Equal(mt, int64(2), res.InsertedCount, "expected inserted count 2, got %v", res.InsertedCount)
+			assert.Equal(mt, 2, len(res.InsertedIDs), "expected 2 inserted IDs, got %v", res.InsertedIDs)
+			assert.Equal(mt, "a", res.InsertedIDs[0], "expected inserted ID %v, got %v", "a", res.InsertedIDs[0])
+			generatedID, ok := res.InsertedIDs[2].(bson.ObjectID)
+			assert.True(mt, ok, "expected generated ID to be an ObjectID, got %T", res.InsertedIDs[2])
+
+			err = mt.Coll.FindOne(context.Background(), bson.D{{"_id", generatedID}}).Err()
+			assert.Nil(mt, err, "FindOne error: %v", err)
+			assert.Equal(mt, map[int64]interface{}{3: int32(2)}, res.UpsertedIDs,
+				"expected upserted IDs %v, got %v", map[int64]interface{}{3: int32(2)}, res.UpsertedIDs)
+		})
 		mt.RunOpts("delete write errors", mtest.NewOptions().MaxServerVersion("5.0.7"), func(mt *mtest.T) {
 			// Deletes are not allowed on capped collections on MongoDB 5.0.6-. We use this
 			// behavior to test the processing of write errors.
@@ -16,12 +16,12 @@ import (
 
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/internal/assert"
-	"go.mongodb.org/mongo-driver/v2/internal/failpoint"
 	"go.mongodb.org/mongo-driver/v2/internal/handshake"
 	"go.mongodb.org/mongo-driver/v2/internal/integration/mtest"
 	"go.mongodb.org/mongo-driver/v2/mongo"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 	"go.mongodb.org/mongo-driver/v2/mongo/readpref"
+	"go.mongodb.org/mongo-driver/v2/mongo/testutil/failpoint"
 	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
 )
 
@@ -168,6 +168,22 @@ func TestDatabase(t *testing.T) {
 				assert.Equal(mt, tc.found, found, "expected to find collection: %v, found collection: %v", tc.found, found)
 			})
 		}
+		mt.Run("filter on non-name field", func(mt *mtest.T) {
+			mt.CreateCollection(mtest.Collection{
+				Name:       listCollCapped,
+				CreateOpts: options.CreateCollection().SetCapped(true).SetSizeInBytes(64 * 1024),
+			}, true)
+
+			mt.ClearEvents()
+			colls, err := mt.DB.ListCollectionNames(context.Background(), bson.D{{"options.capped", true}})
+			assert.Nil(mt, err, "ListCollectionNames error: %v", err)
+			assert.Equal(mt, []string{listCollCapped}, colls, "expected collections %v, got %v",
+				[]string{listCollCapped}, colls)
+
+			evt := mt.GetStartedEvent()
+			nameOnly, ok := evt.Command.Lookup("nameOnly").BooleanOK()
+			assert.False(mt, ok && nameOnly, "expected nameOnly to not be true, got command %v", evt.Command)
+		})
 	})
 
 	mt.RunOpts("list collections", noClientOpts, func(mt *mtest.T) {
@@ -20,6 +20,7 @@ import (
 	"go.mongodb.org/mongo-driver/v2/internal/assert"
 	"go.mongodb.org/mongo-driver/v2/internal/integration/mtest"
 	"go.mongodb.org/mongo-driver/v2/internal/israce"
+	"go.mongodb.org/mongo-driver/v2/internal/ptrutil"
 	"go.mongodb.org/mongo-driver/v2/mongo"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 )
@@ -526,6 +527,94 @@ func TestGridFS(x *testing.T) {
 
 		assert.Nil(mt, err, "Find error: %v", err)
 	})
+	mt.Run("FindFiles", func(mt *mtest.T) {
+		bucket := mt.DB.GridFSBucket()
+
+		// Upload the files with different lengths so they can be told apart, and sleep between uploads so each
+		// file has a distinct uploadDate.
+		uploads := []struct {
+			filename string
+			data     []byte
+		}{
+			{"a", []byte("1")},
+			{"b", []byte("22")},
+			{"a", []byte("333")},
+		}
+		for _, upload := range uploads {
+			_, err := bucket.UploadFromStream(context.Background(), upload.filename, bytes.NewReader(upload.data))
+			assert.Nil(mt, err, "UploadFromStream error: %v", err)
+			time.Sleep(5 * time.Millisecond)
+		}
+
+		mt.Run("sort by uploadDate", func(mt *mtest.T) {
+			files, err := bucket.FindFiles(context.Background(), bson.D{},
+				options.GridFSFind().SetSort(bson.D{{"uploadDate", -1}}))
+			assert.Nil(mt, err, "FindFiles error: %v", err)
+
+			var lengths []int64
+			for _, file := range files {
+				lengths = append(lengths, file.Length)
+			}
+			assert.Equal(mt, []int64{3, 2, 1}, lengths, "expected file lengths %v, got %v", []int64{3, 2, 1}, lengths)
+		})
+		mt.Run("filter by filename", func(mt *mtest.T) {
+			files, err := bucket.FindFiles(context.Background(), bson.D{{"filename", "a"}})
+			assert.Nil(mt, err, "FindFiles error: %v", err)
+			assert.Len(mt, files, 2, "expected 2 files, got %d", len(files))
+			for _, file := range files {
+				assert.Equal(mt, "a", file.Name, "expected filename %q, got %q", "a", file.Name)
+				_, ok := file.ID.(bson.ObjectID)
+				assert.True(mt, ok, "expected ID to be an ObjectID, got %T", file.ID)
+			}
+		})
+		mt.Run("FindOneFile", func(mt *mtest.T) {
+			file, err := bucket.FindOneFile(context.Background(), bson.D{{"filename", "a"}},
+				options.GridFSFind().SetSort(bson.D{{"uploadDate", -1}}))
+			assert.Nil(mt, err, "FindOneFile error: %v", err)
+			assert.Equal(mt, int64(3), file.Length, "expected length 3, got %d", file.Length)
+
+			_, err = bucket.FindOneFile(context.Background(), bson.D{{"filename", "c"}})
+			assert.ErrorIs(mt, err, mongo.ErrFileNotFound)
+		})
+		mt.Run("FindOneByName", func(mt *mtest.T) {
+			testCases := []struct {
+				name       string
+				revision   *int32
+				wantLength int64
+			}{
+				{"default is latest", nil, 3},
+				{"latest", ptrutil.Ptr[int32](-1), 3},
+				{"original", ptrutil.Ptr[int32](0), 1},
+				{"first revision", ptrutil.Ptr[int32](1), 3},
+				{"second most recent", ptrutil.Ptr[int32](-2), 1},
+			}
+			for _, tc := range testCases {
+				mt.Run(tc.name, func(mt *mtest.T) {
+					nameOpts := options.GridFSName()
+					if tc.revision != nil {
+						nameOpts.SetRevision(*tc.revision)
+					}
+
+					file, err := bucket.FindOneByName(context.Background(), "a", nameOpts)
+					assert.Nil(mt, err, "FindOneByName error: %v", err)
+					assert.Equal(mt, tc.wantLength, file.Length, "expected length %d, got %d", tc.wantLength,
+						file.Length)
+				})
+			}
+
+			_, err := bucket.FindOneByName(context.Background(), "a", options.GridFSName().SetRevision(2))
+			assert.ErrorIs(mt, err, mongo.ErrFileNotFound)
+		})
+		mt.Run("FileExists", func(mt *mtest.T) {
+			exists, err := bucket.FileExists(context.Background(), "b")
+			assert.Nil(mt, err, "FileExists error: %v", err)
+			assert.True(mt, exists, "expected file %q to exist", "b")
+
+			exists, err = bucket.FileExists(context.Background(), "c")
+			assert.Nil(mt, err, "FileExists error: %v", err)
+			assert.False(mt, exists, "expected file %q to not exist", "c")
+		})
+	})
 }
 
 func assertGridFSCollectionState(mt *mtest.T, coll *mongo.Collection, expectedName string, expectedNumDocuments int64) {
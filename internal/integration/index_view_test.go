@@ -13,10 +13,11 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/internal/assert"
-	"go.mongodb.org/mongo-driver/v2/internal/failpoint"
 	"go.mongodb.org/mongo-driver/v2/internal/integration/mtest"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
 	"go.mongodb.org/mongo-driver/v2/mongo"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.mongodb.org/mongo-driver/v2/mongo/testutil/failpoint"
 	"go.mongodb.org/mongo-driver/v2/mongo/writeconcern"
 	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
 )
@@ -317,6 +318,75 @@ func TestIndexView(t *testing.T) {
 				Name: indexNames[1],
 			})
 		})
+		mt.Run("ignore conflicts", func(mt *mtest.T) {
+			ignoreOpts := options.CreateIndexes().SetIgnoreConflicts(true)
+			fooModel := mongo.IndexModel{Keys: bson.D{{"foo", 1}}}
+			barModel := mongo.IndexModel{Keys: bson.D{{"bar", -1}}, Options: options.Index().SetUnique(true)}
+
+			mt.Run("all new", func(mt *mtest.T) {
+				res, err := mt.Coll.Indexes().CreateManyWithResult(context.Background(),
+					[]mongo.IndexModel{fooModel, barModel}, ignoreOpts)
+				require.NoError(mt, err, "CreateManyWithResult error")
+
+				assert.Equal(mt, []string{"foo_1", "bar_-1"}, res.Created, "expected all indexes to be created")
+				assert.Len(mt, res.Skipped, 0, "expected no indexes to be skipped, got %v", res.Skipped)
+			})
+			mt.Run("all existing", func(mt *mtest.T) {
+				_, err := mt.Coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{fooModel, barModel})
+				require.NoError(mt, err, "CreateMany error")
+
+				mt.ClearEvents()
+				res, err := mt.Coll.Indexes().CreateManyWithResult(context.Background(),
+					[]mongo.IndexModel{fooModel, barModel}, ignoreOpts)
+				require.NoError(mt, err, "CreateManyWithResult error")
+
+				assert.Len(mt, res.Created, 0, "expected no indexes to be created, got %v", res.Created)
+				assert.Equal(mt, []string{"foo_1", "bar_-1"}, res.Skipped, "expected all indexes to be skipped")
+				for evt := mt.GetStartedEvent(); evt != nil; evt = mt.GetStartedEvent() {
+					assert.NotEqual(mt, "createIndexes", evt.CommandName, "expected no createIndexes command")
+				}
+			})
+			mt.Run("mixed", func(mt *mtest.T) {
+				_, err := mt.Coll.Indexes().CreateOne(context.Background(), fooModel)
+				require.NoError(mt, err, "CreateOne error")
+
+				res, err := mt.Coll.Indexes().CreateManyWithResult(context.Background(),
+					[]mongo.IndexModel{fooModel, barModel}, ignoreOpts)
+				require.NoError(mt, err, "CreateManyWithResult error")
+
+				assert.Equal(mt, []string{"bar_-1"}, res.Created, "expected new index to be created")
+				assert.Equal(mt, []string{"foo_1"}, res.Skipped, "expected existing index to be skipped")
+				verifyIndexExists(mt, mt.Coll.Indexes(), index{Key: bson.D{{"bar", int32(-1)}}, Name: "bar_-1"})
+			})
+			mt.Run("conflicting spec", func(mt *mtest.T) {
+				_, err := mt.Coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+					Keys:    bson.D{{"foo", 1}},
+					Options: options.Index().SetName("conflict"),
+				})
+				require.NoError(mt, err, "CreateOne error")
+
+				_, err = mt.Coll.Indexes().CreateManyWithResult(context.Background(), []mongo.IndexModel{{
+					Keys:    bson.D{{"bar", 1}},
+					Options: options.Index().SetName("conflict"),
+				}}, ignoreOpts)
+				assert.NotNil(mt, err, "expected CreateManyWithResult error, got nil")
+			})
+			mt.Run("error on conflict", func(mt *mtest.T) {
+				_, err := mt.Coll.Indexes().CreateOne(context.Background(), fooModel)
+				require.NoError(mt, err, "CreateOne error")
+
+				mt.ClearEvents()
+				res, err := mt.Coll.Indexes().CreateManyWithResult(context.Background(), []mongo.IndexModel{fooModel},
+					options.CreateIndexes().SetIgnoreConflicts(true).SetErrorOnConflict(true))
+				require.NoError(mt, err, "CreateManyWithResult error")
+
+				// The server accepts identical indexes, so the strict mode only shows up as the index being sent.
+				assert.Equal(mt, []string{"foo_1"}, res.Created, "expected index to be sent to the server")
+				evt := mt.GetStartedEvent()
+				require.NotNil(mt, evt, "expected a started event")
+				assert.Equal(mt, "createIndexes", evt.CommandName, "expected createIndexes command")
+			})
+		})
 		wc := writeconcern.W1()
 		wcMtOpts := mtest.NewOptions().CollectionOptions(options.Collection().SetWriteConcern(wc))
 		mt.RunOpts("uses writeconcern", wcMtOpts, func(mt *mtest.T) {
@@ -11,8 +11,8 @@ import (
 	"fmt"
 
 	"go.mongodb.org/mongo-driver/v2/bson"
-	"go.mongodb.org/mongo-driver/v2/internal/failpoint"
 	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/testutil/failpoint"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/connstring"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/topology"
 )
@@ -78,11 +78,8 @@ func ServerVersion() string {
 
 // SetFailPoint configures the provided fail point on the cluster under test using the provided Client.
 func SetFailPoint(fp failpoint.FailPoint, client *mongo.Client) error {
-	admin := client.Database("admin")
-	if err := admin.RunCommand(context.Background(), fp).Err(); err != nil {
-		return fmt.Errorf("error creating fail point: %w", err)
-	}
-	return nil
+	_, err := failpoint.Set(context.Background(), client, fp)
+	return err
 }
 
 // SetRawFailPoint configures the fail point represented by the fp parameter on the cluster under test using the
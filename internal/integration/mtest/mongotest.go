@@ -19,13 +19,13 @@ import (
 	"go.mongodb.org/mongo-driver/v2/event"
 	"go.mongodb.org/mongo-driver/v2/internal/assert"
 	"go.mongodb.org/mongo-driver/v2/internal/csfle"
-	"go.mongodb.org/mongo-driver/v2/internal/failpoint"
 	"go.mongodb.org/mongo-driver/v2/internal/mongoutil"
 	"go.mongodb.org/mongo-driver/v2/internal/require"
 	"go.mongodb.org/mongo-driver/v2/mongo"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 	"go.mongodb.org/mongo-driver/v2/mongo/readconcern"
 	"go.mongodb.org/mongo-driver/v2/mongo/readpref"
+	"go.mongodb.org/mongo-driver/v2/mongo/testutil/failpoint"
 	"go.mongodb.org/mongo-driver/v2/mongo/writeconcern"
 	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver"
@@ -547,15 +547,9 @@ func (t *T) TrackFailPoint(fpName string) {
 
 // ClearFailPoints disables all previously set failpoints for this test.
 func (t *T) ClearFailPoints() {
-	db := t.Client.Database("admin")
 	for _, fp := range t.failPointNames {
-		cmd := failpoint.FailPoint{
-			ConfigureFailPoint: fp,
-			Mode:               failpoint.ModeOff,
-		}
-		err := db.RunCommand(context.Background(), cmd).Err()
-		if err != nil {
-			t.Fatalf("error clearing fail point %s: %v", fp, err)
+		if err := failpoint.Disable(context.Background(), t.Client, fp); err != nil {
+			t.Fatal(err)
 		}
 	}
 	t.failPointNames = t.failPointNames[:0]
@@ -9,6 +9,7 @@ package integration
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"testing"
@@ -18,12 +19,12 @@ import (
 	"go.mongodb.org/mongo-driver/v2/event"
 	"go.mongodb.org/mongo-driver/v2/internal/assert"
 	"go.mongodb.org/mongo-driver/v2/internal/eventtest"
-	"go.mongodb.org/mongo-driver/v2/internal/failpoint"
 	"go.mongodb.org/mongo-driver/v2/internal/integration/mtest"
 	"go.mongodb.org/mongo-driver/v2/internal/mongoutil"
 	"go.mongodb.org/mongo-driver/v2/internal/require"
 	"go.mongodb.org/mongo-driver/v2/mongo"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.mongodb.org/mongo-driver/v2/mongo/testutil/failpoint"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver"
 )
 
@@ -286,6 +287,57 @@ func TestRetryableWritesProse(t *testing.T) {
 			require.True(mt, err.(mongo.WriteException).HasErrorCode(int(shutdownInProgressErrorCode)))
 		})
 
+	mtWCELabelOpts := mtest.NewOptions().MinServerVersion("4.4").Topologies(mtest.ReplicaSet)
+	mt.RunOpts("write concern error labels", mtWCELabelOpts, func(mt *mtest.T) {
+		const shutdownInProgressErrorCode int32 = 91
+
+		setRetryableWCEFailPoint := func(mt *mtest.T) {
+			mt.SetFailPoint(failpoint.FailPoint{
+				ConfigureFailPoint: "failCommand",
+				Mode:               failpoint.Mode{Times: 1},
+				Data: failpoint.Data{
+					FailCommands: []string{"insert"},
+					WriteConcernError: &failpoint.WriteConcernError{
+						Code:        shutdownInProgressErrorCode,
+						ErrorLabels: &[]string{driver.RetryableWriteError},
+					},
+				},
+			})
+		}
+
+		mt.Run("labeled write concern error is retried once", func(mt *mtest.T) {
+			mt.ResetClient(options.Client().SetRetryWrites(true))
+			setRetryableWCEFailPoint(mt)
+			mt.ClearEvents()
+
+			_, err := mt.Coll.InsertOne(context.Background(), bson.D{{"x", 1}})
+			require.NoError(mt, err, "InsertOne error")
+
+			var inserts int
+			for evt := mt.GetStartedEvent(); evt != nil; evt = mt.GetStartedEvent() {
+				if evt.CommandName == "insert" {
+					inserts++
+				}
+			}
+			assert.Equal(mt, 2, inserts, "expected 2 insert commands, got %v", inserts)
+		})
+		mt.Run("labels are surfaced on the returned error", func(mt *mtest.T) {
+			mt.ResetClient(options.Client().SetRetryWrites(false))
+			setRetryableWCEFailPoint(mt)
+
+			_, err := mt.Coll.InsertOne(context.Background(), bson.D{{"x", 1}})
+			require.Error(mt, err, "expected InsertOne error, got nil")
+
+			var we mongo.WriteException
+			require.True(mt, errors.As(err, &we), "expected error to be a WriteException, got %T", err)
+			require.NotNil(mt, we.WriteConcernError, "expected a write concern error, got nil")
+			assert.True(mt, we.HasErrorLabel(driver.RetryableWriteError),
+				"expected error to have label %q", driver.RetryableWriteError)
+			assert.True(mt, we.WriteConcernError.HasErrorLabel(driver.RetryableWriteError),
+				"expected write concern error to have label %q", driver.RetryableWriteError)
+		})
+	})
+
 	mtOpts = mtest.NewOptions().Topologies(mtest.Sharded).MinServerVersion("4.2")
 	mt.RunOpts("retrying in sharded cluster", mtOpts, func(mt *mtest.T) {
 		tests := []struct {
@@ -107,6 +107,55 @@ func TestSessions(t *testing.T) {
 		})
 	})
 
+	mt.Run("UseSession", func(mt *mtest.T) {
+		secondaryOpts := mtest.NewOptions().
+			MinServerVersion("5.0").
+			Topologies(mtest.ReplicaSet).
+			CollectionOptions(options.Collection().SetReadPreference(readpref.Secondary()))
+		mt.RunOpts("snapshot read concern propagated", secondaryOpts, func(mt *mtest.T) {
+			// A snapshot session started by UseSessionWithOptions should send readConcern level "snapshot" on reads
+			// performed from a secondary within the callback.
+			sessOpts := options.Session().SetSnapshot(true)
+			err := mt.Client.UseSessionWithOptions(context.Background(), sessOpts, func(ctx context.Context) error {
+				mt.ClearEvents()
+				cursor, err := mt.Coll.Find(ctx, bson.D{})
+				if err != nil {
+					return err
+				}
+				return cursor.Close(ctx)
+			})
+			assert.Nil(mt, err, "UseSessionWithOptions error: %v", err)
+
+			evt := mt.GetStartedEvent()
+			assert.Equal(mt, "find", evt.CommandName, "expected 'find' event, got '%v'", evt.CommandName)
+			level, _ := getReadConcernFields(mt, evt.Command)
+			assert.Equal(mt, "snapshot", level, "expected read concern level %q, got %q", "snapshot", level)
+		})
+		mt.Run("afterClusterTime propagated", func(mt *mtest.T) {
+			// Reads after the first operation in a causally consistent session should carry the session's operation
+			// time as afterClusterTime.
+			sessOpts := options.Session().SetCausalConsistency(true)
+			err := mt.Client.UseSessionWithOptions(context.Background(), sessOpts, func(ctx context.Context) error {
+				if _, err := mt.Coll.InsertOne(ctx, bson.D{{"x", 1}}); err != nil {
+					return err
+				}
+				optime := mongo.SessionFromContext(ctx).OperationTime()
+				assert.NotNil(mt, optime, "expected session operation time, got nil")
+
+				mt.ClearEvents()
+				if err := mt.Coll.FindOne(ctx, bson.D{}).Err(); err != nil {
+					return err
+				}
+
+				_, sentOptime := getReadConcernFields(mt, mt.GetStartedEvent().Command)
+				assert.NotNil(mt, sentOptime, "expected afterClusterTime on command, got nil")
+				assert.True(mt, optime.Equal(*sentOptime), "expected afterClusterTime %v, got %v", optime, sentOptime)
+				return nil
+			})
+			assert.Nil(mt, err, "UseSessionWithOptions error: %v", err)
+		})
+	})
+
 	unackWcOpts := options.Collection().SetWriteConcern(writeconcern.Unacknowledged())
 	mt.RunOpts("unacknowledged write", mtest.NewOptions().CollectionOptions(unackWcOpts), func(mt *mtest.T) {
 		// unacknowledged write during a session should result in an error
@@ -780,7 +780,7 @@ func (em *EntityMap) addSessionEntity(entityOptions *entityOptions) error {
 		sessionOpts = entityOptions.SessionOptions.SessionOptionsBuilder
 	}
 
-	sess, err := client.StartSession(sessionOpts)
+	sess, err := client.StartSessionWithContext(context.Background(), sessionOpts)
 	if err != nil {
 		return fmt.Errorf("error starting session: %w", err)
 	}
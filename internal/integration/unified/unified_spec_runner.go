@@ -21,6 +21,7 @@ import (
 	"go.mongodb.org/mongo-driver/v2/internal/integration/mtest"
 	"go.mongodb.org/mongo-driver/v2/internal/spectest"
 	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/testutil/failpoint"
 )
 
 var (
@@ -369,9 +370,5 @@ func disableTargetedFailPoints(ctx context.Context) []error {
 }
 
 func disableFailPointWithClient(ctx context.Context, fpName string, client *mongo.Client) error {
-	cmd := bson.D{
-		{"configureFailPoint", fpName},
-		{"mode", "off"},
-	}
-	return client.Database("admin").RunCommand(ctx, cmd).Err()
+	return failpoint.Disable(ctx, client, fpName)
 }
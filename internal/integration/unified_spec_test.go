@@ -24,7 +24,6 @@ import (
 	"go.mongodb.org/mongo-driver/v2/event"
 	"go.mongodb.org/mongo-driver/v2/internal/assert"
 	"go.mongodb.org/mongo-driver/v2/internal/bsonutil"
-	"go.mongodb.org/mongo-driver/v2/internal/failpoint"
 	"go.mongodb.org/mongo-driver/v2/internal/integration/mtest"
 	"go.mongodb.org/mongo-driver/v2/internal/integtest"
 	"go.mongodb.org/mongo-driver/v2/internal/spectest"
@@ -33,6 +32,7 @@ import (
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 	"go.mongodb.org/mongo-driver/v2/mongo/readconcern"
 	"go.mongodb.org/mongo-driver/v2/mongo/readpref"
+	"go.mongodb.org/mongo-driver/v2/mongo/testutil/failpoint"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/session"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/topology"
 )
@@ -450,18 +450,17 @@ func executeTestRunnerOperation(mt *mtest.T, testCase *testCase, op *operation,
 			return errors.New("expected valid session, got nil")
 		}
 		targetHost := clientSession.PinnedServerAddr.String()
-		opts := options.Client().ApplyURI(mtest.ClusterURI()).SetHosts([]string{targetHost})
+		opts := options.Client().ApplyURI(mtest.ClusterURI())
 		integtest.AddTestServerAPIVersion(opts)
-		client, err := mongo.Connect(opts)
+		disable, err := failpoint.SetOnHost(context.Background(), opts, targetHost, fp)
 		if err != nil {
-			return fmt.Errorf("Connect error for targeted client: %w", err)
-		}
-		defer func() { _ = client.Disconnect(context.Background()) }()
-
-		if err = client.Database("admin").RunCommand(context.Background(), fp).Err(); err != nil {
 			return fmt.Errorf("error setting targeted fail point: %w", err)
 		}
-		mt.TrackFailPoint(fp.ConfigureFailPoint)
+		mt.Cleanup(func() {
+			if err := disable(context.Background()); err != nil {
+				mt.Errorf("error disabling targeted fail point: %v", err)
+			}
+		})
 	case "configureFailPoint":
 		fp, err := op.Arguments.LookupErr("failPoint")
 		if err != nil {
@@ -28,6 +28,8 @@ const (
 	ConnectionCheckoutFailed         = "Connection checkout failed"
 	ConnectionCheckedOut             = "Connection checked out"
 	ConnectionCheckedIn              = "Connection checked in"
+	ConnectionCompressionRejected    = "Connection compressors rejected"
+	CursorLeaked                     = "Cursor garbage collected without being closed"
 	ServerSelectionFailed            = "Server selection failed"
 	ServerSelectionStarted           = "Server selection started"
 	ServerSelectionSucceeded         = "Server selection succeeded"
@@ -46,6 +48,8 @@ const (
 	KeyAwaited             = "awaited"
 	KeyCommand             = "command"
 	KeyCommandName         = "commandName"
+	KeyCompressors         = "compressors"
+	KeyCursorID            = "cursorId"
 	KeyDatabaseName        = "databaseName"
 	KeyDriverConnectionID  = "driverConnectionId"
 	KeyDurationMS          = "durationMS"
@@ -56,11 +60,14 @@ const (
 	KeyMaxPoolSize         = "maxPoolSize"
 	KeyMessage             = "message"
 	KeyMinPoolSize         = "minPoolSize"
+	KeyNamespace           = "namespace"
 	KeyNewDescription      = "newDescription"
 	KeyOperation           = "operation"
 	KeyOperationID         = "operationId"
 	KeyPreviousDescription = "previousDescription"
 	KeyRemainingTimeMS     = "remainingTimeMS"
+	KeyReadConcern         = "readConcern"
+	KeyReadConcernSource   = "readConcernSource"
 	KeyReason              = "reason"
 	KeyReply               = "reply"
 	KeyRequestID           = "requestId"
@@ -69,9 +76,12 @@ const (
 	KeyServerHost          = "serverHost"
 	KeyServerPort          = "serverPort"
 	KeyServiceID           = "serviceId"
+	KeyStack               = "stack"
 	KeyTimestamp           = "timestamp"
 	KeyTopologyDescription = "topologyDescription"
 	KeyTopologyID          = "topologyId"
+	KeyWriteConcern        = "writeConcern"
+	KeyWriteConcernSource  = "writeConcernSource"
 )
 
 // KeyValues is a list of key-value pairs.
@@ -0,0 +1,53 @@
+// Copyright (C) MongoDB, Inc. 2026-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package stringutil
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+)
+
+func TestSliceContains(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		source []string
+		target string
+		want   bool
+	}{
+		{
+			name:   "nil",
+			target: "zlib",
+			want:   false,
+		},
+		{
+			name:   "found",
+			source: []string{"snappy", "zlib"},
+			target: "zlib",
+			want:   true,
+		},
+		{
+			name:   "not found",
+			source: []string{"snappy", "zlib"},
+			target: "zstd",
+			want:   false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test // capture the range variable
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := SliceContains(test.source, test.target)
+			assert.Equal(t, test.want, got, "expected %v, got %v", test.want, got)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright (C) MongoDB, Inc. 2026-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// AggregateCache stores the results of aggregations run with the CacheKey and CacheTTL aggregate options. See
+// Collection.WithAggregateCache. Implementations must be safe for concurrent use.
+type AggregateCache interface {
+	// Get returns the results stored under key and true, or false if there are no results for key or they have
+	// expired. The caller must not modify the returned documents.
+	Get(key string) ([]bson.Raw, bool)
+
+	// Set stores results under key for ttl, replacing any results already stored under key. The cache owns the
+	// documents after Set is called.
+	Set(key string, results []bson.Raw, ttl time.Duration)
+}
+
+// NewInMemoryAggregateCache returns an AggregateCache that keeps up to maxEntries results in memory. When the cache
+// is full, the least recently used results are evicted. If maxEntries is not positive, the number of entries is not
+// limited.
+func NewInMemoryAggregateCache(maxEntries int) AggregateCache {
+	return &inMemoryAggregateCache{
+		maxEntries: maxEntries,
+		entries:    make(map[string]*list.Element),
+		lru:        list.New(),
+		now:        time.Now,
+	}
+}
+
+type inMemoryAggregateCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	entries    map[string]*list.Element
+	lru        *list.List // Most recently used entries are at the front.
+	now        func() time.Time
+}
+
+type aggregateCacheEntry struct {
+	key     string
+	results []bson.Raw
+	expires time.Time
+}
+
+var _ AggregateCache = &inMemoryAggregateCache{}
+
+func (c *inMemoryAggregateCache) Get(key string) ([]bson.Raw, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := elem.Value.(*aggregateCacheEntry)
+	if !c.now().Before(entry.expires) {
+		c.lru.Remove(elem)
+		delete(c.entries, key)
+		return nil, false
+	}
+
+	c.lru.MoveToFront(elem)
+	return entry.results, true
+}
+
+func (c *inMemoryAggregateCache) Set(key string, results []bson.Raw, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := &aggregateCacheEntry{key: key, results: results, expires: c.now().Add(ttl)}
+	if elem, ok := c.entries[key]; ok {
+		elem.Value = entry
+		c.lru.MoveToFront(elem)
+		return
+	}
+
+	c.entries[key] = c.lru.PushFront(entry)
+	if c.maxEntries > 0 && c.lru.Len() > c.maxEntries {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*aggregateCacheEntry).key)
+	}
+}
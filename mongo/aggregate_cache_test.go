@@ -0,0 +1,258 @@
+// Copyright (C) MongoDB, Inc. 2026-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/event"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+func TestInMemoryAggregateCache(t *testing.T) {
+	results := func(x int32) []bson.Raw {
+		doc, err := bson.Marshal(bson.D{{"x", x}})
+		require.NoError(t, err, "Marshal error")
+		return []bson.Raw{doc}
+	}
+
+	t.Run("miss", func(t *testing.T) {
+		cache := NewInMemoryAggregateCache(10)
+
+		_, ok := cache.Get("a")
+		assert.False(t, ok, "expected cache miss")
+	})
+	t.Run("hit", func(t *testing.T) {
+		cache := NewInMemoryAggregateCache(10)
+		cache.Set("a", results(1), time.Minute)
+
+		got, ok := cache.Get("a")
+		require.True(t, ok, "expected cache hit")
+		assert.Equal(t, results(1), got, "expected cached results %v, got %v", results(1), got)
+	})
+	t.Run("TTL expiry", func(t *testing.T) {
+		now := time.Now()
+		cache := NewInMemoryAggregateCache(10).(*inMemoryAggregateCache)
+		cache.now = func() time.Time { return now }
+
+		cache.Set("a", results(1), time.Minute)
+		now = now.Add(59 * time.Second)
+		_, ok := cache.Get("a")
+		assert.True(t, ok, "expected cache hit before the TTL expires")
+
+		now = now.Add(time.Second)
+		_, ok = cache.Get("a")
+		assert.False(t, ok, "expected cache miss after the TTL expires")
+		assert.Equal(t, 0, cache.lru.Len(), "expected expired entry to be removed")
+	})
+	t.Run("evicts least recently used", func(t *testing.T) {
+		cache := NewInMemoryAggregateCache(2)
+		cache.Set("a", results(1), time.Minute)
+		cache.Set("b", results(2), time.Minute)
+
+		_, ok := cache.Get("a")
+		require.True(t, ok, "expected cache hit for a")
+
+		cache.Set("c", results(3), time.Minute)
+
+		_, ok = cache.Get("b")
+		assert.False(t, ok, "expected b to be evicted")
+		_, ok = cache.Get("a")
+		assert.True(t, ok, "expected a to be kept")
+		_, ok = cache.Get("c")
+		assert.True(t, ok, "expected c to be kept")
+	})
+	t.Run("set replaces", func(t *testing.T) {
+		cache := NewInMemoryAggregateCache(1)
+		cache.Set("a", results(1), time.Minute)
+		cache.Set("a", results(2), time.Minute)
+
+		got, ok := cache.Get("a")
+		require.True(t, ok, "expected cache hit")
+		assert.Equal(t, results(2), got, "expected cached results %v, got %v", results(2), got)
+	})
+}
+
+func TestCollection_AggregateCache(t *testing.T) {
+	var aggregates int
+	clientOpts := options.Client().SetMonitor(&event.CommandMonitor{
+		Started: func(_ context.Context, evt *event.CommandStartedEvent) {
+			if evt.CommandName == "aggregate" {
+				aggregates++
+			}
+		},
+	})
+
+	mc := newMockClient(t, clientOpts)
+
+	ns := testDbName + ".coll"
+	pipeline := Pipeline{{{"$group", bson.D{{"_id", nil}, {"total", bson.D{{"$sum", "$x"}}}}}}}
+	reply := func(total int32) bson.D {
+		return bson.D{{"ok", 1}, {"cursor", bson.D{
+			{"id", int64(0)},
+			{"ns", ns},
+			{"firstBatch", bson.A{bson.D{{"total", total}}}},
+		}}}
+	}
+	total := func(t *testing.T, coll *Collection, opts *options.AggregateOptionsBuilder) int32 {
+		t.Helper()
+
+		cursor, err := coll.Aggregate(context.Background(), pipeline, opts)
+		require.NoError(t, err, "Aggregate error")
+
+		var res []struct{ Total int32 }
+		err = cursor.All(context.Background(), &res)
+		require.NoError(t, err, "All error")
+		require.Len(t, res, 1, "expected 1 result")
+		return res[0].Total
+	}
+
+	t.Run("cache miss then hit", func(t *testing.T) {
+		coll := mc.Database(testDbName).Collection("coll").WithAggregateCache(NewInMemoryAggregateCache(10))
+		opts := options.Aggregate().SetCacheKey("totals").SetCacheTTL(time.Minute)
+
+		mc.md.ClearResponses()
+		mc.md.AddResponses(reply(10))
+		aggregates = 0
+
+		assert.Equal(t, int32(10), total(t, coll, opts), "expected total from the server")
+		assert.Equal(t, int32(10), total(t, coll, opts), "expected cached total")
+		assert.Equal(t, 1, aggregates, "expected 1 aggregate command, got %d", aggregates)
+	})
+	t.Run("nil context", func(t *testing.T) {
+		coll := mc.Database(testDbName).Collection("coll").WithAggregateCache(NewInMemoryAggregateCache(10))
+		opts := options.Aggregate().SetCacheKey("totals").SetCacheTTL(time.Minute)
+
+		mc.md.ClearResponses()
+		mc.md.AddResponses(reply(10))
+		aggregates = 0
+
+		var nilCtx context.Context
+		for i := 0; i < 2; i++ {
+			cursor, err := coll.Aggregate(nilCtx, pipeline, opts)
+			require.NoError(t, err, "Aggregate error")
+
+			var res []struct{ Total int32 }
+			err = cursor.All(context.Background(), &res)
+			require.NoError(t, err, "All error")
+			assert.Equal(t, []struct{ Total int32 }{{10}}, res, "expected total from the server or the cache")
+		}
+		assert.Equal(t, 1, aggregates, "expected 1 aggregate command, got %d", aggregates)
+	})
+	t.Run("TTL expiry", func(t *testing.T) {
+		now := time.Now()
+		cache := NewInMemoryAggregateCache(10).(*inMemoryAggregateCache)
+		cache.now = func() time.Time { return now }
+		coll := mc.Database(testDbName).Collection("coll").WithAggregateCache(cache)
+		opts := options.Aggregate().SetCacheKey("totals").SetCacheTTL(time.Minute)
+
+		mc.md.ClearResponses()
+		mc.md.AddResponses(reply(10), reply(20))
+		aggregates = 0
+
+		assert.Equal(t, int32(10), total(t, coll, opts), "expected total from the server")
+		now = now.Add(time.Minute)
+		assert.Equal(t, int32(20), total(t, coll, opts), "expected new total after the TTL expires")
+		assert.Equal(t, 2, aggregates, "expected 2 aggregate commands, got %d", aggregates)
+	})
+	t.Run("ForceRefresh", func(t *testing.T) {
+		coll := mc.Database(testDbName).Collection("coll").WithAggregateCache(NewInMemoryAggregateCache(10))
+		opts := options.Aggregate().SetCacheKey("totals").SetCacheTTL(time.Minute)
+
+		mc.md.ClearResponses()
+		mc.md.AddResponses(reply(10), reply(20))
+		aggregates = 0
+
+		assert.Equal(t, int32(10), total(t, coll, opts), "expected total from the server")
+		assert.Equal(t, int32(20), total(t, coll, options.Aggregate().
+			SetCacheKey("totals").
+			SetCacheTTL(time.Minute).
+			SetForceRefresh(true)), "expected refreshed total")
+		assert.Equal(t, int32(20), total(t, coll, opts), "expected refreshed total to be cached")
+		assert.Equal(t, 2, aggregates, "expected 2 aggregate commands, got %d", aggregates)
+	})
+	t.Run("not cached without All", func(t *testing.T) {
+		coll := mc.Database(testDbName).Collection("coll").WithAggregateCache(NewInMemoryAggregateCache(10))
+		opts := options.Aggregate().SetCacheKey("totals").SetCacheTTL(time.Minute)
+
+		mc.md.ClearResponses()
+		mc.md.AddResponses(reply(10), reply(20))
+		aggregates = 0
+
+		cursor, err := coll.Aggregate(context.Background(), pipeline, opts)
+		require.NoError(t, err, "Aggregate error")
+		require.True(t, cursor.Next(context.Background()), "Next error: %v", cursor.Err())
+		require.NoError(t, cursor.Close(context.Background()), "Close error")
+
+		assert.Equal(t, int32(20), total(t, coll, opts), "expected total from the server")
+		assert.Equal(t, 2, aggregates, "expected 2 aggregate commands, got %d", aggregates)
+	})
+	t.Run("not cached after Next", func(t *testing.T) {
+		coll := mc.Database(testDbName).Collection("coll").WithAggregateCache(NewInMemoryAggregateCache(10))
+		opts := options.Aggregate().SetCacheKey("totals").SetCacheTTL(time.Minute)
+
+		mc.md.ClearResponses()
+		mc.md.AddResponses(bson.D{{"ok", 1}, {"cursor", bson.D{
+			{"id", int64(0)},
+			{"ns", ns},
+			{"firstBatch", bson.A{bson.D{{"total", 1}}, bson.D{{"total", 2}}}},
+		}}}, reply(20))
+		aggregates = 0
+
+		cursor, err := coll.Aggregate(context.Background(), pipeline, opts)
+		require.NoError(t, err, "Aggregate error")
+		require.True(t, cursor.Next(context.Background()), "Next error: %v", cursor.Err())
+
+		// The results of All after Next may be partial, so they must not be cached as the complete results.
+		var rest []bson.Raw
+		require.NoError(t, cursor.All(context.Background(), &rest), "All error")
+
+		assert.Equal(t, int32(20), total(t, coll, opts), "expected total from the server")
+		assert.Equal(t, 2, aggregates, "expected 2 aggregate commands, got %d", aggregates)
+	})
+	t.Run("bypassed with a session", func(t *testing.T) {
+		coll := mc.Database(testDbName).Collection("coll").WithAggregateCache(NewInMemoryAggregateCache(10))
+		opts := options.Aggregate().SetCacheKey("totals").SetCacheTTL(time.Minute)
+
+		sess, err := mc.StartSession()
+		require.NoError(t, err, "StartSession error")
+		defer sess.EndSession(context.Background())
+		sessCtx := NewSessionContext(context.Background(), sess)
+
+		mc.md.ClearResponses()
+		mc.md.AddResponses(reply(10), reply(20))
+		aggregates = 0
+
+		assert.Equal(t, int32(10), total(t, coll, opts), "expected total from the server")
+
+		cursor, err := coll.Aggregate(sessCtx, pipeline, opts)
+		require.NoError(t, err, "Aggregate error")
+		var res []struct{ Total int32 }
+		require.NoError(t, cursor.All(sessCtx, &res), "All error")
+		require.Len(t, res, 1, "expected 1 result")
+		assert.Equal(t, int32(20), res[0].Total, "expected total from the server in the session")
+
+		assert.Equal(t, int32(10), total(t, coll, opts), "expected the session results not to be cached")
+		assert.Equal(t, 2, aggregates, "expected 2 aggregate commands, got %d", aggregates)
+	})
+	t.Run("options not set", func(t *testing.T) {
+		coll := mc.Database(testDbName).Collection("coll").WithAggregateCache(NewInMemoryAggregateCache(10))
+
+		mc.md.ClearResponses()
+		mc.md.AddResponses(reply(10), reply(20))
+		aggregates = 0
+
+		assert.Equal(t, int32(10), total(t, coll, options.Aggregate().SetCacheKey("totals")), "expected total from the server")
+		assert.Equal(t, int32(20), total(t, coll, options.Aggregate().SetCacheKey("totals")), "expected total from the server")
+		assert.Equal(t, 2, aggregates, "expected 2 aggregate commands, got %d", aggregates)
+	})
+}
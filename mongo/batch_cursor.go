@@ -51,6 +51,10 @@ type batchCursor interface {
 	// SetComment will set a user-configurable comment that can be used to
 	// identify the operation in server logs.
 	SetComment(interface{})
+
+	// LastResponse returns the server response for the most recent command
+	// run by the cursor, or nil if responses are not retained.
+	LastResponse() bsoncore.Document
 }
 
 // changeStreamCursor is the interface implemented by batch cursors that also provide the functionality for retrieving
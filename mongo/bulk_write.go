@@ -50,6 +50,7 @@ func (bw *bulkWrite) execute(ctx context.Context) error {
 	batches := createBatches(bw.models, ordered)
 	bw.result = BulkWriteResult{
 		UpsertedIDs: make(map[int64]interface{}),
+		InsertedIDs: make(map[int64]interface{}),
 	}
 
 	bwErr := BulkWriteException{
@@ -105,13 +106,14 @@ func (bw *bulkWrite) execute(ctx context.Context) error {
 func (bw *bulkWrite) runBatch(ctx context.Context, batch bulkWriteBatch) (BulkWriteResult, BulkWriteException, error) {
 	batchRes := BulkWriteResult{
 		UpsertedIDs: make(map[int64]interface{}),
+		InsertedIDs: make(map[int64]interface{}),
 	}
 	batchErr := BulkWriteException{}
 
 	var writeErrors []driver.WriteError
 	switch batch.models[0].(type) {
 	case *InsertOneModel:
-		res, err := bw.runInsert(ctx, batch)
+		res, ids, err := bw.runInsert(ctx, batch)
 		if err != nil {
 			var writeErr driver.WriteCommandError
 			if !errors.As(err, &writeErr) {
@@ -122,6 +124,23 @@ func (bw *bulkWrite) runBatch(ctx context.Context, batch bulkWriteBatch) (BulkWr
 			batchErr.WriteConcernError = convertDriverWriteConcernError(writeErr.WriteConcernError)
 		}
 		batchRes.InsertedCount = res.N
+
+		// Only record the IDs of documents that were inserted. If the bulk write is ordered, nothing after the
+		// first write error was inserted.
+		failed := make(map[int64]bool, len(writeErrors))
+		for _, we := range writeErrors {
+			failed[we.Index] = true
+		}
+		ordered := bw.ordered == nil || *bw.ordered
+		for i, id := range ids {
+			if failed[int64(i)] {
+				if ordered {
+					break
+				}
+				continue
+			}
+			batchRes.InsertedIDs[int64(batch.indexes[i])] = id
+		}
 	case *DeleteOneModel, *DeleteManyModel:
 		res, err := bw.runDelete(ctx, batch)
 		if err != nil {
@@ -166,20 +185,25 @@ func (bw *bulkWrite) runBatch(ctx context.Context, batch bulkWriteBatch) (BulkWr
 	return batchRes, batchErr, nil
 }
 
-func (bw *bulkWrite) runInsert(ctx context.Context, batch bulkWriteBatch) (operation.InsertResult, error) {
+func (bw *bulkWrite) runInsert(
+	ctx context.Context,
+	batch bulkWriteBatch,
+) (operation.InsertResult, []interface{}, error) {
 	docs := make([]bsoncore.Document, len(batch.models))
+	ids := make([]interface{}, len(batch.models))
 	for i, model := range batch.models {
 		converted := model.(*InsertOneModel)
 		doc, err := marshal(converted.Document, bw.collection.bsonOpts, bw.collection.registry)
 		if err != nil {
-			return operation.InsertResult{}, err
+			return operation.InsertResult{}, nil, err
 		}
-		doc, _, err = ensureID(doc, bson.NilObjectID, bw.collection.bsonOpts, bw.collection.registry)
+		doc, id, err := ensureID(doc, bson.NilObjectID, bw.collection.client.idGenerator, bw.collection.bsonOpts, bw.collection.registry)
 		if err != nil {
-			return operation.InsertResult{}, err
+			return operation.InsertResult{}, nil, err
 		}
 
 		docs[i] = doc
+		ids[i] = id
 	}
 
 	op := operation.NewInsert(docs...).
@@ -192,7 +216,7 @@ func (bw *bulkWrite) runInsert(ctx context.Context, batch bulkWriteBatch) (opera
 	if bw.comment != nil {
 		comment, err := marshalValue(bw.comment, bw.collection.bsonOpts, bw.collection.registry)
 		if err != nil {
-			return op.Result(), err
+			return op.Result(), nil, err
 		}
 		op.Comment(comment)
 	}
@@ -211,7 +235,7 @@ func (bw *bulkWrite) runInsert(ctx context.Context, batch bulkWriteBatch) (opera
 
 	err := op.Execute(ctx)
 
-	return op.Result(), err
+	return op.Result(), ids, err
 }
 
 func (bw *bulkWrite) runDelete(ctx context.Context, batch bulkWriteBatch) (operation.DeleteResult, error) {
@@ -430,6 +454,9 @@ type updateDoc struct {
 	upsert         *bool
 	multi          bool
 	checkDollarKey bool
+	// skipDollarKeyCheck disables the check that an update document starts with an update operator. It only
+	// applies if checkDollarKey is true.
+	skipDollarKeyCheck bool
 }
 
 func (doc updateDoc) marshal(bsonOpts *options.BSONOptions, registry *bson.Registry) (bsoncore.Document, error) {
@@ -444,7 +471,12 @@ func (doc updateDoc) marshal(bsonOpts *options.BSONOptions, registry *bson.Regis
 	uidx, updateDoc := bsoncore.AppendDocumentStart(nil)
 	updateDoc = bsoncore.AppendDocumentElement(updateDoc, "q", f)
 
-	u, err := marshalUpdateValue(doc.update, bsonOpts, registry, doc.checkDollarKey)
+	var u bsoncore.Value
+	if doc.checkDollarKey && doc.skipDollarKeyCheck {
+		u, err = marshalUpdateValueWithChecker(doc.update, bsonOpts, registry, true, skipDocumentCheck)
+	} else {
+		u, err = marshalUpdateValue(doc.update, bsonOpts, registry, doc.checkDollarKey)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -594,6 +626,9 @@ func (bw *bulkWrite) mergeResults(newResult BulkWriteResult) {
 	for index, upsertID := range newResult.UpsertedIDs {
 		bw.result.UpsertedIDs[index] = upsertID
 	}
+	for index, insertedID := range newResult.InsertedIDs {
+		bw.result.InsertedIDs[index] = insertedID
+	}
 }
 
 // WriteCommandKind is the type of command represented by a Write
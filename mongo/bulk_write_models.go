@@ -33,7 +33,7 @@ func NewInsertOneModel() *InsertOneModel {
 
 // SetDocument specifies the document to be inserted. The document cannot be nil. If it does not have an _id field when
 // transformed into BSON, one will be added automatically to the marshalled document. The original document will not be
-// modified.
+// modified. The _id of the inserted document can be retrieved from the InsertedIDs field of the BulkWriteResult.
 func (iom *InsertOneModel) SetDocument(doc interface{}) *InsertOneModel {
 	iom.Document = doc
 	return iom
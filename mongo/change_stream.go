@@ -89,6 +89,13 @@ type ChangeStream struct {
 	selector        description.ServerSelector
 	operationTime   *bson.Timestamp
 	wireVersion     *description.VersionRange
+	concernSources  driverutil.ConcernSources
+
+	checkpointStore options.CheckpointStore
+	// checkpointPending is true if the resume token of the last event returned by Next or TryNext has not been saved
+	// to checkpointStore yet.
+	checkpointPending bool
+	checkpointSaver   *checkpointSaver // nil unless resume tokens are saved asynchronously
 }
 
 type changeStreamConfig struct {
@@ -101,6 +108,7 @@ type changeStreamConfig struct {
 	collectionName string
 	databaseName   string
 	crypt          driver.Crypt
+	concernSources driverutil.ConcernSources
 }
 
 // validChangeStreamTimeouts will return "false" if maxAwaitTimeMS is set,
@@ -146,11 +154,12 @@ func newChangeStream(ctx context.Context, config changeStreamConfig, pipeline in
 	}
 
 	cs := &ChangeStream{
-		client:     config.client,
-		bsonOpts:   config.bsonOpts,
-		registry:   config.registry,
-		streamType: config.streamType,
-		options:    args,
+		client:         config.client,
+		bsonOpts:       config.bsonOpts,
+		registry:       config.registry,
+		streamType:     config.streamType,
+		options:        args,
+		concernSources: config.concernSources,
 		selector: &serverselector.Composite{
 			Selectors: []description.ServerSelector{
 				&serverselector.ReadPref{ReadPref: config.readPreference},
@@ -161,7 +170,7 @@ func newChangeStream(ctx context.Context, config changeStreamConfig, pipeline in
 	}
 
 	cs.sess = sessionFromContext(ctx)
-	if cs.sess == nil && cs.client.sessionPool != nil {
+	if cs.sess == nil && cs.client.sessionPool != nil && !implicitSessionDisabled(ctx) {
 		cs.sess = session.NewImplicitClientSession(cs.client.sessionPool, cs.client.id)
 	}
 	if cs.err = cs.client.validSession(cs.sess); cs.err != nil {
@@ -174,7 +183,7 @@ func newChangeStream(ctx context.Context, config changeStreamConfig, pipeline in
 		Deployment(cs.client.deployment).ClusterClock(cs.client.clock).
 		CommandMonitor(cs.client.monitor).Session(cs.sess).ServerSelector(cs.selector).Retry(driver.RetryNone).
 		ServerAPI(cs.client.serverAPI).Crypt(config.crypt).Timeout(cs.client.timeout).
-		Authenticator(cs.client.authenticator)
+		Logger(cs.client.logger).Authenticator(cs.client.authenticator)
 
 	if cs.options.Collation != nil {
 		cs.aggregate.Collation(bsoncore.Document(toDocument(cs.options.Collation)))
@@ -237,6 +246,19 @@ func newChangeStream(ctx context.Context, config changeStreamConfig, pipeline in
 		return nil, fmt.Errorf("must supply a valid StreamType in config, instead of %v", cs.streamType)
 	}
 
+	// If a checkpoint store is configured and no starting point was given, resume after the saved token.
+	if cs.options.CheckpointStore != nil && cs.options.ResumeAfter == nil && cs.options.StartAfter == nil &&
+		cs.options.StartAtOperationTime == nil {
+		var token bson.Raw
+		if token, cs.err = cs.options.CheckpointStore.Load(ctx); cs.err != nil {
+			closeImplicitSession(cs.sess)
+			return nil, cs.Err()
+		}
+		if token != nil {
+			cs.options.ResumeAfter = token
+		}
+	}
+
 	// When starting a change stream, cache startAfter as the first resume token if it is set. If not, cache
 	// resumeAfter. If neither is set, do not cache a resume token.
 	resumeToken := cs.options.StartAfter
@@ -265,6 +287,11 @@ func newChangeStream(ctx context.Context, config changeStreamConfig, pipeline in
 		return nil, cs.Err()
 	}
 
+	cs.checkpointStore = cs.options.CheckpointStore
+	if cs.checkpointStore != nil && (cs.options.CheckpointSync == nil || !*cs.options.CheckpointSync) {
+		cs.checkpointSaver = newCheckpointSaver(cs.checkpointStore)
+	}
+
 	return cs, cs.Err()
 }
 
@@ -333,7 +360,7 @@ func (cs *ChangeStream) executeOperation(ctx context.Context, resuming bool) err
 	var err error
 AggregateExecuteLoop:
 	for {
-		err = cs.aggregate.Execute(ctx)
+		err = cs.aggregate.Execute(withConcernSources(ctx, cs.client.logger, cs.concernSources))
 		// If no error or no retries remain, do not retry.
 		if err == nil || retries == 0 {
 			break AggregateExecuteLoop
@@ -379,6 +406,10 @@ AggregateExecuteLoop:
 		cs.err = replaceErrors(err)
 		return cs.err
 	}
+	if cs.aggregate.DocumentResult() != nil {
+		cs.err = driver.ErrNoCursor
+		return cs.err
+	}
 
 	cr := cs.aggregate.ResultCursorResponse()
 	cr.Server = server
@@ -426,6 +457,12 @@ func (cs *ChangeStream) storeResumeToken() error {
 	}
 
 	cs.resumeToken = tokenDoc
+	if cs.options != nil && cs.options.ResumeTokenCallback != nil {
+		// Copy the token because it may reference memory owned by the current batch.
+		token := make(bson.Raw, len(tokenDoc))
+		copy(token, tokenDoc)
+		cs.options.ResumeTokenCallback(token)
+	}
 	return nil
 }
 
@@ -567,12 +604,91 @@ func (cs *ChangeStream) ID() int64 {
 	return cs.cursor.ID()
 }
 
+// LastResponse returns the raw server response for the most recent command run
+// by the change stream's cursor: the aggregate that opened or resumed the change
+// stream or the latest getMore. If a getMore failed with a server error, the
+// response containing the error is returned. It returns nil if the change stream
+// has been closed or the Client was not configured with
+// options.ClientOptions.SetRetainRawResponses(true).
+func (cs *ChangeStream) LastResponse() bson.Raw {
+	if cs.cursor == nil {
+		return nil
+	}
+	return bson.Raw(cs.cursor.LastResponse())
+}
+
 // RemainingBatchLength returns the number of documents left in the current batch. If this returns zero, the subsequent
 // call to Next or TryNext will do a network request to fetch the next batch.
 func (cs *ChangeStream) RemainingBatchLength() int {
 	return len(cs.batch)
 }
 
+// RawBatch returns the change events left in the current batch, i.e. the events that will be returned by subsequent
+// calls to Next or TryNext before another batch is fetched from the server. The length of the returned slice is equal
+// to RemainingBatchLength. RawBatch does not advance the change stream, so calling it does not affect Current, the
+// resume token, or subsequent calls to Next, TryNext, or Decode.
+//
+// The returned documents reference the change stream's internal buffer and are only valid until the next call to
+// Next, TryNext, or Close. Callers that need to retain the documents after that must copy them.
+func (cs *ChangeStream) RawBatch() []bson.Raw {
+	if len(cs.batch) == 0 {
+		return nil
+	}
+
+	docs := make([]bson.Raw, len(cs.batch))
+	for i, doc := range cs.batch {
+		docs[i] = bson.Raw(doc)
+	}
+	return docs
+}
+
+// BufferedCount returns the number of change events in the current local batch, i.e. the number of events that
+// AllBuffered would return. It is equivalent to RemainingBatchLength.
+func (cs *ChangeStream) BufferedCount() int {
+	return len(cs.batch)
+}
+
+// AllBuffered consumes and returns all change events left in the current local batch without contacting the server.
+// It is useful for processing events in groups: call Next or TryNext to wait for an event, then AllBuffered to take
+// the rest of the batch. If the batch is empty, AllBuffered returns an empty slice and the next call to Next or
+// TryNext fetches a new batch from the server.
+//
+// AllBuffered advances the change stream as if Next had been called once per returned event: Current is set to the
+// last returned event and the resume token is updated accordingly. The returned documents are copies and remain valid
+// after subsequent calls to Next, TryNext, or Close. If an error occurs, it is also returned by subsequent calls to
+// Err.
+func (cs *ChangeStream) AllBuffered(ctx context.Context) ([]bson.Raw, error) {
+	if cs.err != nil {
+		return nil, cs.Err()
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	if cs.err = cs.saveCheckpoint(ctx); cs.err != nil {
+		return nil, cs.Err()
+	}
+
+	events := make([]bson.Raw, 0, len(cs.batch))
+	for len(cs.batch) > 0 {
+		cs.Current = bson.Raw(cs.batch[0])
+		cs.batch = cs.batch[1:]
+		if cs.err = cs.storeResumeToken(); cs.err != nil {
+			return nil, cs.Err()
+		}
+
+		event := make(bson.Raw, len(cs.Current))
+		copy(event, cs.Current)
+		events = append(events, event)
+	}
+	if len(events) > 0 {
+		cs.checkpointPending = cs.checkpointStore != nil
+	}
+
+	return events, nil
+}
+
 // SetBatchSize sets the number of documents to fetch from the database with
 // each iteration of the ChangeStream's "Next" or "TryNext" method. This setting
 // only affects subsequent document batches fetched from the database.
@@ -608,6 +724,9 @@ func (cs *ChangeStream) Err() error {
 
 // Close closes this change stream and the underlying cursor. Next and TryNext must not be called after Close has been
 // called. Close is idempotent. After the first call, any subsequent calls will not change the state.
+//
+// If a CheckpointStore is configured, Close treats the last event returned by Next or TryNext as processed and saves
+// its resume token before returning. An error saving the token is returned if CheckpointSync is set.
 func (cs *ChangeStream) Close(ctx context.Context) error {
 	if ctx == nil {
 		ctx = context.Background()
@@ -615,12 +734,21 @@ func (cs *ChangeStream) Close(ctx context.Context) error {
 
 	defer closeImplicitSession(cs.sess)
 
+	checkpointErr := cs.saveCheckpoint(ctx)
+	if cs.checkpointSaver != nil {
+		cs.checkpointSaver.close()
+		cs.checkpointSaver = nil
+	}
+
 	if cs.cursor == nil {
-		return nil // cursor is already closed
+		return checkpointErr // cursor is already closed
 	}
 
 	cs.err = replaceErrors(cs.cursor.Close(ctx))
 	cs.cursor = nil
+	if cs.err == nil {
+		cs.err = checkpointErr
+	}
 	return cs.Err()
 }
 
@@ -675,6 +803,10 @@ func (cs *ChangeStream) next(ctx context.Context, nonBlocking bool) bool {
 		ctx = context.Background()
 	}
 
+	if cs.err = cs.saveCheckpoint(ctx); cs.err != nil {
+		return false
+	}
+
 	if len(cs.batch) == 0 {
 		cs.loopNext(ctx, nonBlocking)
 		if cs.err != nil {
@@ -692,6 +824,7 @@ func (cs *ChangeStream) next(ctx context.Context, nonBlocking bool) bool {
 	if cs.err = cs.storeResumeToken(); cs.err != nil {
 		return false
 	}
+	cs.checkpointPending = cs.checkpointStore != nil
 	return true
 }
 
@@ -0,0 +1,85 @@
+// Copyright (C) MongoDB, Inc. 2026-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// checkpointSaver saves change stream resume tokens to a CheckpointStore in a background goroutine. Only the most
+// recent token is kept if saves cannot keep up with the change stream.
+type checkpointSaver struct {
+	store  options.CheckpointStore
+	tokens chan bson.Raw
+	done   chan struct{}
+}
+
+func newCheckpointSaver(store options.CheckpointStore) *checkpointSaver {
+	cs := &checkpointSaver{
+		store:  store,
+		tokens: make(chan bson.Raw, 1),
+		done:   make(chan struct{}),
+	}
+	go cs.run()
+	return cs
+}
+
+func (cs *checkpointSaver) run() {
+	defer close(cs.done)
+
+	for token := range cs.tokens {
+		// Errors are ignored because there is no caller to report them to. Applications that need to handle save
+		// errors should use synchronous checkpoints.
+		_ = cs.store.Save(context.Background(), token)
+	}
+}
+
+// save queues token to be saved, replacing any token that has not been saved yet. save must not be called
+// concurrently or after close.
+func (cs *checkpointSaver) save(token bson.Raw) {
+	select {
+	case cs.tokens <- token:
+		return
+	default:
+	}
+
+	// A token is already waiting to be saved. Replace it with the newer one.
+	select {
+	case <-cs.tokens:
+	default:
+	}
+	cs.tokens <- token
+}
+
+// close stops accepting tokens and waits for the pending save, if any, to complete.
+func (cs *checkpointSaver) close() {
+	close(cs.tokens)
+	<-cs.done
+}
+
+// saveCheckpoint saves the resume token of the event that was last returned by Next or TryNext, if any. It is called
+// when the application asks for the next event or closes the change stream, both of which signal that the previous
+// event has been processed.
+func (cs *ChangeStream) saveCheckpoint(ctx context.Context) error {
+	if !cs.checkpointPending {
+		return nil
+	}
+	cs.checkpointPending = false
+
+	// Copy the token because it may reference memory owned by the current batch.
+	token := make(bson.Raw, len(cs.resumeToken))
+	copy(token, cs.resumeToken)
+
+	if cs.checkpointSaver != nil {
+		cs.checkpointSaver.save(token)
+		return nil
+	}
+	return cs.checkpointStore.Save(ctx, token)
+}
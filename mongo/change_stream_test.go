@@ -8,11 +8,17 @@ package mongo
 
 import (
 	"context"
+	"fmt"
+	"sync"
 	"testing"
 	"time"
 
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/event"
 	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
 )
 
 func TestChangeStream(t *testing.T) {
@@ -31,6 +37,23 @@ func TestChangeStream(t *testing.T) {
 	})
 }
 
+func TestChangeStream_RawBatch(t *testing.T) {
+	var batch []bsoncore.Document
+	for i := 0; i < 3; i++ {
+		batch = append(batch, bsoncore.NewDocumentBuilder().AppendInt32("x", int32(i)).Build())
+	}
+	cs := &ChangeStream{batch: batch}
+
+	raw := cs.RawBatch()
+	require.Len(t, raw, 3, "expected 3 documents in the batch")
+	assert.Equal(t, 3, cs.RemainingBatchLength(), "expected RawBatch not to consume documents")
+	for i, doc := range raw {
+		assert.Equal(t, bson.Raw(batch[i]), doc, "expected document %v, got %v", bson.Raw(batch[i]), doc)
+	}
+
+	assert.Nil(t, (&ChangeStream{}).RawBatch(), "expected empty batch")
+}
+
 func TestValidChangeStreamTimeouts(t *testing.T) {
 	t.Parallel()
 
@@ -123,3 +146,338 @@ func TestValidChangeStreamTimeouts(t *testing.T) {
 		})
 	}
 }
+
+type blockingCheckpointStore struct {
+	mu      sync.Mutex
+	saved   []bson.Raw
+	started chan struct{}
+	release chan struct{}
+}
+
+func (s *blockingCheckpointStore) Save(_ context.Context, token bson.Raw) error {
+	s.mu.Lock()
+	s.saved = append(s.saved, token)
+	s.mu.Unlock()
+
+	s.started <- struct{}{}
+	<-s.release
+	return nil
+}
+
+func (s *blockingCheckpointStore) Load(context.Context) (bson.Raw, error) {
+	return nil, nil
+}
+
+func TestCheckpointSaver(t *testing.T) {
+	store := &blockingCheckpointStore{
+		started: make(chan struct{}, 3),
+		release: make(chan struct{}),
+	}
+	saver := newCheckpointSaver(store)
+
+	tokens := make([]bson.Raw, 3)
+	for i := range tokens {
+		var err error
+		tokens[i], err = bson.Marshal(bson.D{{"_data", int32(i)}})
+		require.NoError(t, err, "Marshal error")
+	}
+
+	// Queue two more tokens while the first save is blocked. Only the newest queued token should be saved after the
+	// first one completes.
+	saver.save(tokens[0])
+	<-store.started
+	saver.save(tokens[1])
+	saver.save(tokens[2])
+	close(store.release)
+	saver.close()
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	assert.Equal(t, []bson.Raw{tokens[0], tokens[2]}, store.saved, "expected saved tokens to match")
+}
+
+type memoryCheckpointStore struct {
+	mu    sync.Mutex
+	token bson.Raw
+}
+
+func (s *memoryCheckpointStore) Save(_ context.Context, token bson.Raw) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.token = token
+	return nil
+}
+
+func (s *memoryCheckpointStore) Load(context.Context) (bson.Raw, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.token, nil
+}
+
+func TestChangeStream_CloseSavesCheckpoint(t *testing.T) {
+	for _, checkpointSync := range []bool{false, true} {
+		checkpointSync := checkpointSync
+
+		t.Run(fmt.Sprintf("sync %v", checkpointSync), func(t *testing.T) {
+			mc := newMockClient(t, nil)
+
+			coll := mc.Database(testDbName).Collection("coll")
+			token := bson.D{{"_data", "123"}}
+
+			mc.md.AddResponses(
+				bson.D{{"ok", 1}, {"cursor", bson.D{
+					{"id", int64(1)},
+					{"ns", testDbName + ".coll"},
+					{"firstBatch", bson.A{bson.D{{"_id", token}, {"operationType", "insert"}}}},
+				}}},
+				bson.D{{"ok", 1}},
+			)
+
+			store := &memoryCheckpointStore{}
+			csOpts := options.ChangeStream().SetCheckpointStore(store).SetCheckpointSync(checkpointSync)
+			cs, err := coll.Watch(context.Background(), Pipeline{}, csOpts)
+			require.NoError(t, err, "Watch error")
+
+			require.True(t, cs.Next(context.Background()), "expected Next to return true, got false; error: %v", cs.Err())
+			got, err := store.Load(context.Background())
+			require.NoError(t, err, "Load error")
+			assert.Nil(t, got, "expected no token to be saved before Close, got %v", got)
+
+			require.NoError(t, cs.Close(context.Background()), "Close error")
+
+			want, err := bson.Marshal(token)
+			require.NoError(t, err, "Marshal error")
+			got, err = store.Load(context.Background())
+			require.NoError(t, err, "Load error")
+			assert.Equal(t, bson.Raw(want), got, "expected saved token %v, got %v", bson.Raw(want), got)
+		})
+	}
+}
+
+func TestChangeStream_Collation(t *testing.T) {
+	mc := newMockClient(t, nil)
+
+	coll := mc.Database(testDbName).Collection("coll")
+	ns := testDbName + ".coll"
+	token := bson.D{{"_data", "123"}}
+
+	mc.md.AddResponses(
+		bson.D{{"ok", 1}, {"cursor", bson.D{
+			{"id", int64(1)},
+			{"ns", ns},
+			{"firstBatch", bson.A{}},
+			{"postBatchResumeToken", token},
+		}}},
+		bson.D{
+			{"ok", 0},
+			{"code", 6},
+			{"errmsg", "host unreachable"},
+			{"errorLabels", bson.A{"ResumableChangeStreamError"}},
+		},
+		bson.D{{"ok", 1}},
+		bson.D{{"ok", 1}, {"cursor", bson.D{
+			{"id", int64(0)},
+			{"ns", ns},
+			{"firstBatch", bson.A{bson.D{{"_id", token}, {"operationType", "insert"}}}},
+		}}},
+	)
+
+	collation := options.Collation{Locale: "en", Strength: 2}
+	pipeline := Pipeline{{{"$match", bson.D{{"fullDocument.name", "alice"}}}}}
+	cs, err := coll.Watch(context.Background(), pipeline, options.ChangeStream().SetCollation(collation))
+	require.NoError(t, err, "Watch error")
+
+	// The getMore fails with a resumable error, so the change stream resumes with a new aggregate.
+	require.True(t, cs.Next(context.Background()), "expected Next to return true, got false; error: %v", cs.Err())
+
+	var aggregates []*event.CommandStartedEvent
+	for _, evt := range mc.started {
+		if evt.CommandName == "aggregate" {
+			aggregates = append(aggregates, evt)
+		}
+	}
+	require.Len(t, aggregates, 2, "expected 2 aggregate commands, got %d", len(aggregates))
+
+	want := toDocument(&collation)
+	for i, evt := range aggregates {
+		val, err := evt.Command.LookupErr("collation")
+		require.NoError(t, err, "expected aggregate %d to have a collation", i)
+		got := bson.Raw(val.Document())
+		assert.Equal(t, want, got, "expected aggregate %d collation %v, got %v", i, want, got)
+	}
+}
+
+func TestChangeStream_GetMoreOptions(t *testing.T) {
+	mc := newMockClient(t, nil)
+
+	coll := mc.Database(testDbName).Collection("coll")
+	ns := testDbName + ".coll"
+	token := bson.D{{"_data", "123"}}
+	emptyBatch := func(batchField string) bson.D {
+		return bson.D{{"ok", 1}, {"cursor", bson.D{
+			{"id", int64(1)},
+			{"ns", ns},
+			{batchField, bson.A{}},
+			{"postBatchResumeToken", token},
+		}}}
+	}
+
+	mc.md.AddResponses(
+		emptyBatch("firstBatch"),
+		emptyBatch("nextBatch"),
+		bson.D{
+			{"ok", 0},
+			{"code", 6},
+			{"errmsg", "host unreachable"},
+			{"errorLabels", bson.A{"ResumableChangeStreamError"}},
+		},
+		bson.D{{"ok", 1}},
+		emptyBatch("firstBatch"),
+		bson.D{{"ok", 1}, {"cursor", bson.D{
+			{"id", int64(0)},
+			{"ns", ns},
+			{"nextBatch", bson.A{bson.D{{"_id", token}, {"operationType", "insert"}}}},
+		}}},
+	)
+
+	const batchSize = int32(500)
+	const maxAwaitTime = 2 * time.Second
+	opts := options.ChangeStream().SetBatchSize(batchSize).SetMaxAwaitTime(maxAwaitTime)
+	cs, err := coll.Watch(context.Background(), Pipeline{}, opts)
+	require.NoError(t, err, "Watch error")
+
+	// The second getMore fails with a resumable error, so the change stream resumes with a new aggregate and the
+	// options must also apply to the getMore commands of the new cursor.
+	require.True(t, cs.Next(context.Background()), "expected Next to return true, got false; error: %v", cs.Err())
+
+	var getMores []*event.CommandStartedEvent
+	for _, evt := range mc.started {
+		if evt.CommandName == "getMore" {
+			getMores = append(getMores, evt)
+		}
+	}
+	require.Len(t, getMores, 3, "expected 3 getMore commands, got %d", len(getMores))
+
+	for i, evt := range getMores {
+		gotBatchSize, ok := evt.Command.Lookup("batchSize").AsInt64OK()
+		assert.True(t, ok, "expected getMore %d to contain batchSize, got %v", i, evt.Command)
+		assert.Equal(t, int64(batchSize), gotBatchSize,
+			"expected getMore %d batchSize %v, got %v", i, batchSize, gotBatchSize)
+
+		gotMaxTimeMS, ok := evt.Command.Lookup("maxTimeMS").AsInt64OK()
+		assert.True(t, ok, "expected getMore %d to contain maxTimeMS, got %v", i, evt.Command)
+		assert.Equal(t, maxAwaitTime.Milliseconds(), gotMaxTimeMS,
+			"expected getMore %d maxTimeMS %v, got %v", i, maxAwaitTime.Milliseconds(), gotMaxTimeMS)
+	}
+}
+
+func TestChangeStream_ResumeTokenCallback(t *testing.T) {
+	mc := newMockClient(t, nil)
+
+	coll := mc.Database(testDbName).Collection("coll")
+	ns := testDbName + ".coll"
+	newEvent := func(data string) bson.D {
+		return bson.D{{"_id", bson.D{{"_data", data}}}, {"operationType", "insert"}}
+	}
+
+	mc.md.AddResponses(
+		bson.D{{"ok", 1}, {"cursor", bson.D{
+			{"id", int64(1)},
+			{"ns", ns},
+			{"firstBatch", bson.A{newEvent("01"), newEvent("02")}},
+		}}},
+		bson.D{{"ok", 1}, {"cursor", bson.D{
+			{"id", int64(0)},
+			{"ns", ns},
+			{"nextBatch", bson.A{newEvent("03")}},
+			{"postBatchResumeToken", bson.D{{"_data", "04"}}},
+		}}},
+	)
+
+	var tokens []bson.Raw
+	opts := options.ChangeStream().SetResumeTokenCallback(func(token bson.Raw) {
+		tokens = append(tokens, token)
+	})
+	cs, err := coll.Watch(context.Background(), Pipeline{}, opts)
+	require.NoError(t, err, "Watch error")
+
+	for i := 1; cs.Next(context.Background()); i++ {
+		require.Len(t, tokens, i, "expected callback to be called once per event")
+		last := tokens[len(tokens)-1]
+		assert.Equal(t, cs.ResumeToken(), last, "expected callback token %v to match ResumeToken %v", last, cs.ResumeToken())
+	}
+	require.NoError(t, cs.Err(), "change stream error")
+	require.Len(t, tokens, 3, "expected 3 resume tokens, got %d", len(tokens))
+
+	want := []string{"02", "04"}
+	for i := 1; i < len(tokens); i++ {
+		prev := tokens[i-1].Lookup("_data").StringValue()
+		cur := tokens[i].Lookup("_data").StringValue()
+		assert.Equal(t, want[i-1], cur, "expected token %d to be %q, got %q", i, want[i-1], cur)
+		assert.True(t, cur > prev, "expected resume tokens to advance, got %q after %q", cur, prev)
+	}
+}
+
+func TestChangeStream_AllBuffered(t *testing.T) {
+	mc := newMockClient(t, nil)
+
+	coll := mc.Database(testDbName).Collection("coll")
+	ns := testDbName + ".coll"
+	newEvent := func(i int32) bson.D {
+		return bson.D{{"_id", bson.D{{"_data", fmt.Sprintf("token%d", i)}}}, {"operationType", "insert"}, {"i", i}}
+	}
+
+	t.Run("drains the local batch", func(t *testing.T) {
+		mc.started = nil
+		mc.md.ClearResponses()
+		mc.md.AddResponses(bson.D{{"ok", 1}, {"cursor", bson.D{
+			{"id", int64(0)},
+			{"ns", ns},
+			{"firstBatch", bson.A{newEvent(1), newEvent(2), newEvent(3)}},
+		}}})
+
+		cs, err := coll.Watch(context.Background(), Pipeline{})
+		require.NoError(t, err, "Watch error")
+
+		require.True(t, cs.Next(context.Background()), "expected Next to return true, got false; error: %v", cs.Err())
+		assert.Equal(t, 2, cs.BufferedCount(), "expected BufferedCount 2, got %d", cs.BufferedCount())
+
+		events, err := cs.AllBuffered(context.Background())
+		require.NoError(t, err, "AllBuffered error")
+		require.Len(t, events, 2, "expected 2 events, got %d", len(events))
+		for idx, evt := range events {
+			got := evt.Lookup("i").Int32()
+			assert.Equal(t, int32(idx+2), got, "expected event %d to have i=%d, got %d", idx, idx+2, got)
+		}
+		assert.Equal(t, 0, cs.BufferedCount(), "expected BufferedCount 0, got %d", cs.BufferedCount())
+
+		wantToken, err := bson.Marshal(bson.D{{"_data", "token3"}})
+		require.NoError(t, err, "Marshal error")
+		assert.Equal(t, bson.Raw(wantToken), cs.ResumeToken(), "expected resume token %v, got %v", wantToken, cs.ResumeToken())
+
+		numStarted := len(mc.started)
+		events, err = cs.AllBuffered(context.Background())
+		require.NoError(t, err, "AllBuffered error")
+		assert.NotNil(t, events, "expected non-nil slice")
+		assert.Len(t, events, 0, "expected 0 events, got %d", len(events))
+		assert.Len(t, mc.started, numStarted, "expected no commands to be sent, got %d", len(mc.started)-numStarted)
+	})
+	t.Run("missing resume token", func(t *testing.T) {
+		mc.md.ClearResponses()
+		mc.md.AddResponses(bson.D{{"ok", 1}, {"cursor", bson.D{
+			{"id", int64(0)},
+			{"ns", ns},
+			{"firstBatch", bson.A{newEvent(1), bson.D{{"operationType", "insert"}}}},
+		}}})
+
+		cs, err := coll.Watch(context.Background(), Pipeline{})
+		require.NoError(t, err, "Watch error")
+		require.True(t, cs.Next(context.Background()), "expected Next to return true, got false; error: %v", cs.Err())
+
+		_, err = cs.AllBuffered(context.Background())
+		assert.ErrorIs(t, err, ErrMissingResumeToken, "expected error %v, got %v", ErrMissingResumeToken, err)
+		assert.ErrorIs(t, cs.Err(), ErrMissingResumeToken, "expected Err %v, got %v", ErrMissingResumeToken, cs.Err())
+	})
+}
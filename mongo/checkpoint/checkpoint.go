@@ -0,0 +1,148 @@
+// Copyright (C) MongoDB, Inc. 2026-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+// Package checkpoint provides stores for persisting change stream resume
+// tokens. A Store can be passed to ChangeStreamOptionsBuilder.SetCheckpointStore
+// so that a change stream continues from where it left off after the
+// application restarts.
+package checkpoint
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// Store persists change stream resume tokens.
+type Store interface {
+	// Save persists the given resume token, replacing any previously saved token.
+	Save(ctx context.Context, token bson.Raw) error
+
+	// Load returns the last saved resume token, or nil if no token has been saved.
+	Load(ctx context.Context) (bson.Raw, error)
+}
+
+var _ options.CheckpointStore = Store(nil)
+
+// fileContents is the JSON representation of a resume token saved by a FileStore.
+type fileContents struct {
+	ResumeToken json.RawMessage `json:"resumeToken"`
+}
+
+type fileStore struct {
+	path string
+}
+
+// FileStore returns a Store that saves the resume token as JSON in the file at
+// path. The token is stored as canonical Extended JSON so that its BSON types
+// are preserved. Each save replaces the file atomically.
+func FileStore(path string) Store {
+	return &fileStore{path: path}
+}
+
+// Save implements the Store interface.
+func (fs *fileStore) Save(_ context.Context, token bson.Raw) error {
+	ej, err := bson.MarshalExtJSON(token, true, false)
+	if err != nil {
+		return fmt.Errorf("error marshaling resume token: %w", err)
+	}
+	data, err := json.Marshal(fileContents{ResumeToken: ej})
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file in the same directory and rename it so that a crash during the write does not leave
+	// a partially written token behind.
+	tmp, err := os.CreateTemp(filepath.Dir(fs.path), filepath.Base(fs.path)+".tmp*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), fs.path)
+}
+
+// Load implements the Store interface. If the file does not exist, Load
+// returns a nil token and no error.
+func (fs *fileStore) Load(context.Context) (bson.Raw, error) {
+	data, err := os.ReadFile(fs.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var contents fileContents
+	if err := json.Unmarshal(data, &contents); err != nil {
+		return nil, fmt.Errorf("error parsing checkpoint file %q: %w", fs.path, err)
+	}
+	if len(contents.ResumeToken) == 0 || string(contents.ResumeToken) == "null" {
+		return nil, nil
+	}
+
+	var token bson.Raw
+	if err := bson.UnmarshalExtJSON(contents.ResumeToken, true, &token); err != nil {
+		return nil, fmt.Errorf("error parsing resume token in checkpoint file %q: %w", fs.path, err)
+	}
+	return token, nil
+}
+
+type collectionStore struct {
+	coll  *mongo.Collection
+	docID interface{}
+}
+
+// CollectionStore returns a Store that saves the resume token in the
+// "resumeToken" field of the document with the given _id in coll. The document
+// is created if it does not exist.
+func CollectionStore(coll *mongo.Collection, docID interface{}) Store {
+	return &collectionStore{coll: coll, docID: docID}
+}
+
+// Save implements the Store interface.
+func (cs *collectionStore) Save(ctx context.Context, token bson.Raw) error {
+	filter := bson.D{{"_id", cs.docID}}
+	replacement := bson.D{{"_id", cs.docID}, {"resumeToken", token}}
+	_, err := cs.coll.ReplaceOne(ctx, filter, replacement, options.Replace().SetUpsert(true))
+	return err
+}
+
+// Load implements the Store interface. If the document does not exist, Load
+// returns a nil token and no error.
+func (cs *collectionStore) Load(ctx context.Context) (bson.Raw, error) {
+	doc, err := cs.coll.FindOne(ctx, bson.D{{"_id", cs.docID}}).Raw()
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	val, err := doc.LookupErr("resumeToken")
+	if err != nil {
+		return nil, nil
+	}
+	token, ok := val.DocumentOK()
+	if !ok {
+		return nil, fmt.Errorf("expected resumeToken to be a document, got BSON type %v", val.Type)
+	}
+	return token, nil
+}
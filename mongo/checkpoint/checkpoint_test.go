@@ -0,0 +1,67 @@
+// Copyright (C) MongoDB, Inc. 2026-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package checkpoint
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+)
+
+func TestFileStore(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing file", func(t *testing.T) {
+		t.Parallel()
+
+		store := FileStore(filepath.Join(t.TempDir(), "checkpoint.json"))
+
+		token, err := store.Load(context.Background())
+		require.NoError(t, err, "Load error")
+		assert.Nil(t, token, "expected nil token, got %v", token)
+	})
+	t.Run("save and load", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "checkpoint.json")
+		store := FileStore(path)
+
+		first, err := bson.Marshal(bson.D{{"_data", "first"}})
+		require.NoError(t, err, "Marshal error")
+		second, err := bson.Marshal(bson.D{{"_data", "second"}, {"n", int64(2)}})
+		require.NoError(t, err, "Marshal error")
+
+		err = store.Save(context.Background(), first)
+		require.NoError(t, err, "Save error")
+		err = store.Save(context.Background(), second)
+		require.NoError(t, err, "Save error")
+
+		// A new store for the same path should load the last saved token with its BSON types preserved.
+		token, err := FileStore(path).Load(context.Background())
+		require.NoError(t, err, "Load error")
+		assert.Equal(t, bson.Raw(second), token, "expected token %v, got %v", bson.Raw(second), token)
+
+		entries, err := os.ReadDir(filepath.Dir(path))
+		require.NoError(t, err, "ReadDir error")
+		assert.Equal(t, 1, len(entries), "expected temporary files to be removed, got %v", entries)
+	})
+	t.Run("invalid file", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "checkpoint.json")
+		err := os.WriteFile(path, []byte("not json"), 0o600)
+		require.NoError(t, err, "WriteFile error")
+
+		_, err = FileStore(path).Load(context.Background())
+		assert.NotNil(t, err, "expected Load error, got nil")
+	})
+}
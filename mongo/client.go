@@ -11,10 +11,14 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/event"
+	"go.mongodb.org/mongo-driver/v2/internal/driverutil"
 	"go.mongodb.org/mongo-driver/v2/internal/httputil"
 	"go.mongodb.org/mongo-driver/v2/internal/logger"
 	"go.mongodb.org/mongo-driver/v2/internal/mongoutil"
@@ -28,6 +32,7 @@ import (
 	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/auth"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/connstring"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/description"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/mongocrypt"
 	mcopts "go.mongodb.org/mongo-driver/v2/x/mongo/driver/mongocrypt/options"
@@ -64,8 +69,10 @@ type Client struct {
 	readPreference *readpref.ReadPref
 	readConcern    *readconcern.ReadConcern
 	writeConcern   *writeconcern.WriteConcern
+	defaultTxnOpts *options.TransactionOptions
 	bsonOpts       *options.BSONOptions
 	registry       *bson.Registry
+	idGenerator    func() interface{}
 	monitor        *event.CommandMonitor
 	serverAPI      *driver.ServerAPIOptions
 	serverMonitor  *event.ServerMonitor
@@ -73,6 +80,18 @@ type Client struct {
 	timeout        *time.Duration
 	httpClient     *http.Client
 	logger         *logger.Logger
+	cursorReaper   *cursorReaper
+
+	// concernSources records where readConcern and writeConcern were configured.
+	concernSources driverutil.ConcernSources
+
+	// retainRawResponses specifies whether cursors and single results keep the
+	// raw server response for the most recent command.
+	retainRawResponses bool
+
+	// connectionString is the redacted URI applied to the ClientOptions, or an
+	// empty string if ApplyURI was not called.
+	connectionString string
 
 	// in-use encryption fields
 	isAutoEncryptionSet bool
@@ -84,6 +103,10 @@ type Client struct {
 	internalClientFLE   *Client
 	encryptedFieldsMap  map[string]interface{}
 	authenticator       driver.Authenticator
+
+	// cryptSharedLibVersion is the version string of the crypt_shared library loaded for automatic
+	// encryption, or an empty string if it was not loaded.
+	cryptSharedLibVersion string
 }
 
 // Connect creates a new Client and then initializes it using the Connect method.
@@ -141,6 +164,9 @@ func newClient(opts ...*options.ClientOptions) (*Client, error) {
 	}
 	client := &Client{id: id}
 
+	// ConnectionString
+	client.connectionString = connstring.Redact(clientOpts.GetURI())
+
 	// ClusterClock
 	client.clock = new(session.ClusterClock)
 
@@ -158,9 +184,14 @@ func newClient(opts ...*options.ClientOptions) (*Client, error) {
 		client.serverMonitor = clientOpts.ServerMonitor
 	}
 	// ReadConcern
+	client.concernSources = driverutil.ConcernSources{
+		ReadConcern:  driverutil.ConcernSourceDefault,
+		WriteConcern: driverutil.ConcernSourceDefault,
+	}
 	client.readConcern = &readconcern.ReadConcern{}
 	if clientOpts.ReadConcern != nil {
 		client.readConcern = clientOpts.ReadConcern
+		client.concernSources.ReadConcern = driverutil.ConcernSourceClient
 	}
 	// ReadPreference
 	client.readPreference = readpref.Primary()
@@ -176,6 +207,8 @@ func newClient(opts ...*options.ClientOptions) (*Client, error) {
 	if clientOpts.Registry != nil {
 		client.registry = clientOpts.Registry
 	}
+	// IDGenerator
+	client.idGenerator = clientOpts.IDGenerator
 	// RetryWrites
 	client.retryWrites = true // retry writes on by default
 	if clientOpts.RetryWrites != nil {
@@ -185,12 +218,24 @@ func newClient(opts ...*options.ClientOptions) (*Client, error) {
 	if clientOpts.RetryReads != nil {
 		client.retryReads = *clientOpts.RetryReads
 	}
+	// RetainRawResponses
+	if clientOpts.RetainRawResponses != nil {
+		client.retainRawResponses = *clientOpts.RetainRawResponses
+	}
 	// Timeout
 	client.timeout = clientOpts.Timeout
 	client.httpClient = clientOpts.HTTPClient
 	// WriteConcern
 	if clientOpts.WriteConcern != nil {
 		client.writeConcern = clientOpts.WriteConcern
+		client.concernSources.WriteConcern = driverutil.ConcernSourceClient
+	}
+	// DefaultTransactionOptions
+	if bldr := clientOpts.DefaultTransactionOptions; bldr != nil {
+		client.defaultTxnOpts, err = mongoutil.NewOptions[options.TransactionOptions](bldr)
+		if err != nil {
+			return nil, err
+		}
 	}
 	// AutoEncryptionOptions
 	if clientOpts.AutoEncryptionOptions != nil {
@@ -249,6 +294,8 @@ func newClient(opts ...*options.ClientOptions) (*Client, error) {
 		return nil, fmt.Errorf("invalid logger options: %w", err)
 	}
 
+	client.cursorReaper = newCursorReaper(client.logger)
+
 	return client, nil
 }
 
@@ -322,6 +369,7 @@ func (c *Client) Disconnect(ctx context.Context) error {
 		defer httputil.CloseIdleHTTPConnections(c.httpClient)
 	}
 
+	c.cursorReaper.stop(ctx)
 	c.endSessions(ctx)
 	if c.mongocryptdFLE != nil {
 		if err := c.mongocryptdFLE.disconnect(ctx); err != nil {
@@ -392,9 +440,31 @@ func (c *Client) Ping(ctx context.Context, rp *readpref.ReadPref) error {
 // StartSession is safe to call from multiple goroutines concurrently. However, Sessions returned by StartSession are
 // not safe for concurrent use by multiple goroutines.
 //
-// If the DefaultReadConcern, DefaultWriteConcern, or DefaultReadPreference options are not set, the client's read
-// concern, write concern, or read preference will be used, respectively.
+// If the DefaultReadConcern, DefaultWriteConcern, or DefaultReadPreference options are not set, the client's default
+// transaction options are used. If those are not set either, the client's read concern, write concern, or read
+// preference will be used, respectively.
+//
+// Deprecated: StartSession does not take a context, so checking the server session out of the session pool cannot
+// observe a deadline or cancellation. Use StartSessionWithContext instead, passing context.Background() to keep the
+// current behavior.
 func (c *Client) StartSession(opts ...options.Lister[options.SessionOptions]) (*Session, error) {
+	return c.StartSessionWithContext(context.Background(), opts...)
+}
+
+// StartSessionWithContext starts a new session configured with the given options. The provided context is used to check
+// out the server session from the client's session pool: if it is cancelled or its deadline has been exceeded before
+// the checkout, no session is started and the context's error is returned.
+//
+// Like StartSession, StartSessionWithContext does not communicate with the server, is safe to call from multiple
+// goroutines concurrently, and applies the same default transaction options.
+func (c *Client) StartSessionWithContext(
+	ctx context.Context,
+	opts ...options.Lister[options.SessionOptions],
+) (*Session, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
 	sessArgs, err := mongoutil.NewOptions(opts...)
 	if err != nil {
 		return nil, err
@@ -410,12 +480,24 @@ func (c *Client) StartSession(opts ...options.Lister[options.SessionOptions]) (*
 	if sessArgs.CausalConsistency != nil {
 		coreOpts.CausalConsistency = sessArgs.CausalConsistency
 	}
+
+	// Apply the Client's default transaction options first so that the session's default transaction
+	// options take precedence over them.
+	var maxCommitRetryTime *time.Duration
+	txnDefaults := []*options.TransactionOptions{c.defaultTxnOpts}
 	if bldr := sessArgs.DefaultTransactionOptions; bldr != nil {
 		txnOpts, err := mongoutil.NewOptions[options.TransactionOptions](bldr)
 		if err != nil {
 			return nil, err
 		}
 
+		txnDefaults = append(txnDefaults, txnOpts)
+	}
+	for _, txnOpts := range txnDefaults {
+		if txnOpts == nil {
+			continue
+		}
+
 		if rc := txnOpts.ReadConcern; rc != nil {
 			coreOpts.DefaultReadConcern = rc
 		}
@@ -427,20 +509,25 @@ func (c *Client) StartSession(opts ...options.Lister[options.SessionOptions]) (*
 		if rp := txnOpts.ReadPreference; rp != nil {
 			coreOpts.DefaultReadPreference = rp
 		}
+
+		if d := txnOpts.MaxCommitRetryTime; d != nil {
+			maxCommitRetryTime = d
+		}
 	}
 	if sessArgs.Snapshot != nil {
 		coreOpts.Snapshot = sessArgs.Snapshot
 	}
 
-	sess, err := session.NewClientSession(c.sessionPool, c.id, coreOpts)
+	sess, err := session.NewClientSessionWithContext(ctx, c.sessionPool, c.id, coreOpts)
 	if err != nil {
 		return nil, replaceErrors(err)
 	}
 
 	return &Session{
-		clientSession: sess,
-		client:        c,
-		deployment:    c.deployment,
+		clientSession:      sess,
+		client:             c,
+		deployment:         c.deployment,
+		maxCommitRetryTime: maxCommitRetryTime,
 	}, nil
 }
 
@@ -484,7 +571,8 @@ func (c *Client) configureAutoEncryption(args *options.ClientOptions) error {
 	}
 
 	// If the crypt_shared library was not loaded, try to spawn and connect to mongocryptd.
-	if mc.CryptSharedLibVersionString() == "" {
+	c.cryptSharedLibVersion = mc.CryptSharedLibVersionString()
+	if c.cryptSharedLibVersion == "" {
 		mongocryptdFLE, err := newMongocryptdClient(args.AutoEncryptionOptions)
 		if err != nil {
 			return err
@@ -579,8 +667,8 @@ func (c *Client) newMongoCrypt(opts *options.AutoEncryptionOptions) (*mongocrypt
 		return nil, fmt.Errorf("error creating KMS providers document: %w", err)
 	}
 
-	// Set the crypt_shared library override path from the "cryptSharedLibPath" extra option if one
-	// was set.
+	// Set the crypt_shared library override path from the CryptSharedLibPath option or the
+	// "cryptSharedLibPath" extra option if one was set.
 	cryptSharedLibPath := ""
 	if val, ok := opts.ExtraOptions["cryptSharedLibPath"]; ok {
 		str, ok := val.(string)
@@ -590,6 +678,9 @@ func (c *Client) newMongoCrypt(opts *options.AutoEncryptionOptions) (*mongocrypt
 		}
 		cryptSharedLibPath = str
 	}
+	if opts.CryptSharedLibPath != nil {
+		cryptSharedLibPath = *opts.CryptSharedLibPath
+	}
 
 	// Explicitly disable loading the crypt_shared library if requested. Note that this is ONLY
 	// intended for use from tests; there is no supported public API for explicitly disabling
@@ -602,6 +693,36 @@ func (c *Client) newMongoCrypt(opts *options.AutoEncryptionOptions) (*mongocrypt
 	bypassAutoEncryption := opts.BypassAutoEncryption != nil && *opts.BypassAutoEncryption
 	bypassQueryAnalysis := opts.BypassQueryAnalysis != nil && *opts.BypassQueryAnalysis
 
+	var cryptSharedLibRequired bool
+	if val, ok := opts.ExtraOptions["cryptSharedLibRequired"]; ok {
+		b, ok := val.(bool)
+		if !ok {
+			return nil, fmt.Errorf(
+				`expected AutoEncryption extra option "cryptSharedLibRequired" to be a bool, but is a %T`, val)
+		}
+		cryptSharedLibRequired = b
+	}
+	if opts.CryptSharedLibRequired != nil {
+		cryptSharedLibRequired = *opts.CryptSharedLibRequired
+	}
+
+	// The crypt_shared library is never loaded if automatic encryption is bypassed, so requiring it
+	// would always fail. Return a clear error instead of reporting that the library failed to load.
+	if cryptSharedLibRequired && bypassAutoEncryption {
+		return nil, errors.New(
+			"AutoEncryption options CryptSharedLibRequired and BypassAutoEncryption cannot both be true")
+	}
+
+	// If loading the crypt_shared library is required from a specific file, report a missing file before creating
+	// the MongoCrypt. Paths that start with "$ORIGIN" are resolved by libmongocrypt and are not checked here.
+	if cryptSharedLibRequired && cryptSharedLibPath != "" && !strings.HasPrefix(cryptSharedLibPath, "$ORIGIN") {
+		if _, err := os.Stat(cryptSharedLibPath); err != nil {
+			return nil, fmt.Errorf(
+				"AutoEncryption option CryptSharedLibRequired is true, but we failed to load the crypt_shared library from %q: %w",
+				cryptSharedLibPath, err)
+		}
+	}
+
 	mc, err := mongocrypt.NewMongoCrypt(mcopts.MongoCrypt().
 		SetKmsProviders(kmsProviders).
 		SetLocalSchemaMap(cryptSchemaMap).
@@ -615,22 +736,17 @@ func (c *Client) newMongoCrypt(opts *options.AutoEncryptionOptions) (*mongocrypt
 		return nil, err
 	}
 
-	var cryptSharedLibRequired bool
-	if val, ok := opts.ExtraOptions["cryptSharedLibRequired"]; ok {
-		b, ok := val.(bool)
-		if !ok {
-			return nil, fmt.Errorf(
-				`expected AutoEncryption extra option "cryptSharedLibRequired" to be a bool, but is a %T`, val)
-		}
-		cryptSharedLibRequired = b
-	}
-
-	// If the "cryptSharedLibRequired" extra option is set to true, check the MongoCrypt version
-	// string to confirm that the library was successfully loaded. If the version string is empty,
-	// return an error indicating that we couldn't load the crypt_shared library.
+	// If loading the crypt_shared library is required, check the MongoCrypt version string to
+	// confirm that the library was successfully loaded. If the version string is empty, return an
+	// error indicating that we couldn't load the crypt_shared library.
 	if cryptSharedLibRequired && mc.CryptSharedLibVersionString() == "" {
-		return nil, errors.New(
-			`AutoEncryption extra option "cryptSharedLibRequired" is true, but we failed to load the crypt_shared library`)
+		path := "the default system library search path"
+		if cryptSharedLibPath != "" {
+			path = strconv.Quote(cryptSharedLibPath)
+		}
+		return nil, fmt.Errorf(
+			"AutoEncryption option CryptSharedLibRequired is true, but we failed to load the crypt_shared library from %s",
+			path)
 	}
 
 	return mc, nil
@@ -666,6 +782,12 @@ func (c *Client) validSession(sess *session.Client) error {
 	return nil
 }
 
+// withConcernSources returns ctx annotated with where the Client's read and write concerns were configured. See the
+// package-level withConcernSources.
+func (c *Client) withConcernSources(ctx context.Context) context.Context {
+	return withConcernSources(ctx, c.logger, c.concernSources)
+}
+
 // Database returns a handle for a database with the given name configured with the given DatabaseOptions.
 func (c *Client) Database(name string, opts ...options.Lister[options.DatabaseOptions]) *Database {
 	return newDatabase(c, name, opts...)
@@ -691,7 +813,7 @@ func (c *Client) ListDatabases(ctx context.Context, filter interface{}, opts ...
 	if err != nil {
 		return ListDatabasesResult{}, err
 	}
-	if sess == nil && c.sessionPool != nil {
+	if sess == nil && c.sessionPool != nil && !implicitSessionDisabled(ctx) {
 		sess = session.NewImplicitClientSession(c.sessionPool, c.id)
 		defer sess.EndSession()
 	}
@@ -724,7 +846,7 @@ func (c *Client) ListDatabases(ctx context.Context, filter interface{}, opts ...
 	op := operation.NewListDatabases(filterDoc).
 		Session(sess).ReadPreference(c.readPreference).CommandMonitor(c.monitor).
 		ServerSelector(selector).ClusterClock(c.clock).Database("admin").Deployment(c.deployment).Crypt(c.cryptFLE).
-		ServerAPI(c.serverAPI).Timeout(c.timeout).Authenticator(c.authenticator)
+		ServerAPI(c.serverAPI).Timeout(c.timeout).Logger(c.logger).Authenticator(c.authenticator)
 
 	if lda.NameOnly != nil {
 		op = op.NameOnly(*lda.NameOnly)
@@ -739,7 +861,7 @@ func (c *Client) ListDatabases(ctx context.Context, filter interface{}, opts ...
 	}
 	op.Retry(retry)
 
-	err = op.Execute(ctx)
+	err = op.Execute(c.withConcernSources(ctx))
 	if err != nil {
 		return ListDatabasesResult{}, replaceErrors(err)
 	}
@@ -819,7 +941,7 @@ func (c *Client) UseSessionWithOptions(
 	opts *options.SessionOptionsBuilder,
 	fn func(context.Context) error,
 ) error {
-	defaultSess, err := c.StartSession(opts)
+	defaultSess, err := c.StartSessionWithContext(ctx, opts)
 	if err != nil {
 		return err
 	}
@@ -851,6 +973,7 @@ func (c *Client) Watch(ctx context.Context, pipeline interface{},
 		registry:       c.registry,
 		streamType:     ClientStream,
 		crypt:          c.cryptFLE,
+		concernSources: c.concernSources,
 	}
 
 	return newChangeStream(ctx, csConfig, pipeline, opts...)
@@ -865,11 +988,64 @@ func (c *Client) NumberSessionsInProgress() int {
 	return int(c.sessionPool.CheckedOut())
 }
 
+// ReadConcern returns the read concern used by the Client. If no read concern was specified in the ClientOptions, an
+// empty read concern is returned so that the server's default is used. The returned value is a copy, so modifying it
+// does not affect the Client.
+func (c *Client) ReadConcern() *readconcern.ReadConcern {
+	return copyReadConcern(c.readConcern)
+}
+
+// WriteConcern returns the write concern used by the Client, or nil if no write concern was specified in the
+// ClientOptions. The returned value is a copy, so modifying it does not affect the Client.
+func (c *Client) WriteConcern() *writeconcern.WriteConcern {
+	return copyWriteConcern(c.writeConcern)
+}
+
+// ReadPreference returns the read preference used by the Client. If no read preference was specified in the
+// ClientOptions, readpref.Primary() is returned.
+func (c *Client) ReadPreference() *readpref.ReadPref {
+	return c.readPreference
+}
+
+// CryptSharedLibVersion returns the version string of the crypt_shared library loaded for automatic
+// encryption. It returns an empty string if automatic encryption is not configured or if the
+// crypt_shared library was not loaded, in which case mongocryptd is used instead.
+func (c *Client) CryptSharedLibVersion() string {
+	return c.cryptSharedLibVersion
+}
+
+// ConnectionString returns the URI used to configure the Client with the password replaced by "***". The values of the
+// tlsCertificateKeyFilePassword and sslClientCertificateKeyPassword options and of the AWS_SESSION_TOKEN auth mechanism
+// property are redacted the same way. Credentials configured with options.ClientOptions.SetAuth, including OIDC
+// callbacks, are never part of the URI. ConnectionString returns an empty string if the Client was not configured with
+// options.ClientOptions.ApplyURI.
+func (c *Client) ConnectionString() string {
+	return c.connectionString
+}
+
+// Hosts returns the addresses of the servers currently known to the Client. The list reflects the
+// latest topology description, so it can change as servers are discovered or removed. Hosts
+// returns nil if the Client is not backed by a monitored topology.
+func (c *Client) Hosts() []string {
+	topo, ok := c.deployment.(*topology.Topology)
+	if !ok {
+		return nil
+	}
+
+	servers := topo.Description().Servers
+	hosts := make([]string, 0, len(servers))
+	for _, server := range servers {
+		hosts = append(hosts, server.Addr.String())
+	}
+	return hosts
+}
+
 func (c *Client) createBaseCursorOptions() driver.CursorOptions {
 	return driver.CursorOptions{
-		CommandMonitor: c.monitor,
-		Crypt:          c.cryptFLE,
-		ServerAPI:      c.serverAPI,
+		CommandMonitor:     c.monitor,
+		Crypt:              c.cryptFLE,
+		ServerAPI:          c.serverAPI,
+		RetainLastResponse: c.retainRawResponses,
 	}
 }
 
@@ -901,7 +1077,7 @@ func (c *Client) BulkWrite(ctx context.Context, writes []ClientBulkWrite,
 	}
 
 	sess := sessionFromContext(ctx)
-	if sess == nil && c.sessionPool != nil {
+	if sess == nil && c.sessionPool != nil && !implicitSessionDisabled(ctx) {
 		sess = session.NewImplicitClientSession(c.sessionPool, c.id)
 		defer sess.EndSession()
 	}
@@ -964,7 +1140,12 @@ func (c *Client) BulkWrite(ctx context.Context, writes []ClientBulkWrite,
 	}
 	op.result.Acknowledged = acknowledged
 	op.result.HasVerboseResults = !op.errorsOnly
-	err = op.execute(ctx)
+
+	sources := c.concernSources
+	if bwo.WriteConcern != nil {
+		sources.WriteConcern = driverutil.ConcernSourceOperation
+	}
+	err = op.execute(withConcernSources(ctx, c.logger, sources))
 	return &op.result, replaceErrors(err)
 }
 
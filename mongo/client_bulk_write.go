@@ -298,8 +298,9 @@ func (mb *modelBatches) appendBatches(fn functionSet, dst []byte, maxCount, tota
 			mb.cursorHandlers = append(mb.cursorHandlers, mb.appendInsertResult)
 			var id interface{}
 			id, doc, err = (&clientInsertDoc{
-				namespace: nsIdx,
-				document:  model.Document,
+				namespace:   nsIdx,
+				document:    model.Document,
+				idGenerator: mb.client.idGenerator,
 			}).marshal(mb.client.bsonOpts, mb.client.registry)
 			if err != nil {
 				break
@@ -576,8 +577,9 @@ func (mb *modelBatches) appendUpdateResult(cur *cursorInfo, raw bson.Raw) bool {
 }
 
 type clientInsertDoc struct {
-	namespace int
-	document  interface{}
+	namespace   int
+	document    interface{}
+	idGenerator func() interface{}
 }
 
 func (d *clientInsertDoc) marshal(bsonOpts *options.BSONOptions, registry *bson.Registry) (interface{}, bsoncore.Document, error) {
@@ -589,7 +591,7 @@ func (d *clientInsertDoc) marshal(bsonOpts *options.BSONOptions, registry *bson.
 		return nil, nil, err
 	}
 	var id interface{}
-	f, id, err = ensureID(f, bson.NilObjectID, bsonOpts, registry)
+	f, id, err = ensureID(f, bson.NilObjectID, d.idGenerator, bsonOpts, registry)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -10,12 +10,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"reflect"
-	"strings"
 	"time"
 
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/internal/csfle"
+	"go.mongodb.org/mongo-driver/v2/internal/driverutil"
 	"go.mongodb.org/mongo-driver/v2/internal/mongoutil"
 	"go.mongodb.org/mongo-driver/v2/internal/serverselector"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
@@ -41,6 +42,16 @@ type Collection struct {
 	writeSelector  description.ServerSelector
 	bsonOpts       *options.BSONOptions
 	registry       *bson.Registry
+
+	// maxTime is the server-side time limit for operations whose context has no deadline. It is
+	// always nil if Timeout is set on the Client.
+	maxTime *time.Duration
+
+	// concernSources records where readConcern and writeConcern were configured.
+	concernSources driverutil.ConcernSources
+
+	// aggregateCache stores the results of aggregations run with the CacheKey and CacheTTL options.
+	aggregateCache AggregateCache
 }
 
 // aggregateParams is used to store information to configure an Aggregate operation.
@@ -58,6 +69,7 @@ type aggregateParams struct {
 	readSelector   description.ServerSelector
 	writeSelector  description.ServerSelector
 	readPreference *readpref.ReadPref
+	explain        ExplainVerbosity
 }
 
 func closeImplicitSession(sess *session.Client) {
@@ -69,14 +81,18 @@ func closeImplicitSession(sess *session.Client) {
 func newCollection(db *Database, name string, opts ...options.Lister[options.CollectionOptions]) *Collection {
 	args, _ := mongoutil.NewOptions[options.CollectionOptions](opts...)
 
+	sources := db.concernSources
+
 	rc := db.readConcern
 	if args.ReadConcern != nil {
 		rc = args.ReadConcern
+		sources.ReadConcern = driverutil.ConcernSourceCollection
 	}
 
 	wc := db.writeConcern
 	if args.WriteConcern != nil {
 		wc = args.WriteConcern
+		sources.WriteConcern = driverutil.ConcernSourceCollection
 	}
 
 	rp := db.readPreference
@@ -94,6 +110,11 @@ func newCollection(db *Database, name string, opts ...options.Lister[options.Col
 		reg = args.Registry
 	}
 
+	maxTime := db.defaultMaxTime
+	if args.DefaultMaxTime != nil {
+		maxTime = args.DefaultMaxTime
+	}
+
 	readSelector := &serverselector.Composite{
 		Selectors: []description.ServerSelector{
 			&serverselector.ReadPref{ReadPref: rp},
@@ -119,11 +140,22 @@ func newCollection(db *Database, name string, opts ...options.Lister[options.Col
 		writeSelector:  writeSelector,
 		bsonOpts:       bsonOpts,
 		registry:       reg,
+		concernSources: sources,
 	}
+	coll.setMaxTime(maxTime)
 
 	return coll
 }
 
+// setMaxTime sets the default server-side time limit for operations on coll. The limit is not
+// used if Timeout is set on the Client to avoid mixing maxTimeMS with client-side timeouts.
+func (coll *Collection) setMaxTime(maxTime *time.Duration) {
+	if coll.client.timeout != nil {
+		maxTime = nil
+	}
+	coll.maxTime = maxTime
+}
+
 func (coll *Collection) copy() *Collection {
 	return &Collection{
 		client:         coll.client,
@@ -135,9 +167,27 @@ func (coll *Collection) copy() *Collection {
 		readSelector:   coll.readSelector,
 		writeSelector:  coll.writeSelector,
 		registry:       coll.registry,
+		maxTime:        coll.maxTime,
+		concernSources: coll.concernSources,
+		aggregateCache: coll.aggregateCache,
 	}
 }
 
+// withConcernSources returns ctx annotated with where the Collection's read and write concerns were configured. See
+// the package-level withConcernSources.
+func (coll *Collection) withConcernSources(ctx context.Context) context.Context {
+	return withConcernSources(ctx, coll.client.logger, coll.concernSources)
+}
+
+// WithAggregateCache returns a copy of the Collection that uses cache for aggregations run with the CacheKey and
+// CacheTTL aggregate options. Passing nil disables caching. The cache is not invalidated when the collection is
+// modified; see AggregateOptionsBuilder.SetCacheKey for more information.
+func (coll *Collection) WithAggregateCache(cache AggregateCache) *Collection {
+	copyColl := coll.copy()
+	copyColl.aggregateCache = cache
+	return copyColl
+}
+
 // Clone creates a copy of the Collection configured with the given CollectionOptions.
 // The specified options are merged with the existing options on the collection, with the specified options taking
 // precedence.
@@ -148,10 +198,12 @@ func (coll *Collection) Clone(opts ...options.Lister[options.CollectionOptions])
 
 	if args.ReadConcern != nil {
 		copyColl.readConcern = args.ReadConcern
+		copyColl.concernSources.ReadConcern = driverutil.ConcernSourceCollection
 	}
 
 	if args.WriteConcern != nil {
 		copyColl.writeConcern = args.WriteConcern
+		copyColl.concernSources.WriteConcern = driverutil.ConcernSourceCollection
 	}
 
 	if args.ReadPreference != nil {
@@ -162,6 +214,10 @@ func (coll *Collection) Clone(opts ...options.Lister[options.CollectionOptions])
 		copyColl.registry = args.Registry
 	}
 
+	if args.DefaultMaxTime != nil {
+		copyColl.setMaxTime(args.DefaultMaxTime)
+	}
+
 	copyColl.readSelector = &serverselector.Composite{
 		Selectors: []description.ServerSelector{
 			&serverselector.ReadPref{ReadPref: copyColl.readPreference},
@@ -182,6 +238,26 @@ func (coll *Collection) Database() *Database {
 	return coll.db
 }
 
+// ReadConcern returns the read concern used by the Collection. If no read concern was specified when the Collection
+// was created or cloned, the read concern of the Database it was created from is returned. The returned value is a
+// copy, so modifying it does not affect the Collection.
+func (coll *Collection) ReadConcern() *readconcern.ReadConcern {
+	return copyReadConcern(coll.readConcern)
+}
+
+// WriteConcern returns the write concern used by the Collection. If no write concern was specified when the
+// Collection was created or cloned, the write concern of the Database it was created from is returned. The returned
+// value is a copy, so modifying it does not affect the Collection.
+func (coll *Collection) WriteConcern() *writeconcern.WriteConcern {
+	return copyWriteConcern(coll.writeConcern)
+}
+
+// ReadPreference returns the read preference used by the Collection. If no read preference was specified when the
+// Collection was created or cloned, the read preference of the Database it was created from is returned.
+func (coll *Collection) ReadPreference() *readpref.ReadPref {
+	return coll.readPreference
+}
+
 // BulkWrite performs a bulk write operation (https://www.mongodb.com/docs/manual/core/bulk-write-operations/).
 //
 // The models parameter must be a slice of operations to be executed in this bulk write. It cannot be nil or empty.
@@ -199,9 +275,10 @@ func (coll *Collection) BulkWrite(ctx context.Context, models []WriteModel,
 	if ctx == nil {
 		ctx = context.Background()
 	}
+	ctx = coll.withConcernSources(ctx)
 
 	sess := sessionFromContext(ctx)
-	if sess == nil && coll.client.sessionPool != nil {
+	if sess == nil && coll.client.sessionPool != nil && !implicitSessionDisabled(ctx) {
 		sess = session.NewImplicitClientSession(coll.client.sessionPool, coll.client.id)
 		defer sess.EndSession()
 	}
@@ -251,25 +328,23 @@ func (coll *Collection) BulkWrite(ctx context.Context, models []WriteModel,
 	return &op.result, replaceErrors(err)
 }
 
+// insertedID is the _id value of a document that is being inserted, both decoded and as the raw BSON value.
+type insertedID struct {
+	value interface{}
+	raw   bson.RawValue
+}
+
 func (coll *Collection) insert(
 	ctx context.Context,
 	documents []interface{},
 	opts ...options.Lister[options.InsertManyOptions],
-) ([]interface{}, error) {
+) ([]insertedID, error) {
 
-	if ctx == nil {
-		ctx = context.Background()
-	}
-
-	result := make([]interface{}, len(documents))
+	result := make([]insertedID, len(documents))
 	docs := make([]bsoncore.Document, len(documents))
 
 	for i, doc := range documents {
-		bsoncoreDoc, err := marshal(doc, coll.bsonOpts, coll.registry)
-		if err != nil {
-			return nil, err
-		}
-		bsoncoreDoc, id, err := ensureID(bsoncoreDoc, bson.NilObjectID, coll.bsonOpts, coll.registry)
+		bsoncoreDoc, id, err := coll.marshalInsertDocument(ctx, doc)
 		if err != nil {
 			return nil, err
 		}
@@ -278,8 +353,54 @@ func (coll *Collection) insert(
 		result[i] = id
 	}
 
+	args, err := mongoutil.NewOptions[options.InsertManyOptions](opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to construct options from builder: %w", err)
+	}
+
+	return coll.insertDocuments(ctx, docs, result, args)
+}
+
+// marshalInsertDocument marshals doc and adds an _id field if it does not already have one. It returns the marshalled
+// document and its _id value. Marshalling is aborted if ctx is done.
+func (coll *Collection) marshalInsertDocument(
+	ctx context.Context,
+	doc interface{},
+) (bsoncore.Document, insertedID, error) {
+	bsoncoreDoc, err := marshalWithContext(ctx, doc, coll.bsonOpts, coll.registry)
+	if err != nil {
+		return nil, insertedID{}, err
+	}
+	bsoncoreDoc, id, err := ensureID(bsoncoreDoc, bson.NilObjectID, coll.client.idGenerator, coll.bsonOpts, coll.registry)
+	if err != nil {
+		return nil, insertedID{}, err
+	}
+
+	// Copy the raw _id value so the result does not keep the whole marshalled document alive.
+	rawID := bsoncoreDoc.Lookup("_id")
+	data := make([]byte, len(rawID.Data))
+	copy(data, rawID.Data)
+
+	return bsoncoreDoc, insertedID{
+		value: id,
+		raw:   bson.RawValue{Type: bson.Type(rawID.Type), Value: data},
+	}, nil
+}
+
+// insertDocuments executes an insert command for the already-marshalled docs. The result slice must contain the _id
+// value for each document in docs. The returned slice contains the _id values of the documents that were inserted.
+func (coll *Collection) insertDocuments(
+	ctx context.Context,
+	docs []bsoncore.Document,
+	result []insertedID,
+	args *options.InsertManyOptions,
+) ([]insertedID, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
 	sess := sessionFromContext(ctx)
-	if sess == nil && coll.client.sessionPool != nil {
+	if sess == nil && coll.client.sessionPool != nil && !implicitSessionDisabled(ctx) {
 		sess = session.NewImplicitClientSession(coll.client.sessionPool, coll.client.id)
 		defer sess.EndSession()
 	}
@@ -306,11 +427,6 @@ func (coll *Collection) insert(
 		Deployment(coll.client.deployment).Crypt(coll.client.cryptFLE).Ordered(true).
 		ServerAPI(coll.client.serverAPI).Timeout(coll.client.timeout).Logger(coll.client.logger).Authenticator(coll.client.authenticator)
 
-	args, err := mongoutil.NewOptions[options.InsertManyOptions](opts...)
-	if err != nil {
-		return nil, fmt.Errorf("failed to construct options from builder: %w", err)
-	}
-
 	if args.BypassDocumentValidation != nil && *args.BypassDocumentValidation {
 		op = op.BypassDocumentValidation(*args.BypassDocumentValidation)
 	}
@@ -330,7 +446,7 @@ func (coll *Collection) insert(
 	}
 	op = op.Retry(retry)
 
-	err = op.Execute(ctx)
+	err = op.Execute(coll.withConcernSources(ctx))
 	var wce driver.WriteCommandError
 	if !errors.As(err, &wce) {
 		return result, err
@@ -383,7 +499,7 @@ func (coll *Collection) InsertOne(ctx context.Context, document interface{},
 	}
 
 	return &InsertOneResult{
-		InsertedID:   res[0],
+		InsertedID:   res[0].value,
 		Acknowledged: rr.isAcknowledged(),
 	}, err
 }
@@ -424,10 +540,8 @@ func (coll *Collection) InsertMany(
 		return nil, err
 	}
 
-	imResult := &InsertManyResult{
-		InsertedIDs:  result,
-		Acknowledged: rr.isAcknowledged(),
-	}
+	imResult := &InsertManyResult{Acknowledged: rr.isAcknowledged()}
+	imResult.appendInsertedIDs(result)
 	var writeException WriteException
 	if !errors.As(err, &writeException) {
 		return imResult, err
@@ -449,6 +563,155 @@ func (coll *Collection) InsertMany(
 	}
 }
 
+// insertManyFromFuncMaxBatchCount and insertManyFromFuncMaxBatchSize bound the number of documents and the total
+// marshalled size of the documents that InsertManyFromFunc pulls before sending them to the server. They match the
+// default maxWriteBatchSize and maxMessageSizeBytes server limits.
+var (
+	insertManyFromFuncMaxBatchCount = 100000
+	insertManyFromFuncMaxBatchSize  = 48000000
+)
+
+// InsertManyFromFunc executes insert commands to insert documents pulled from the next function into the collection.
+// Unlike InsertMany, the documents do not need to be materialized in a slice. Documents are pulled lazily, marshalled
+// one at a time, and pulled in batches bounded by the default maxWriteBatchSize and maxMessageSizeBytes server limits.
+// As with InsertMany, each pulled batch is split into multiple insert commands if it exceeds the limits reported by
+// the server.
+//
+// The next function must return the next document to insert, or io.EOF when there are no more documents. If next
+// returns any other error, no more documents are pulled and that error is returned along with the result for the
+// documents that were already inserted. If next returns io.EOF before returning any documents, ErrEmptySlice is
+// returned.
+//
+// If write errors occur and the operation is ordered, no more documents are pulled. If the operation is unordered,
+// the remaining documents are still pulled and inserted. In either case, a BulkWriteException is returned whose write
+// error indexes refer to the position of the document in the sequence returned by next. The InsertedIDs field of the
+// returned InsertManyResult contains the _id values of all documents that were inserted.
+//
+// The opts parameter can be used to specify options for the operation (see the options.InsertManyOptions
+// documentation). The Progress option can be used to report the number of documents inserted after each batch.
+//
+// For more information about the command, see https://www.mongodb.com/docs/manual/reference/command/insert/.
+func (coll *Collection) InsertManyFromFunc(
+	ctx context.Context,
+	next func() (interface{}, error),
+	opts ...options.Lister[options.InsertManyOptions],
+) (*InsertManyResult, error) {
+	args, err := mongoutil.NewOptions[options.InsertManyOptions](opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to construct options from builder: %w", err)
+	}
+	ordered := args.Ordered == nil || *args.Ordered
+
+	imResult := &InsertManyResult{Acknowledged: true}
+	var bwe *BulkWriteException
+	var pulled int
+	var docs []bsoncore.Document
+	var ids []insertedID
+	for {
+		// Reuse the batch buffers because the documents and IDs of the previous batch are no longer referenced.
+		var eof bool
+		var pullErr error
+		docs, ids, eof, pullErr = coll.pullInsertBatch(ctx, next, docs[:0], ids[:0])
+		if len(docs) > 0 {
+			// Insert the documents that have already been pulled even if next returned an error.
+			bwe, err = coll.insertManyFromFuncBatch(ctx, docs, ids, pulled, args, imResult, bwe)
+			if err != nil {
+				return imResult, err
+			}
+			pulled += len(docs)
+		}
+		if pullErr != nil {
+			return imResult, pullErr
+		}
+		if eof || (bwe != nil && ordered) {
+			break
+		}
+	}
+
+	if pulled == 0 {
+		return nil, ErrEmptySlice
+	}
+	if bwe != nil {
+		return imResult, *bwe
+	}
+	return imResult, nil
+}
+
+// pullInsertBatch pulls and marshals documents from next, appending them and their _id values to docs and ids, until
+// the batch reaches the maximum batch count or size, or until next returns io.EOF, in which case the returned bool is
+// true.
+func (coll *Collection) pullInsertBatch(
+	ctx context.Context,
+	next func() (interface{}, error),
+	docs []bsoncore.Document,
+	ids []insertedID,
+) ([]bsoncore.Document, []insertedID, bool, error) {
+	var size int
+	for len(docs) < insertManyFromFuncMaxBatchCount && size < insertManyFromFuncMaxBatchSize {
+		doc, err := next()
+		if errors.Is(err, io.EOF) {
+			return docs, ids, true, nil
+		}
+		if err != nil {
+			return docs, ids, false, err
+		}
+
+		bsoncoreDoc, id, err := coll.marshalInsertDocument(ctx, doc)
+		if err != nil {
+			return docs, ids, false, err
+		}
+
+		docs = append(docs, bsoncoreDoc)
+		ids = append(ids, id)
+		size += len(bsoncoreDoc)
+	}
+	return docs, ids, false, nil
+}
+
+// insertManyFromFuncBatch inserts a single batch of documents for InsertManyFromFunc. The IDs of the inserted documents
+// are appended to imResult and any write errors are merged into bwe, with their indexes offset by the number of
+// documents pulled before this batch. A non-nil error is returned for errors that are not write errors.
+func (coll *Collection) insertManyFromFuncBatch(
+	ctx context.Context,
+	docs []bsoncore.Document,
+	ids []insertedID,
+	offset int,
+	args *options.InsertManyOptions,
+	imResult *InsertManyResult,
+	bwe *BulkWriteException,
+) (*BulkWriteException, error) {
+	inserted, err := coll.insertDocuments(ctx, docs, ids, args)
+	rr, err := processWriteError(err)
+	if rr&rrMany == 0 {
+		return bwe, err
+	}
+
+	imResult.appendInsertedIDs(inserted)
+	imResult.Acknowledged = rr.isAcknowledged()
+	if args.Progress != nil {
+		args.Progress(len(imResult.InsertedIDs))
+	}
+
+	var writeException WriteException
+	if !errors.As(err, &writeException) {
+		return bwe, err
+	}
+
+	if bwe == nil {
+		bwe = &BulkWriteException{}
+	}
+	for _, we := range writeException.WriteErrors {
+		we.Index += offset
+		bwe.WriteErrors = append(bwe.WriteErrors, BulkWriteError{WriteError: we})
+	}
+	if writeException.WriteConcernError != nil {
+		bwe.WriteConcernError = writeException.WriteConcernError
+	}
+	bwe.Labels = append(bwe.Labels, writeException.Labels...)
+
+	return bwe, nil
+}
+
 func (coll *Collection) delete(
 	ctx context.Context,
 	filter interface{},
@@ -467,7 +730,7 @@ func (coll *Collection) delete(
 	}
 
 	sess := sessionFromContext(ctx)
-	if sess == nil && coll.client.sessionPool != nil {
+	if sess == nil && coll.client.sessionPool != nil && !implicitSessionDisabled(ctx) {
 		sess = session.NewImplicitClientSession(coll.client.sessionPool, coll.client.id)
 		defer sess.EndSession()
 	}
@@ -541,7 +804,7 @@ func (coll *Collection) delete(
 		retryMode = driver.RetryOncePerCommand
 	}
 	op = op.Retry(retryMode)
-	rr, err := processWriteError(op.Execute(ctx))
+	rr, err := processWriteError(op.Execute(coll.withConcernSources(ctx)))
 	if rr&expectedRr == 0 {
 		return nil, err
 	}
@@ -621,22 +884,23 @@ func (coll *Collection) updateOrReplace(
 	// collation, arrayFilters, upsert, and hint are included on the individual update documents rather than as part of the
 	// command
 	updateDoc, err := updateDoc{
-		filter:         filter,
-		update:         update,
-		hint:           args.Hint,
-		sort:           sort,
-		arrayFilters:   args.ArrayFilters,
-		collation:      args.Collation,
-		upsert:         args.Upsert,
-		multi:          multi,
-		checkDollarKey: checkDollarKey,
+		filter:             filter,
+		update:             update,
+		hint:               args.Hint,
+		sort:               sort,
+		arrayFilters:       args.ArrayFilters,
+		collation:          args.Collation,
+		upsert:             args.Upsert,
+		multi:              multi,
+		checkDollarKey:     checkDollarKey,
+		skipDollarKeyCheck: args.SkipDollarKeyValidation != nil && *args.SkipDollarKeyValidation,
 	}.marshal(coll.bsonOpts, coll.registry)
 	if err != nil {
 		return nil, err
 	}
 
 	sess := sessionFromContext(ctx)
-	if sess == nil && coll.client.sessionPool != nil {
+	if sess == nil && coll.client.sessionPool != nil && !implicitSessionDisabled(ctx) {
 		sess = session.NewImplicitClientSession(coll.client.sessionPool, coll.client.id)
 		defer sess.EndSession()
 	}
@@ -687,7 +951,7 @@ func (coll *Collection) updateOrReplace(
 		retry = driver.RetryOncePerCommand
 	}
 	op = op.Retry(retry)
-	err = op.Execute(ctx)
+	err = op.Execute(coll.withConcernSources(ctx))
 
 	rr, err := processWriteError(err)
 	if rr&expectedRr == 0 {
@@ -743,7 +1007,8 @@ func (coll *Collection) UpdateByID(
 //
 // The update parameter must be a document containing update operators
 // (https://www.mongodb.com/docs/manual/reference/operator/update/) and can be used to specify the modifications to be
-// made to the selected document. It cannot be nil or empty.
+// made to the selected document. It cannot be nil or empty unless the update is specified with shorthand update fields
+// such as options.UpdateOneOptionsBuilder.SetSetFields, in which case it must be nil.
 //
 // The opts parameter can be used to specify options for the operation (see the options.UpdateOptions documentation).
 //
@@ -767,6 +1032,12 @@ func (coll *Collection) UpdateOne(
 	if err != nil {
 		return nil, fmt.Errorf("failed to construct options from builder: %w", err)
 	}
+	if sh := shorthandUpdate(args.SetFields, args.UnsetFields, args.PushFields, args.PullFields, args.IncFields); sh != nil {
+		if update != nil {
+			return nil, ErrConflictingUpdateSpec
+		}
+		update = sh
+	}
 	updateOptions := &options.UpdateManyOptions{
 		ArrayFilters:             args.ArrayFilters,
 		BypassDocumentValidation: args.BypassDocumentValidation,
@@ -775,6 +1046,7 @@ func (coll *Collection) UpdateOne(
 		Hint:                     args.Hint,
 		Upsert:                   args.Upsert,
 		Let:                      args.Let,
+		SkipDollarKeyValidation:  args.SkipDollarKeyValidation,
 	}
 
 	return coll.updateOrReplace(ctx, f, update, false, rrOne, true, args.Sort, updateOptions)
@@ -788,7 +1060,8 @@ func (coll *Collection) UpdateOne(
 //
 // The update parameter must be a document containing update operators
 // (https://www.mongodb.com/docs/manual/reference/operator/update/) and can be used to specify the modifications to be made
-// to the selected documents. It cannot be nil or empty.
+// to the selected documents. It cannot be nil or empty unless the update is specified with shorthand update fields
+// such as options.UpdateManyOptionsBuilder.SetSetFields, in which case it must be nil.
 //
 // The opts parameter can be used to specify options for the operation (see the options.UpdateOptions documentation).
 //
@@ -812,6 +1085,12 @@ func (coll *Collection) UpdateMany(
 	if err != nil {
 		return nil, fmt.Errorf("failed to construct options from builder: %w", err)
 	}
+	if sh := shorthandUpdate(args.SetFields, args.UnsetFields, args.PushFields, args.PullFields, args.IncFields); sh != nil {
+		if update != nil {
+			return nil, ErrConflictingUpdateSpec
+		}
+		update = sh
+	}
 
 	return coll.updateOrReplace(ctx, f, update, true, rrMany, true, nil, args)
 }
@@ -849,7 +1128,7 @@ func (coll *Collection) ReplaceOne(
 		return nil, err
 	}
 
-	r, err := marshal(replacement, coll.bsonOpts, coll.registry)
+	r, err := marshalWithContext(ctx, replacement, coll.bsonOpts, coll.registry)
 	if err != nil {
 		return nil, err
 	}
@@ -858,6 +1137,12 @@ func (coll *Collection) ReplaceOne(
 		return nil, err
 	}
 
+	if args.ValidateID != nil && *args.ValidateID {
+		if err := ensureReplacementIDMatchesFilter(f, r); err != nil {
+			return nil, err
+		}
+	}
+
 	updateOptions := &options.UpdateManyOptions{
 		BypassDocumentValidation: args.BypassDocumentValidation,
 		Collation:                args.Collation,
@@ -886,8 +1171,108 @@ func (coll *Collection) Aggregate(
 	pipeline interface{},
 	opts ...options.Lister[options.AggregateOptions],
 ) (*Cursor, error) {
-	a := aggregateParams{
-		ctx:            ctx,
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	if coll.aggregateCache != nil {
+		return coll.cachedAggregate(ctx, pipeline, opts...)
+	}
+	return aggregate(coll.newAggregateParams(ctx, pipeline), opts...)
+}
+
+// cachedAggregate runs Aggregate using the Collection's aggregate cache. If the CacheKey and CacheTTL options are
+// set, results found in the cache are returned without running the aggregation. Otherwise, the aggregation is run and
+// its results are stored in the cache once they are read with Cursor.All.
+func (coll *Collection) cachedAggregate(
+	ctx context.Context,
+	pipeline interface{},
+	opts ...options.Lister[options.AggregateOptions],
+) (*Cursor, error) {
+	args, err := mongoutil.NewOptions[options.AggregateOptions](opts...)
+	if err != nil {
+		return nil, err
+	}
+	if args.CacheKey == nil || *args.CacheKey == "" || args.CacheTTL == nil || *args.CacheTTL <= 0 {
+		return aggregate(coll.newAggregateParams(ctx, pipeline), opts...)
+	}
+
+	// Cached results are not tied to a session, so they could be from outside the snapshot of a transaction or violate
+	// the guarantees of a causally consistent session.
+	if sessionFromContext(ctx) != nil {
+		return aggregate(coll.newAggregateParams(ctx, pipeline), opts...)
+	}
+
+	// Pipelines that write their results must always run. Pass the marshalled pipeline on so aggregate does not
+	// marshal it again.
+	pipelineArr, hasOutputStage, err := marshalAggregatePipeline(pipeline, coll.bsonOpts, coll.registry)
+	if err != nil {
+		return nil, err
+	}
+	pipeline = bsoncore.Array(pipelineArr)
+	if hasOutputStage {
+		return aggregate(coll.newAggregateParams(ctx, pipeline), opts...)
+	}
+
+	cache, key, ttl := coll.aggregateCache, *args.CacheKey, *args.CacheTTL
+	if args.ForceRefresh == nil || !*args.ForceRefresh {
+		if results, ok := cache.Get(key); ok {
+			docs := make([]interface{}, len(results))
+			for i, doc := range results {
+				docs[i] = doc
+			}
+			cursor, err := NewCursorFromDocuments(docs, nil, coll.registry)
+			if err != nil {
+				return nil, err
+			}
+			cursor.bsonOpts = coll.bsonOpts
+			return cursor, nil
+		}
+	}
+
+	cursor, err := aggregate(coll.newAggregateParams(ctx, pipeline), opts...)
+	if err != nil {
+		return nil, err
+	}
+	cursor.cacheResults = func(results []bson.Raw) {
+		cache.Set(key, results, ttl)
+	}
+	return cursor, nil
+}
+
+// ExplainAggregate runs an explain command with the given verbosity for the aggregate command that Aggregate would
+// execute with the same pipeline and options, and returns the explain output. The pipeline is not executed unless the
+// verbosity requires it (e.g. ExecutionStats), and no documents are returned. If verbosity is empty, QueryPlanner is
+// used.
+//
+// The explain output is a raw document whose format depends on the server version and topology. It is intended for
+// logging and analysis; see https://www.mongodb.com/docs/manual/reference/explain-results/ for more information.
+//
+// For more information about the command, see https://www.mongodb.com/docs/manual/reference/command/explain/.
+func (coll *Collection) ExplainAggregate(
+	ctx context.Context,
+	pipeline interface{},
+	verbosity ExplainVerbosity,
+	opts ...options.Lister[options.AggregateOptions],
+) *SingleResult {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	a := coll.newAggregateParams(ctx, pipeline)
+	a.explain = verbosity
+	if a.explain == "" {
+		a.explain = QueryPlanner
+	}
+
+	cursor, err := aggregate(a, opts...)
+	return coll.explainResult(ctx, cursor, err)
+}
+
+func (coll *Collection) newAggregateParams(ctx context.Context, pipeline interface{}) aggregateParams {
+	rp, readSelector := coll.readPrefForContext(ctx)
+	return aggregateParams{
+		ctx:            coll.withConcernSources(ctx),
 		pipeline:       pipeline,
 		client:         coll.client,
 		registry:       coll.registry,
@@ -897,12 +1282,72 @@ func (coll *Collection) Aggregate(
 		retryRead:      coll.client.retryReads,
 		db:             coll.db.name,
 		col:            coll.name,
-		readSelector:   coll.readSelector,
+		readSelector:   readSelector,
 		writeSelector:  coll.writeSelector,
-		readPreference: coll.readPreference,
+		readPreference: rp,
+	}
+}
+
+// explainResult returns a SingleResult for the explain output held by cursor.
+func (coll *Collection) explainResult(ctx context.Context, cursor *Cursor, err error) *SingleResult {
+	return &SingleResult{
+		ctx:      ctx,
+		cur:      cursor,
+		bsonOpts: coll.bsonOpts,
+		reg:      coll.registry,
+		err:      replaceErrors(err),
 	}
+}
+
+// AggregateStream executes an aggregate command against the collection and streams the resulting documents on the
+// returned document channel. The cursor is iterated in a separate goroutine that is started before AggregateStream
+// returns.
+//
+// The pipeline and opts parameters are the same as for Aggregate.
+//
+// Both channels are closed once the cursor is exhausted, an error occurs, or ctx is cancelled. The error channel
+// receives at most one value: the error returned by Aggregate, the cursor error, or ctx.Err() if ctx is cancelled
+// while waiting for the documents to be received. Callers should drain the document channel and then receive from
+// the error channel; a nil error indicates that all documents were delivered. The documents sent on the channel are
+// copies and remain valid after subsequent documents are received.
+func (coll *Collection) AggregateStream(
+	ctx context.Context,
+	pipeline interface{},
+	opts ...options.Lister[options.AggregateOptions],
+) (<-chan bson.Raw, <-chan error) {
+	docs := make(chan bson.Raw)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+		defer close(docs)
 
-	return aggregate(a, opts...)
+		cursor, err := coll.Aggregate(ctx, pipeline, opts...)
+		if err != nil {
+			errs <- err
+			return
+		}
+		// Close the cursor with a context that is not cancelled so the server-side cursor is killed even if ctx
+		// was cancelled.
+		defer func() { _ = cursor.Close(newBackgroundContext(ctx)) }()
+
+		for cursor.Next(ctx) {
+			doc := make(bson.Raw, len(cursor.Current))
+			copy(doc, cursor.Current)
+
+			select {
+			case docs <- doc:
+			case <-ctx.Done():
+				errs <- ctx.Err()
+				return
+			}
+		}
+		if err := cursor.Err(); err != nil {
+			errs <- err
+		}
+	}()
+
+	return docs, errs
 }
 
 // aggregate is the helper method for Aggregate
@@ -923,7 +1368,7 @@ func aggregate(a aggregateParams, opts ...options.Lister[options.AggregateOption
 			closeImplicitSession(sess)
 		}
 	}()
-	if sess == nil && a.client.sessionPool != nil {
+	if sess == nil && a.client.sessionPool != nil && !implicitSessionDisabled(a.ctx) {
 		sess = session.NewImplicitClientSession(a.client.sessionPool, a.client.id)
 	}
 	if err = a.client.validSession(sess); err != nil {
@@ -954,6 +1399,21 @@ func aggregate(a aggregateParams, opts ...options.Lister[options.AggregateOption
 		return nil, err
 	}
 
+	if args.MaxResults != nil {
+		if hasOutputStage {
+			return nil, errors.New("MaxResults cannot be used with an $out or $merge stage")
+		}
+		if *args.MaxResults <= 0 {
+			return nil, fmt.Errorf("MaxResults must be positive, got %d", *args.MaxResults)
+		}
+		pipelineArr = appendLimitStage(pipelineArr, *args.MaxResults)
+	}
+
+	explain := a.explain
+	if explain == "" && args.Explain != nil && *args.Explain {
+		explain = QueryPlanner
+	}
+
 	cursorOpts := a.client.createBaseCursorOptions()
 
 	cursorOpts.MarshalValueEncoderFn = newEncoderFn(a.bsonOpts, a.registry)
@@ -973,12 +1433,14 @@ func aggregate(a aggregateParams, opts ...options.Lister[options.AggregateOption
 		ServerAPI(a.client.serverAPI).
 		HasOutputStage(hasOutputStage).
 		Timeout(a.client.timeout).
+		Logger(a.client.logger).
 		Authenticator(a.client.authenticator).
 		// Omit "maxTimeMS" from operations that return a user-managed cursor to
 		// prevent confusing "cursor not found" errors.
 		//
 		// See DRIVERS-2722 for more detail.
-		OmitMaxTimeMS(true)
+		OmitMaxTimeMS(true).
+		Explain(string(explain))
 
 	if args.AllowDiskUse != nil {
 		op.AllowDiskUse(*args.AllowDiskUse)
@@ -1052,11 +1514,31 @@ func aggregate(a aggregateParams, opts ...options.Lister[options.AggregateOption
 		return nil, replaceErrors(err)
 	}
 
+	if explain != "" {
+		// The explain output is a single document rather than a server-side cursor, so the session is not needed
+		// for any subsequent getMore commands.
+		closeImplicitSession(sess)
+		sess = nil
+		return NewCursorFromDocuments([]interface{}{bson.Raw(op.ExplainResult())}, nil, a.registry)
+	}
+	if doc := op.DocumentResult(); doc != nil {
+		// Some aggregate invocations, such as one with an explain option passed through Custom, reply with a single
+		// document instead of a cursor. Return that reply as the only document of the cursor.
+		closeImplicitSession(sess)
+		sess = nil
+		return NewCursorFromDocuments([]interface{}{bson.Raw(doc)}, nil, a.registry)
+	}
+
 	bc, err := op.Result(cursorOpts)
 	if err != nil {
 		return nil, replaceErrors(err)
 	}
 	cursor, err := newCursorWithSession(bc, a.client.bsonOpts, a.registry, sess)
+	ns := a.db + "." + a.col
+	if a.col == "" {
+		ns = a.db + ".$cmd.aggregate"
+	}
+	a.client.cursorReaper.track(cursor, ns)
 	return cursor, replaceErrors(err)
 }
 
@@ -1085,7 +1567,7 @@ func (coll *Collection) CountDocuments(ctx context.Context, filter interface{},
 	}
 
 	sess := sessionFromContext(ctx)
-	if sess == nil && coll.client.sessionPool != nil {
+	if sess == nil && coll.client.sessionPool != nil && !implicitSessionDisabled(ctx) {
 		sess = session.NewImplicitClientSession(coll.client.sessionPool, coll.client.id)
 		defer sess.EndSession()
 	}
@@ -1098,11 +1580,12 @@ func (coll *Collection) CountDocuments(ctx context.Context, filter interface{},
 		rc = nil
 	}
 
-	selector := makeReadPrefSelector(sess, coll.readSelector, coll.client.localThreshold)
-	op := operation.NewAggregate(pipelineArr).Session(sess).ReadConcern(rc).ReadPreference(coll.readPreference).
+	rp, readSelector := coll.readPrefForContext(ctx)
+	selector := makeReadPrefSelector(sess, readSelector, coll.client.localThreshold)
+	op := operation.NewAggregate(pipelineArr).Session(sess).ReadConcern(rc).ReadPreference(rp).
 		CommandMonitor(coll.client.monitor).ServerSelector(selector).ClusterClock(coll.client.clock).Database(coll.db.name).
 		Collection(coll.name).Deployment(coll.client.deployment).Crypt(coll.client.cryptFLE).ServerAPI(coll.client.serverAPI).
-		Timeout(coll.client.timeout).Authenticator(coll.client.authenticator)
+		Timeout(coll.client.timeout).MaxTime(coll.maxTime).Logger(coll.client.logger).Authenticator(coll.client.authenticator)
 	if args.Collation != nil {
 		op.Collation(bsoncore.Document(toDocument(args.Collation)))
 	}
@@ -1130,7 +1613,7 @@ func (coll *Collection) CountDocuments(ctx context.Context, filter interface{},
 	}
 	op = op.Retry(retry)
 
-	err = op.Execute(ctx)
+	err = op.Execute(coll.withConcernSources(ctx))
 	if err != nil {
 		return 0, replaceErrors(err)
 	}
@@ -1153,8 +1636,47 @@ func (coll *Collection) CountDocuments(ctx context.Context, filter interface{},
 	return val, nil
 }
 
+// ExplainCount runs an explain command with the given verbosity for the aggregation that CountDocuments would execute
+// with the same filter and options, and returns the explain output. If verbosity is empty, QueryPlanner is used.
+//
+// See ExplainAggregate for more information about the explain output.
+func (coll *Collection) ExplainCount(
+	ctx context.Context,
+	filter interface{},
+	verbosity ExplainVerbosity,
+	opts ...options.Lister[options.CountOptions],
+) *SingleResult {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	args, err := mongoutil.NewOptions[options.CountOptions](opts...)
+	if err != nil {
+		return &SingleResult{err: err}
+	}
+
+	pipelineArr, err := countDocumentsAggregatePipeline(filter, coll.bsonOpts, coll.registry, args)
+	if err != nil {
+		return &SingleResult{err: err}
+	}
+
+	a := coll.newAggregateParams(ctx, bsoncore.Array(pipelineArr))
+	a.explain = verbosity
+	if a.explain == "" {
+		a.explain = QueryPlanner
+	}
+
+	aggArgs := &options.AggregateOptions{
+		Collation: args.Collation,
+		Comment:   args.Comment,
+		Hint:      args.Hint,
+	}
+	cursor, err := aggregate(a, mongoutil.NewOptionsLister(aggArgs, nil))
+	return coll.explainResult(ctx, cursor, err)
+}
+
 // EstimatedDocumentCount executes a count command and returns an estimate of the number of documents in the collection
-// using collection metadata.
+// using collection metadata. If the UseCollStats option is set, an aggregation with a $collStats stage is used instead.
 //
 // The opts parameter can be used to specify options for the operation (see the options.EstimatedDocumentCountOptions
 // documentation).
@@ -1168,10 +1690,18 @@ func (coll *Collection) EstimatedDocumentCount(
 		ctx = context.Background()
 	}
 
+	args, err := mongoutil.NewOptions[options.EstimatedDocumentCountOptions](opts...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to construct options from builder: %w", err)
+	}
+
+	if args.UseCollStats != nil && *args.UseCollStats {
+		return coll.estimatedDocumentCountFromCollStats(ctx, args.Comment)
+	}
+
 	sess := sessionFromContext(ctx)
 
-	var err error
-	if sess == nil && coll.client.sessionPool != nil {
+	if sess == nil && coll.client.sessionPool != nil && !implicitSessionDisabled(ctx) {
 		sess = session.NewImplicitClientSession(coll.client.sessionPool, coll.client.id)
 		defer sess.EndSession()
 	}
@@ -1186,17 +1716,13 @@ func (coll *Collection) EstimatedDocumentCount(
 		rc = nil
 	}
 
-	args, err := mongoutil.NewOptions[options.EstimatedDocumentCountOptions](opts...)
-	if err != nil {
-		return 0, fmt.Errorf("failed to construct options from builder: %w", err)
-	}
-
-	selector := makeReadPrefSelector(sess, coll.readSelector, coll.client.localThreshold)
+	rp, readSelector := coll.readPrefForContext(ctx)
+	selector := makeReadPrefSelector(sess, readSelector, coll.client.localThreshold)
 	op := operation.NewCount().Session(sess).ClusterClock(coll.client.clock).
 		Database(coll.db.name).Collection(coll.name).CommandMonitor(coll.client.monitor).
-		Deployment(coll.client.deployment).ReadConcern(rc).ReadPreference(coll.readPreference).
+		Deployment(coll.client.deployment).ReadConcern(rc).ReadPreference(rp).
 		ServerSelector(selector).Crypt(coll.client.cryptFLE).ServerAPI(coll.client.serverAPI).
-		Timeout(coll.client.timeout).Authenticator(coll.client.authenticator)
+		Timeout(coll.client.timeout).MaxTime(coll.maxTime).Authenticator(coll.client.authenticator)
 
 	if args.Comment != nil {
 		comment, err := marshalValue(args.Comment, coll.bsonOpts, coll.registry)
@@ -1212,10 +1738,184 @@ func (coll *Collection) EstimatedDocumentCount(
 	}
 	op.Retry(retry)
 
-	err = op.Execute(ctx)
+	err = op.Execute(coll.withConcernSources(ctx))
 	return op.Result().N, replaceErrors(err)
 }
 
+// estimatedDocumentCountFromCollStats returns the number of documents in the collection as reported by the $collStats
+// stage. On a sharded collection $collStats returns one document per shard, so the counts are summed with $group.
+func (coll *Collection) estimatedDocumentCountFromCollStats(ctx context.Context, comment interface{}) (int64, error) {
+	pipeline := Pipeline{
+		{{"$collStats", bson.D{{"count", bson.D{}}}}},
+		{{"$group", bson.D{{"_id", 1}, {"n", bson.D{{"$sum", "$count"}}}}}},
+	}
+
+	aggOpts := options.Aggregate()
+	if comment != nil {
+		aggOpts.SetComment(comment)
+	}
+
+	// $collStats can only be run with a "local" read concern.
+	localColl := coll.Clone(options.Collection().SetReadConcern(readconcern.Local()))
+	cursor, err := aggregate(localColl.newAggregateParams(ctx, pipeline), aggOpts)
+	if err != nil {
+		// $collStats fails if the collection does not exist, which the count command reports as 0 documents.
+		var ce CommandError
+		if errors.As(err, &ce) && ce.HasErrorCode(26) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	defer cursor.Close(ctx)
+
+	if !cursor.Next(ctx) {
+		return 0, cursor.Err()
+	}
+
+	nVal, err := cursor.Current.LookupErr("n")
+	if err != nil {
+		return 0, fmt.Errorf("$collStats result is missing the count: %w", err)
+	}
+	n, ok := nVal.AsInt64OK()
+	if !ok {
+		return 0, fmt.Errorf("$collStats count has unexpected type %v", nVal.Type)
+	}
+	return n, nil
+}
+
+// CountSummary returns the exact number of documents matching the filter along with the server time at which it was
+// computed and, optionally, an estimate of the total number of documents in the collection. This is useful for
+// displaying results such as "showing X of ~Y".
+//
+// The filter parameter must be a document and can be used to select which documents contribute to the exact count. It
+// cannot be nil. An empty document (e.g. bson.D{}) should be used to count all documents in the collection.
+//
+// If the IncludeEstimate option is true, the estimate is computed with an additional count command (see
+// EstimatedDocumentCount). The two operations run concurrently unless ctx carries a session, in which case they run
+// one after the other because a session cannot be used concurrently.
+//
+// This method requires MongoDB 4.2 or later.
+//
+// The opts parameter can be used to specify options for the operation (see the options.CountSummaryOptions
+// documentation).
+func (coll *Collection) CountSummary(
+	ctx context.Context,
+	filter interface{},
+	opts ...options.Lister[options.CountSummaryOptions],
+) (*CountSummary, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	args, err := mongoutil.NewOptions[options.CountSummaryOptions](opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to construct options from builder: %w", err)
+	}
+
+	estimate := func() (int64, error) {
+		edcOpts := options.EstimatedDocumentCount()
+		if args.Comment != nil {
+			edcOpts.SetComment(args.Comment)
+		}
+		return coll.EstimatedDocumentCount(ctx, edcOpts)
+	}
+
+	type estimateResult struct {
+		n   int64
+		err error
+	}
+	var estimateCh chan estimateResult
+	includeEstimate := args.IncludeEstimate != nil && *args.IncludeEstimate
+	if includeEstimate && sessionFromContext(ctx) == nil {
+		estimateCh = make(chan estimateResult, 1)
+		go func() {
+			n, err := estimate()
+			estimateCh <- estimateResult{n: n, err: err}
+		}()
+	}
+
+	summary, err := coll.exactCountSummary(ctx, filter, args)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case estimateCh != nil:
+		res := <-estimateCh
+		if res.err != nil {
+			return nil, res.err
+		}
+		summary.Estimated = res.n
+	case includeEstimate:
+		summary.Estimated, err = estimate()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return summary, nil
+}
+
+// exactCountSummary runs an aggregation that counts the documents matching filter and reports the server time.
+func (coll *Collection) exactCountSummary(
+	ctx context.Context,
+	filter interface{},
+	args *options.CountSummaryOptions,
+) (*CountSummary, error) {
+	filterDoc, err := marshal(filter, coll.bsonOpts, coll.registry)
+	if err != nil {
+		return nil, err
+	}
+
+	// $facet always produces exactly one document, even if no documents match the filter.
+	pipeline := bson.A{
+		bson.D{{"$match", bson.Raw(filterDoc)}},
+		bson.D{{"$facet", bson.D{{"count", bson.A{bson.D{{"$count", "n"}}}}}}},
+		bson.D{{"$project", bson.D{
+			{"n", bson.D{{"$ifNull", bson.A{bson.D{{"$arrayElemAt", bson.A{"$count.n", 0}}}, 0}}}},
+			{"now", "$$NOW"},
+		}}},
+	}
+
+	aggOpts := options.Aggregate()
+	if args.Collation != nil {
+		aggOpts.SetCollation(args.Collation)
+	}
+	if args.Comment != nil {
+		aggOpts.SetComment(args.Comment)
+	}
+	if args.Hint != nil {
+		aggOpts.SetHint(args.Hint)
+	}
+
+	cursor, err := coll.Aggregate(ctx, pipeline, aggOpts)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = cursor.Close(ctx) }()
+
+	if !cursor.Next(ctx) {
+		if err := cursor.Err(); err != nil {
+			return nil, err
+		}
+		return nil, errors.New("invalid response from server, no count summary document")
+	}
+
+	n, ok := cursor.Current.Lookup("n").AsInt64OK()
+	if !ok {
+		return nil, errors.New("invalid response from server, no 'n' field")
+	}
+	now, ok := cursor.Current.Lookup("now").DateTimeOK()
+	if !ok {
+		return nil, errors.New("invalid response from server, no 'now' field")
+	}
+
+	return &CountSummary{
+		Exact:    n,
+		StoredAt: bson.DateTime(now).Time(),
+	}, nil
+}
+
 // Distinct executes a distinct command to find the unique values for a specified field in the collection.
 //
 // The fieldName parameter specifies the field name for which distinct values should be returned.
@@ -1243,7 +1943,7 @@ func (coll *Collection) Distinct(
 
 	sess := sessionFromContext(ctx)
 
-	if sess == nil && coll.client.sessionPool != nil {
+	if sess == nil && coll.client.sessionPool != nil && !implicitSessionDisabled(ctx) {
 		sess = session.NewImplicitClientSession(coll.client.sessionPool, coll.client.id)
 		defer sess.EndSession()
 	}
@@ -1258,7 +1958,8 @@ func (coll *Collection) Distinct(
 		rc = nil
 	}
 
-	selector := makeReadPrefSelector(sess, coll.readSelector, coll.client.localThreshold)
+	rp, readSelector := coll.readPrefForContext(ctx)
+	selector := makeReadPrefSelector(sess, readSelector, coll.client.localThreshold)
 
 	args, err := mongoutil.NewOptions[options.DistinctOptions](opts...)
 	if err != nil {
@@ -1270,9 +1971,9 @@ func (coll *Collection) Distinct(
 	op := operation.NewDistinct(fieldName, f).
 		Session(sess).ClusterClock(coll.client.clock).
 		Database(coll.db.name).Collection(coll.name).CommandMonitor(coll.client.monitor).
-		Deployment(coll.client.deployment).ReadConcern(rc).ReadPreference(coll.readPreference).
+		Deployment(coll.client.deployment).ReadConcern(rc).ReadPreference(rp).
 		ServerSelector(selector).Crypt(coll.client.cryptFLE).ServerAPI(coll.client.serverAPI).
-		Timeout(coll.client.timeout).Authenticator(coll.client.authenticator)
+		Timeout(coll.client.timeout).MaxTime(coll.maxTime).Authenticator(coll.client.authenticator)
 
 	if args.Collation != nil {
 		op.Collation(bsoncore.Document(toDocument(args.Collation)))
@@ -1300,7 +2001,7 @@ func (coll *Collection) Distinct(
 	}
 	op = op.Retry(retry)
 
-	err = op.Execute(ctx)
+	err = op.Execute(coll.withConcernSources(ctx))
 	if err != nil {
 		return &DistinctResult{err: replaceErrors(err)}
 	}
@@ -1338,13 +2039,75 @@ func (coll *Collection) Find(ctx context.Context, filter interface{},
 	// prevent confusing "cursor not found" errors.
 	//
 	// See DRIVERS-2722 for more detail.
-	return coll.find(ctx, filter, true, args)
+	return coll.find(ctx, filter, true, "", args)
+}
+
+// ExplainFind runs an explain command with the given verbosity for the find command that Find would execute with the
+// same filter and options, and returns the explain output. If verbosity is empty, QueryPlanner is used.
+//
+// See ExplainAggregate for more information about the explain output.
+func (coll *Collection) ExplainFind(
+	ctx context.Context,
+	filter interface{},
+	verbosity ExplainVerbosity,
+	opts ...options.Lister[options.FindOptions],
+) *SingleResult {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	args, err := mongoutil.NewOptions(opts...)
+	if err != nil {
+		return &SingleResult{err: err}
+	}
+	if verbosity == "" {
+		verbosity = QueryPlanner
+	}
+
+	cursor, err := coll.find(ctx, filter, true, verbosity, args)
+	return coll.explainResult(ctx, cursor, err)
+}
+
+// FindAll executes a find command and decodes all of the matching documents into results. The results parameter must
+// be a pointer to a slice (see Cursor.All). The cursor created by the find command is always closed before FindAll
+// returns, even if decoding fails or the context is cancelled.
+//
+// The filter and opts parameters are the same as for Find. If either the find command or iterating the cursor fails,
+// the error will be returned.
+func (coll *Collection) FindAll(ctx context.Context, filter interface{}, results interface{},
+	opts ...options.Lister[options.FindOptions]) error {
+	cursor, err := coll.Find(ctx, filter, opts...)
+	if err != nil {
+		return err
+	}
+	// Cursor.All returns without closing the cursor if results is not a pointer to a slice. Use
+	// context.Background() to ensure Close completes even if ctx has errored.
+	defer cursor.Close(context.Background())
+
+	return cursor.All(ctx, results)
+}
+
+// FindAll executes a find command against coll and decodes all of the matching documents into a slice of T. If no
+// documents match, an empty, non-nil slice is returned, as with CollectAll. The cursor created by the find command is
+// always closed before FindAll returns.
+//
+// The filter and opts parameters are the same as for Collection.Find. If either the find command or iterating the
+// cursor fails, the error will be returned.
+func FindAll[T any](ctx context.Context, coll *Collection, filter interface{},
+	opts ...options.Lister[options.FindOptions]) ([]T, error) {
+	results := []T{}
+	if err := coll.FindAll(ctx, filter, &results, opts...); err != nil {
+		return nil, err
+	}
+
+	return results, nil
 }
 
 func (coll *Collection) find(
 	ctx context.Context,
 	filter interface{},
 	omitMaxTimeMS bool,
+	explain ExplainVerbosity,
 	args *options.FindOptions,
 ) (cur *Cursor, err error) {
 
@@ -1364,7 +2127,7 @@ func (coll *Collection) find(
 			closeImplicitSession(sess)
 		}
 	}()
-	if sess == nil && coll.client.sessionPool != nil {
+	if sess == nil && coll.client.sessionPool != nil && !implicitSessionDisabled(ctx) {
 		sess = session.NewImplicitClientSession(coll.client.sessionPool, coll.client.id)
 	}
 
@@ -1378,14 +2141,15 @@ func (coll *Collection) find(
 		rc = nil
 	}
 
-	selector := makeReadPrefSelector(sess, coll.readSelector, coll.client.localThreshold)
+	rp, readSelector := coll.readPrefForContext(ctx)
+	selector := makeReadPrefSelector(sess, readSelector, coll.client.localThreshold)
 	op := operation.NewFind(f).
-		Session(sess).ReadConcern(rc).ReadPreference(coll.readPreference).
+		Session(sess).ReadConcern(rc).ReadPreference(rp).
 		CommandMonitor(coll.client.monitor).ServerSelector(selector).
 		ClusterClock(coll.client.clock).Database(coll.db.name).Collection(coll.name).
 		Deployment(coll.client.deployment).Crypt(coll.client.cryptFLE).ServerAPI(coll.client.serverAPI).
 		Timeout(coll.client.timeout).Logger(coll.client.logger).Authenticator(coll.client.authenticator).
-		OmitMaxTimeMS(omitMaxTimeMS)
+		OmitMaxTimeMS(omitMaxTimeMS).MaxTime(coll.maxTime).Explain(string(explain))
 
 	cursorOpts := coll.client.createBaseCursorOptions()
 
@@ -1503,19 +2267,31 @@ func (coll *Collection) find(
 	}
 	op = op.Retry(retry)
 
-	if err = op.Execute(ctx); err != nil {
+	if err = op.Execute(coll.withConcernSources(ctx)); err != nil {
 		return nil, replaceErrors(err)
 	}
 
+	if explain != "" {
+		closeImplicitSession(sess)
+		sess = nil
+		return NewCursorFromDocuments([]interface{}{bson.Raw(op.ExplainResult())}, nil, coll.registry)
+	}
+
 	bc, err := op.Result(cursorOpts)
 	if err != nil {
 		return nil, replaceErrors(err)
 	}
-	return newCursorWithSession(bc, coll.bsonOpts, coll.registry, sess)
+	cursor, err := newCursorWithSession(bc, coll.bsonOpts, coll.registry, sess)
+	coll.client.cursorReaper.track(cursor, coll.db.name+"."+coll.name)
+	return cursor, err
 }
 
 func newFindArgsFromFindOneArgs(args *options.FindOneOptions) *options.FindOptions {
 	var limit int64 = -1
+	if args != nil && args.AssertSingleMatch != nil && *args.AssertSingleMatch {
+		// Request a second document so that multiple matches can be detected.
+		limit = -2
+	}
 	v := &options.FindOptions{Limit: &limit}
 	if args != nil {
 		v.AllowPartialResults = args.AllowPartialResults
@@ -1538,7 +2314,9 @@ func newFindArgsFromFindOneArgs(args *options.FindOneOptions) *options.FindOptio
 //
 // The filter parameter must be a document containing query operators and can be used to select the document to be
 // returned. It cannot be nil. If the filter does not match any documents, a SingleResult with an error set to
-// ErrNoDocuments will be returned. If the filter matches multiple documents, one will be selected from the matched set.
+// ErrNoDocuments will be returned. If the filter matches multiple documents, one will be selected from the matched set
+// unless the AssertSingleMatch option is set, in which case a SingleResult with an error set to ErrMultipleDocuments
+// will be returned.
 //
 // The opts parameter can be used to specify options for this operation (see the options.FindOneOptions documentation).
 //
@@ -1554,14 +2332,34 @@ func (coll *Collection) FindOne(ctx context.Context, filter interface{},
 	if err != nil {
 		return &SingleResult{err: err}
 	}
-	cursor, err := coll.find(ctx, filter, false, newFindArgsFromFindOneArgs(args))
-	return &SingleResult{
+	cursor, err := coll.find(ctx, filter, false, "", newFindArgsFromFindOneArgs(args))
+	sr := &SingleResult{
 		ctx:      ctx,
 		cur:      cursor,
 		bsonOpts: coll.bsonOpts,
 		reg:      coll.registry,
 		err:      replaceErrors(err),
 	}
+	if args.AssertSingleMatch != nil && *args.AssertSingleMatch {
+		// The cursor must be drained up front to know whether more than one document matched.
+		sr.err = sr.setSingleMatchRdrContents()
+	}
+	return sr
+}
+
+// FindOne executes a find command against coll and decodes the first matching document into a value of type T.
+//
+// The filter and opts parameters are the same as for Collection.FindOne. If the filter does not match any
+// documents, the zero value of T and ErrNoDocuments will be returned.
+func FindOne[T any](ctx context.Context, coll *Collection, filter interface{},
+	opts ...options.Lister[options.FindOneOptions]) (T, error) {
+	var result T
+	if err := coll.FindOne(ctx, filter, opts...).Decode(&result); err != nil {
+		var zero T
+		return zero, err
+	}
+
+	return result, nil
 }
 
 func (coll *Collection) findAndModify(ctx context.Context, op *operation.FindAndModify) *SingleResult {
@@ -1571,7 +2369,7 @@ func (coll *Collection) findAndModify(ctx context.Context, op *operation.FindAnd
 
 	sess := sessionFromContext(ctx)
 	var err error
-	if sess == nil && coll.client.sessionPool != nil {
+	if sess == nil && coll.client.sessionPool != nil && !implicitSessionDisabled(ctx) {
 		sess = session.NewImplicitClientSession(coll.client.sessionPool, coll.client.id)
 		defer sess.EndSession()
 	}
@@ -1605,20 +2403,25 @@ func (coll *Collection) findAndModify(ctx context.Context, op *operation.FindAnd
 		Collection(coll.name).
 		Deployment(coll.client.deployment).
 		Retry(retry).
-		Crypt(coll.client.cryptFLE)
+		Crypt(coll.client.cryptFLE).
+		MaxTime(coll.maxTime)
 
-	rr, err := processWriteError(op.Execute(ctx))
+	rr, err := processWriteError(op.Execute(coll.withConcernSources(ctx)))
 	if err != nil {
 		return &SingleResult{err: err}
 	}
 
-	return &SingleResult{
+	sr := &SingleResult{
 		ctx:          ctx,
 		rdr:          bson.Raw(op.Result().Value),
 		bsonOpts:     coll.bsonOpts,
 		reg:          coll.registry,
 		Acknowledged: rr.isAcknowledged(),
 	}
+	if coll.client.retainRawResponses {
+		sr.rawResponse = bson.Raw(op.Result().Raw)
+	}
+	return sr
 }
 
 // FindOneAndDelete executes a findAndModify command to delete at most one document in the collection. and returns the
@@ -1721,12 +2524,12 @@ func (coll *Collection) FindOneAndReplace(
 	if err != nil {
 		return &SingleResult{err: err}
 	}
-	r, err := marshal(replacement, coll.bsonOpts, coll.registry)
+	r, err := marshalWithContext(ctx, replacement, coll.bsonOpts, coll.registry)
 	if err != nil {
 		return &SingleResult{err: err}
 	}
-	if firstElem, err := r.IndexErr(0); err == nil && strings.HasPrefix(firstElem.Key(), "$") {
-		return &SingleResult{err: errors.New("replacement document cannot contain keys beginning with '$'")}
+	if err := ensureNoDollarKey(r); err != nil {
+		return &SingleResult{err: err}
 	}
 
 	args, err := mongoutil.NewOptions[options.FindOneAndReplaceOptions](opts...)
@@ -1928,6 +2731,7 @@ func (coll *Collection) Watch(ctx context.Context, pipeline interface{},
 		collectionName: coll.Name(),
 		databaseName:   coll.db.Name(),
 		crypt:          coll.client.cryptFLE,
+		concernSources: coll.concernSources,
 	}
 	return newChangeStream(ctx, csConfig, pipeline, opts...)
 }
@@ -2012,7 +2816,7 @@ func (coll *Collection) drop(ctx context.Context) error {
 	}
 
 	sess := sessionFromContext(ctx)
-	if sess == nil && coll.client.sessionPool != nil {
+	if sess == nil && coll.client.sessionPool != nil && !implicitSessionDisabled(ctx) {
 		sess = session.NewImplicitClientSession(coll.client.sessionPool, coll.client.id)
 		defer sess.EndSession()
 	}
@@ -2039,7 +2843,7 @@ func (coll *Collection) drop(ctx context.Context) error {
 		Deployment(coll.client.deployment).Crypt(coll.client.cryptFLE).
 		ServerAPI(coll.client.serverAPI).Timeout(coll.client.timeout).
 		Authenticator(coll.client.authenticator)
-	err = op.Execute(ctx)
+	err = op.Execute(coll.withConcernSources(ctx))
 
 	// ignore namespace not found errors
 	var driverErr driver.Error
@@ -2129,6 +2933,22 @@ func makePinnedSelector(sess *session.Client, fallback description.ServerSelecto
 	return pss
 }
 
+// readPrefForContext returns the read preference and server selector to use for a read operation run with ctx. A read
+// preference stored in ctx using WithReadPreference takes precedence over the Collection's read preference.
+func (coll *Collection) readPrefForContext(ctx context.Context) (*readpref.ReadPref, description.ServerSelector) {
+	rp := readPrefFromContext(ctx)
+	if rp == nil {
+		return coll.readPreference, coll.readSelector
+	}
+
+	return rp, &serverselector.Composite{
+		Selectors: []description.ServerSelector{
+			&serverselector.ReadPref{ReadPref: rp},
+			&serverselector.Latency{Latency: coll.client.localThreshold},
+		},
+	}
+}
+
 func makeReadPrefSelector(
 	sess *session.Client,
 	selector description.ServerSelector,
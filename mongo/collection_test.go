@@ -10,17 +10,26 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
+	"io"
+	"runtime"
+	"sync"
 	"testing"
+	"time"
 
 	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/event"
 	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/logger"
 	"go.mongodb.org/mongo-driver/v2/internal/ptrutil"
 	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/internal/serverselector"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 	"go.mongodb.org/mongo-driver/v2/mongo/readconcern"
 	"go.mongodb.org/mongo-driver/v2/mongo/readpref"
 	"go.mongodb.org/mongo-driver/v2/mongo/writeconcern"
 	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/drivertest"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/topology"
 )
 
@@ -230,6 +239,209 @@ func TestCollection(t *testing.T) {
 	})
 }
 
+func TestCollection_ConcernAccessors(t *testing.T) {
+	clientRC, dbRC, collRC := readconcern.Local(), readconcern.Majority(), readconcern.Snapshot()
+	clientWC, dbWC, collWC := &writeconcern.WriteConcern{W: 1}, &writeconcern.WriteConcern{W: 2}, writeconcern.Majority()
+	clientRP, dbRP, collRP := readpref.Primary(), readpref.Secondary(), readpref.Nearest()
+
+	for _, setClient := range []bool{false, true} {
+		for _, setDB := range []bool{false, true} {
+			for _, setColl := range []bool{false, true} {
+				name := fmt.Sprintf("client=%v/database=%v/collection=%v", setClient, setDB, setColl)
+				t.Run(name, func(t *testing.T) {
+					clientOpts := options.Client().ApplyURI("mongodb://localhost:27017")
+					// Default client values when nothing is overridden.
+					wantRC, wantWC, wantRP := &readconcern.ReadConcern{}, (*writeconcern.WriteConcern)(nil), readpref.Primary()
+					if setClient {
+						clientOpts.SetReadConcern(clientRC).SetWriteConcern(clientWC).SetReadPreference(clientRP)
+						wantRC, wantWC, wantRP = clientRC, clientWC, clientRP
+					}
+					client, err := Connect(clientOpts)
+					require.NoError(t, err, "Connect error")
+					defer func() { _ = client.Disconnect(context.Background()) }()
+
+					dbOpts := options.Database()
+					if setDB {
+						dbOpts.SetReadConcern(dbRC).SetWriteConcern(dbWC).SetReadPreference(dbRP)
+						wantRC, wantWC, wantRP = dbRC, dbWC, dbRP
+					}
+					db := client.Database("foo", dbOpts)
+
+					assert.Equal(t, wantRC, db.ReadConcern(), "expected database read concern %v, got %v", wantRC, db.ReadConcern())
+					assert.Equal(t, wantWC, db.WriteConcern(), "expected database write concern %v, got %v", wantWC, db.WriteConcern())
+					assert.Equal(t, wantRP, db.ReadPreference(), "expected database read preference %v, got %v", wantRP, db.ReadPreference())
+
+					collOpts := options.Collection()
+					if setColl {
+						collOpts.SetReadConcern(collRC).SetWriteConcern(collWC).SetReadPreference(collRP)
+						wantRC, wantWC, wantRP = collRC, collWC, collRP
+					}
+					coll := db.Collection("bar", collOpts)
+
+					assert.Equal(t, wantRC, coll.ReadConcern(), "expected collection read concern %v, got %v", wantRC, coll.ReadConcern())
+					assert.Equal(t, wantWC, coll.WriteConcern(), "expected collection write concern %v, got %v", wantWC, coll.WriteConcern())
+					assert.Equal(t, wantRP, coll.ReadPreference(), "expected collection read preference %v, got %v", wantRP, coll.ReadPreference())
+				})
+			}
+		}
+	}
+
+	t.Run("modifying returned values has no effect", func(t *testing.T) {
+		coll := setupColl("foo", options.Collection().
+			SetReadConcern(readconcern.Majority()).
+			SetWriteConcern(writeconcern.Majority()))
+
+		coll.ReadConcern().Level = "local"
+		coll.WriteConcern().W = 0
+
+		assert.Equal(t, readconcern.Majority(), coll.ReadConcern(), "expected read concern to be unchanged, got %v", coll.ReadConcern())
+		assert.Equal(t, writeconcern.Majority(), coll.WriteConcern(), "expected write concern to be unchanged, got %v", coll.WriteConcern())
+	})
+}
+
+func TestCollection_IDGenerator(t *testing.T) {
+	mc := newMockClient(t, options.Client().SetIDGenerator(func() interface{} { return "custom-id" }))
+
+	coll := mc.Database(testDbName).Collection("coll")
+
+	t.Run("InsertOne", func(t *testing.T) {
+		mc.md.AddResponses(bson.D{{"ok", 1}, {"n", 1}})
+
+		res, err := coll.InsertOne(context.Background(), bson.D{{"x", 1}})
+		require.NoError(t, err, "InsertOne error")
+		assert.Equal(t, "custom-id", res.InsertedID, "expected InsertedID %v, got %v", "custom-id", res.InsertedID)
+	})
+	t.Run("InsertOne with existing _id", func(t *testing.T) {
+		mc.md.AddResponses(bson.D{{"ok", 1}, {"n", 1}})
+
+		res, err := coll.InsertOne(context.Background(), bson.D{{"_id", "existing"}, {"x", 1}})
+		require.NoError(t, err, "InsertOne error")
+		assert.Equal(t, "existing", res.InsertedID, "expected InsertedID %v, got %v", "existing", res.InsertedID)
+	})
+}
+
+// docSource returns a function that yields documents with _id values 0 through n-1 followed by io.EOF. The number of
+// times the function has been called is stored in calls.
+func docSource(n int, calls *int) func() (interface{}, error) {
+	return func() (interface{}, error) {
+		*calls++
+		if *calls > n {
+			return nil, io.EOF
+		}
+		return bson.D{{"_id", *calls - 1}}, nil
+	}
+}
+
+func TestCollection_InsertManyFromFunc(t *testing.T) {
+	mc := newMockClient(t, nil)
+
+	coll := mc.Database(testDbName).Collection("coll")
+
+	setBatchCount := func(t *testing.T, n int) {
+		t.Helper()
+
+		orig := insertManyFromFuncMaxBatchCount
+		insertManyFromFuncMaxBatchCount = n
+		t.Cleanup(func() { insertManyFromFuncMaxBatchCount = orig })
+	}
+	duplicateKeyErr := bson.D{{"index", 1}, {"code", 11000}, {"errmsg", "duplicate key"}}
+
+	t.Run("inserts all documents", func(t *testing.T) {
+		setBatchCount(t, 2)
+		mc.md.ClearResponses()
+		mc.md.AddResponses(bson.D{{"ok", 1}, {"n", 2}}, bson.D{{"ok", 1}, {"n", 1}})
+
+		var calls int
+		var progress []int
+		res, err := coll.InsertManyFromFunc(context.Background(), docSource(3, &calls),
+			options.InsertMany().SetProgress(func(n int) { progress = append(progress, n) }))
+		require.NoError(t, err, "InsertManyFromFunc error")
+
+		assert.Equal(t, []interface{}{int32(0), int32(1), int32(2)}, res.InsertedIDs,
+			"expected InsertedIDs to match")
+		assert.True(t, res.Acknowledged, "expected result to be acknowledged")
+		assert.Equal(t, []int{2, 3}, progress, "expected progress to be reported after each batch")
+	})
+	t.Run("no documents", func(t *testing.T) {
+		var calls int
+		_, err := coll.InsertManyFromFunc(context.Background(), docSource(0, &calls))
+		assert.ErrorIs(t, err, ErrEmptySlice)
+	})
+	t.Run("next error stops pulling", func(t *testing.T) {
+		mc.md.ClearResponses()
+		mc.md.AddResponses(bson.D{{"ok", 1}, {"n", 1}})
+
+		nextErr := errors.New("next error")
+		var calls int
+		res, err := coll.InsertManyFromFunc(context.Background(), func() (interface{}, error) {
+			calls++
+			if calls > 1 {
+				return nil, nextErr
+			}
+			return bson.D{{"_id", 0}}, nil
+		})
+		assert.ErrorIs(t, err, nextErr)
+		assert.Equal(t, []interface{}{int32(0)}, res.InsertedIDs, "expected pulled documents to be inserted")
+		assert.Equal(t, 2, calls, "expected next to be called 2 times, got %d", calls)
+	})
+	t.Run("ordered write error stops pulling", func(t *testing.T) {
+		setBatchCount(t, 2)
+		mc.md.ClearResponses()
+		mc.md.AddResponses(bson.D{{"ok", 1}, {"n", 1}, {"writeErrors", bson.A{duplicateKeyErr}}})
+
+		var calls int
+		res, err := coll.InsertManyFromFunc(context.Background(), docSource(4, &calls))
+
+		var bwe BulkWriteException
+		require.True(t, errors.As(err, &bwe), "expected BulkWriteException, got %v", err)
+		require.Equal(t, 1, len(bwe.WriteErrors), "expected 1 write error, got %d", len(bwe.WriteErrors))
+		assert.Equal(t, 1, bwe.WriteErrors[0].Index, "expected write error index 1, got %d", bwe.WriteErrors[0].Index)
+		assert.Equal(t, []interface{}{int32(0)}, res.InsertedIDs, "expected InsertedIDs to match")
+		assert.Equal(t, 2, calls, "expected next to be called 2 times, got %d", calls)
+	})
+	t.Run("unordered write error continues", func(t *testing.T) {
+		setBatchCount(t, 2)
+		mc.md.ClearResponses()
+		mc.md.AddResponses(
+			bson.D{{"ok", 1}, {"n", 1}, {"writeErrors", bson.A{duplicateKeyErr}}},
+			bson.D{{"ok", 1}, {"n", 1}, {"writeErrors", bson.A{duplicateKeyErr}}},
+		)
+
+		var calls int
+		res, err := coll.InsertManyFromFunc(context.Background(), docSource(4, &calls),
+			options.InsertMany().SetOrdered(false))
+
+		var bwe BulkWriteException
+		require.True(t, errors.As(err, &bwe), "expected BulkWriteException, got %v", err)
+		require.Equal(t, 2, len(bwe.WriteErrors), "expected 2 write errors, got %d", len(bwe.WriteErrors))
+		assert.Equal(t, 1, bwe.WriteErrors[0].Index, "expected write error index 1, got %d", bwe.WriteErrors[0].Index)
+		assert.Equal(t, 3, bwe.WriteErrors[1].Index, "expected write error index 3, got %d", bwe.WriteErrors[1].Index)
+		assert.Equal(t, []interface{}{int32(0), int32(2)}, res.InsertedIDs, "expected InsertedIDs to match")
+	})
+	t.Run("server limits split pulled batches", func(t *testing.T) {
+		orig := drivertest.MockDescription.MaxBatchCount
+		drivertest.MockDescription.MaxBatchCount = 2
+		defer func() { drivertest.MockDescription.MaxBatchCount = orig }()
+
+		mc.md.ClearResponses()
+		mc.md.AddResponses(
+			bson.D{{"ok", 1}, {"n", 2}},
+			bson.D{{"ok", 1}, {"n", 0}, {"writeErrors", bson.A{bson.D{{"index", 0}, {"code", 11000}, {"errmsg", "dup"}}}}},
+		)
+
+		var calls int
+		res, err := coll.InsertManyFromFunc(context.Background(), docSource(3, &calls))
+
+		// The write error is reported for the first document of the second insert command, so its index shows that
+		// the single pulled batch was split by the server's maxWriteBatchSize.
+		var bwe BulkWriteException
+		require.True(t, errors.As(err, &bwe), "expected BulkWriteException, got %v", err)
+		require.Equal(t, 1, len(bwe.WriteErrors), "expected 1 write error, got %d", len(bwe.WriteErrors))
+		assert.Equal(t, 2, bwe.WriteErrors[0].Index, "expected write error index 2, got %d", bwe.WriteErrors[0].Index)
+		assert.Equal(t, []interface{}{int32(0), int32(1)}, res.InsertedIDs, "expected InsertedIDs to match")
+	})
+}
+
 func TestCollation(t *testing.T) {
 	t.Run("TestCollationToDocument", func(t *testing.T) {
 		c := &options.Collation{
@@ -295,6 +507,15 @@ func TestNewFindArgsFromFindOneArgs(t *testing.T) {
 				Limit: ptrutil.Ptr(int64(-1)),
 			},
 		},
+		{
+			name: "assert single match",
+			args: &options.FindOneOptions{
+				AssertSingleMatch: ptrutil.Ptr(true),
+			},
+			want: &options.FindOptions{
+				Limit: ptrutil.Ptr(int64(-2)),
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -307,3 +528,1640 @@ func TestNewFindArgsFromFindOneArgs(t *testing.T) {
 		})
 	}
 }
+
+// BenchmarkInsertManyFromFunc compares the memory used by InsertMany and InsertManyFromFunc to insert 1M small
+// documents. In addition to the allocation statistics, each run reports the largest live heap size observed while
+// documents are being inserted.
+func BenchmarkInsertManyFromFunc(b *testing.B) {
+	const numDocs = 1000000
+
+	mc := newMockClient(b, nil)
+
+	coll := mc.Database(testDbName).Collection("coll")
+
+	addResponses := func() {
+		mc.md.ClearResponses()
+		for i := 0; i < numDocs/insertManyFromFuncMaxBatchCount; i++ {
+			mc.md.AddResponses(bson.D{{"ok", 1}, {"n", insertManyFromFuncMaxBatchCount}})
+		}
+	}
+	liveHeap := func() uint64 {
+		var ms runtime.MemStats
+		runtime.GC()
+		runtime.ReadMemStats(&ms)
+		return ms.HeapAlloc
+	}
+
+	b.Run("InsertMany", func(b *testing.B) {
+		b.ReportAllocs()
+		var peak uint64
+		for i := 0; i < b.N; i++ {
+			addResponses()
+
+			docs := make([]interface{}, 0, numDocs)
+			for j := 0; j < numDocs; j++ {
+				docs = append(docs, bson.D{{"x", j}})
+			}
+
+			b.StopTimer()
+			if h := liveHeap(); h > peak {
+				peak = h
+			}
+			b.StartTimer()
+
+			_, err := coll.InsertMany(context.Background(), docs)
+			if err != nil {
+				b.Fatalf("InsertMany error: %v", err)
+			}
+		}
+		b.ReportMetric(float64(peak), "peak-heap-B")
+	})
+	b.Run("InsertManyFromFunc", func(b *testing.B) {
+		b.ReportAllocs()
+		var peak uint64
+		progress := options.InsertMany().SetProgress(func(int) {
+			b.StopTimer()
+			if h := liveHeap(); h > peak {
+				peak = h
+			}
+			b.StartTimer()
+		})
+		for i := 0; i < b.N; i++ {
+			addResponses()
+
+			var j int
+			_, err := coll.InsertManyFromFunc(context.Background(), func() (interface{}, error) {
+				if j == numDocs {
+					return nil, io.EOF
+				}
+				j++
+				return bson.D{{"x", j}}, nil
+			}, progress)
+			if err != nil {
+				b.Fatalf("InsertManyFromFunc error: %v", err)
+			}
+		}
+		b.ReportMetric(float64(peak), "peak-heap-B")
+	})
+}
+
+func TestCollection_AggregateStream(t *testing.T) {
+	mc := newMockClient(t, nil)
+
+	coll := mc.Database(testDbName).Collection("coll")
+	ns := testDbName + ".coll"
+	pipeline := Pipeline{{{"$match", bson.D{{"x", bson.D{{"$gte", 1}}}}}}}
+
+	drain := func(docs <-chan bson.Raw, errs <-chan error) ([]bson.Raw, error) {
+		var got []bson.Raw
+		for doc := range docs {
+			got = append(got, doc)
+		}
+		return got, <-errs
+	}
+
+	t.Run("streams all documents", func(t *testing.T) {
+		mc.md.ClearResponses()
+		mc.md.AddResponses(
+			bson.D{{"ok", 1}, {"cursor", bson.D{
+				{"id", int64(1)},
+				{"ns", ns},
+				{"firstBatch", bson.A{bson.D{{"x", 1}}, bson.D{{"x", 2}}}},
+			}}},
+			bson.D{{"ok", 1}, {"cursor", bson.D{
+				{"id", int64(0)},
+				{"ns", ns},
+				{"nextBatch", bson.A{bson.D{{"x", 3}}}},
+			}}},
+		)
+
+		docs, errs := coll.AggregateStream(context.Background(), pipeline)
+		got, err := drain(docs, errs)
+		require.NoError(t, err, "AggregateStream error")
+
+		require.Len(t, got, 3, "expected 3 documents")
+		for i, doc := range got {
+			x := doc.Lookup("x").Int32()
+			assert.Equal(t, int32(i+1), x, "expected document %d to have x=%d, got %d", i, i+1, x)
+		}
+	})
+	t.Run("aggregate error", func(t *testing.T) {
+		mc.md.ClearResponses()
+		mc.md.AddResponses(bson.D{{"ok", 0}, {"code", 17}, {"errmsg", "bad pipeline"}})
+
+		docs, errs := coll.AggregateStream(context.Background(), pipeline)
+		got, err := drain(docs, errs)
+
+		assert.Len(t, got, 0, "expected no documents, got %v", got)
+		var ce CommandError
+		require.True(t, errors.As(err, &ce), "expected CommandError, got %v", err)
+		assert.Equal(t, int32(17), ce.Code, "expected error code 17, got %d", ce.Code)
+	})
+	t.Run("cursor error", func(t *testing.T) {
+		mc.md.ClearResponses()
+		mc.md.AddResponses(
+			bson.D{{"ok", 1}, {"cursor", bson.D{
+				{"id", int64(1)},
+				{"ns", ns},
+				{"firstBatch", bson.A{bson.D{{"x", 1}}}},
+			}}},
+			bson.D{{"ok", 0}, {"code", 43}, {"errmsg", "cursor not found"}},
+		)
+
+		docs, errs := coll.AggregateStream(context.Background(), pipeline)
+		got, err := drain(docs, errs)
+
+		assert.Len(t, got, 1, "expected 1 document before the error")
+		var ce CommandError
+		require.True(t, errors.As(err, &ce), "expected CommandError, got %v", err)
+		assert.Equal(t, int32(43), ce.Code, "expected error code 43, got %d", ce.Code)
+	})
+	t.Run("context cancelled", func(t *testing.T) {
+		mc.md.ClearResponses()
+		mc.md.AddResponses(
+			bson.D{{"ok", 1}, {"cursor", bson.D{
+				{"id", int64(0)},
+				{"ns", ns},
+				{"firstBatch", bson.A{bson.D{{"x", 1}}, bson.D{{"x", 2}}}},
+			}}},
+		)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		docs, errs := coll.AggregateStream(ctx, pipeline)
+
+		<-docs
+		cancel()
+
+		// Stop receiving documents so the goroutine can only observe the cancellation.
+		err := <-errs
+		assert.ErrorIs(t, err, context.Canceled)
+
+		_, ok := <-docs
+		assert.False(t, ok, "expected document channel to be closed")
+	})
+}
+
+func TestCollection_AggregateMaxResults(t *testing.T) {
+	mc := newMockClient(t, nil)
+
+	coll := mc.Database(testDbName).Collection("coll")
+	ns := testDbName + ".coll"
+	pipeline := Pipeline{
+		{{"$match", bson.D{{"x", bson.D{{"$gte", 1}}}}}},
+		{{"$sort", bson.D{{"x", 1}}}},
+	}
+
+	t.Run("appends $limit stage", func(t *testing.T) {
+		mc.started = nil
+		mc.md.ClearResponses()
+		mc.md.AddResponses(bson.D{{"ok", 1}, {"cursor", bson.D{
+			{"id", int64(0)},
+			{"ns", ns},
+			{"firstBatch", bson.A{bson.D{{"x", 1}}, bson.D{{"x", 2}}}},
+		}}})
+
+		cursor, err := coll.Aggregate(context.Background(), pipeline, options.Aggregate().SetMaxResults(2))
+		require.NoError(t, err, "Aggregate error")
+
+		var got []bson.Raw
+		err = cursor.All(context.Background(), &got)
+		require.NoError(t, err, "All error")
+		assert.Len(t, got, 2, "expected 2 documents, got %d", len(got))
+
+		require.Len(t, mc.started, 1, "expected 1 started event, got %d", len(mc.started))
+		stages, err := mc.started[0].Command.Lookup("pipeline").Array().Values()
+		require.NoError(t, err, "Values error")
+		require.Len(t, stages, 3, "expected 3 pipeline stages, got %d", len(stages))
+
+		first := stages[0].Document().Index(0).Key()
+		assert.Equal(t, "$match", first, "expected first stage to be $match, got %v", first)
+		limit := stages[2].Document().Lookup("$limit").Int64()
+		assert.Equal(t, int64(2), limit, "expected $limit of 2, got %d", limit)
+	})
+	t.Run("pipeline unmodified by default", func(t *testing.T) {
+		mc.started = nil
+		mc.md.ClearResponses()
+		mc.md.AddResponses(bson.D{{"ok", 1}, {"cursor", bson.D{
+			{"id", int64(0)},
+			{"ns", ns},
+			{"firstBatch", bson.A{}},
+		}}})
+
+		_, err := coll.Aggregate(context.Background(), pipeline)
+		require.NoError(t, err, "Aggregate error")
+
+		require.Len(t, mc.started, 1, "expected 1 started event, got %d", len(mc.started))
+		stages, err := mc.started[0].Command.Lookup("pipeline").Array().Values()
+		require.NoError(t, err, "Values error")
+		assert.Len(t, stages, 2, "expected 2 pipeline stages, got %d", len(stages))
+	})
+	t.Run("invalid", func(t *testing.T) {
+		testCases := []struct {
+			name     string
+			pipeline interface{}
+			max      int64
+		}{
+			{"zero", pipeline, 0},
+			{"negative", pipeline, -1},
+			{"$out stage", Pipeline{{{"$out", "other"}}}, 2},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				mc.started = nil
+				mc.md.ClearResponses()
+
+				_, err := coll.Aggregate(context.Background(), tc.pipeline, options.Aggregate().SetMaxResults(tc.max))
+				assert.Error(t, err, "expected Aggregate error")
+				assert.Len(t, mc.started, 0, "expected no commands to be sent, got %d", len(mc.started))
+			})
+		}
+	})
+}
+
+func TestCollection_ReplaceOneValidateID(t *testing.T) {
+	mc := newMockClient(t, nil)
+
+	coll := mc.Database(testDbName).Collection("coll")
+	filter := bson.D{{"_id", 1}}
+
+	testCases := []struct {
+		name        string
+		replacement bson.D
+		opts        *options.ReplaceOptionsBuilder
+		wantErr     bool
+	}{
+		{"matching _id", bson.D{{"_id", 1}, {"x", 1}}, options.Replace().SetValidateID(true), false},
+		{"mismatched _id", bson.D{{"_id", 2}, {"x", 1}}, options.Replace().SetValidateID(true), true},
+		{"absent _id", bson.D{{"x", 1}}, options.Replace().SetValidateID(true), false},
+		{"mismatched _id without validation", bson.D{{"_id", 2}, {"x", 1}}, options.Replace(), false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mc.started = nil
+			mc.md.ClearResponses()
+			mc.md.AddResponses(bson.D{{"ok", 1}, {"n", 1}, {"nModified", 1}})
+
+			_, err := coll.ReplaceOne(context.Background(), filter, tc.replacement, tc.opts)
+			if tc.wantErr {
+				assert.Error(t, err, "expected ReplaceOne error")
+				assert.Len(t, mc.started, 0, "expected no commands to be sent, got %d", len(mc.started))
+				return
+			}
+			require.NoError(t, err, "ReplaceOne error")
+			assert.Len(t, mc.started, 1, "expected 1 started event, got %d", len(mc.started))
+		})
+	}
+}
+
+func TestCollection_CountSummary(t *testing.T) {
+	var mu sync.Mutex
+	started := map[string]*event.CommandStartedEvent{}
+	clientOpts := options.Client().SetMonitor(&event.CommandMonitor{
+		Started: func(_ context.Context, evt *event.CommandStartedEvent) {
+			mu.Lock()
+			defer mu.Unlock()
+			started[evt.CommandName] = evt
+		},
+	})
+
+	mc := newMockClient(t, clientOpts)
+
+	coll := mc.Database(testDbName).Collection("coll")
+	now := time.Date(2026, time.October, 16, 12, 0, 0, 0, time.UTC)
+
+	reply := bson.D{
+		{"ok", 1},
+		{"cursor", bson.D{
+			{"id", int64(0)},
+			{"ns", testDbName + ".coll"},
+			{"firstBatch", bson.A{bson.D{{"n", int32(3)}, {"now", bson.NewDateTimeFromTime(now)}}}},
+		}},
+	}
+
+	t.Run("exact only", func(t *testing.T) {
+		started = map[string]*event.CommandStartedEvent{}
+		mc.md.ClearResponses()
+		mc.md.AddResponses(reply)
+
+		filter := bson.D{{"x", 1}}
+		opts := options.CountSummary().SetComment("summary").SetHint("x_1")
+		summary, err := coll.CountSummary(context.Background(), filter, opts)
+		require.NoError(t, err, "CountSummary error")
+
+		assert.Equal(t, int64(3), summary.Exact, "expected exact count %v, got %v", 3, summary.Exact)
+		assert.Equal(t, int64(0), summary.Estimated, "expected no estimate, got %v", summary.Estimated)
+		assert.True(t, now.Equal(summary.StoredAt), "expected stored at %v, got %v", now, summary.StoredAt)
+
+		require.Len(t, started, 1, "expected 1 started event, got %d", len(started))
+		agg, ok := started["aggregate"]
+		require.True(t, ok, "expected an aggregate command")
+
+		want, err := bson.Marshal(filter)
+		require.NoError(t, err, "Marshal error")
+		match := bson.Raw(agg.Command.Lookup("pipeline", "0", "$match").Document())
+		assert.Equal(t, bson.Raw(want), match, "expected $match %v, got %v", bson.Raw(want), match)
+		comment := agg.Command.Lookup("comment").StringValue()
+		assert.Equal(t, "summary", comment, "expected comment %q, got %q", "summary", comment)
+		hint := agg.Command.Lookup("hint").StringValue()
+		assert.Equal(t, "x_1", hint, "expected hint %q, got %q", "x_1", hint)
+	})
+	t.Run("include estimate", func(t *testing.T) {
+		started = map[string]*event.CommandStartedEvent{}
+		mc.md.ClearResponses()
+		mc.md.AddResponses(reply, bson.D{{"ok", 1}, {"n", int64(100)}})
+
+		// The operations run one after the other when a session is in use, so the replies are consumed in order.
+		sess, err := mc.StartSession()
+		require.NoError(t, err, "StartSession error")
+		defer sess.EndSession(context.Background())
+		ctx := NewSessionContext(context.Background(), sess)
+
+		opts := options.CountSummary().SetIncludeEstimate(true).SetComment("summary")
+		summary, err := coll.CountSummary(ctx, bson.D{}, opts)
+		require.NoError(t, err, "CountSummary error")
+
+		assert.Equal(t, int64(3), summary.Exact, "expected exact count %v, got %v", 3, summary.Exact)
+		assert.Equal(t, int64(100), summary.Estimated, "expected estimate %v, got %v", 100, summary.Estimated)
+
+		require.Len(t, started, 2, "expected 2 started events, got %d", len(started))
+		count, ok := started["count"]
+		require.True(t, ok, "expected a count command")
+		comment := count.Command.Lookup("comment").StringValue()
+		assert.Equal(t, "summary", comment, "expected comment %q, got %q", "summary", comment)
+	})
+	t.Run("nil filter", func(t *testing.T) {
+		_, err := coll.CountSummary(context.Background(), nil)
+		assert.ErrorIs(t, err, ErrNilDocument, "expected error %v, got %v", ErrNilDocument, err)
+	})
+}
+
+func TestCollection_FindAllInvalidResults(t *testing.T) {
+	mc := newMockClient(t, nil)
+
+	mc.md.AddResponses(
+		bson.D{{"ok", 1}, {"cursor", bson.D{
+			{"id", int64(123)},
+			{"ns", testDbName + ".coll"},
+			{"firstBatch", bson.A{bson.D{{"x", 1}}}},
+		}}},
+		bson.D{{"ok", 1}, {"cursorsKilled", bson.A{int64(123)}}},
+	)
+
+	var results []bson.D
+	err := mc.Database(testDbName).Collection("coll").FindAll(context.Background(), bson.D{}, results)
+	assert.Error(t, err, "expected error for non-pointer results")
+	assert.Equal(t, []string{"find", "killCursors"}, mc.commandNames(), "expected the cursor to be killed")
+}
+
+func TestFindAll_NoResults(t *testing.T) {
+	mc := newMockClient(t, nil)
+
+	mc.md.AddResponses(bson.D{{"ok", 1}, {"cursor", bson.D{
+		{"id", int64(0)},
+		{"ns", testDbName + ".coll"},
+		{"firstBatch", bson.A{}},
+	}}})
+
+	got, err := FindAll[bson.D](context.Background(), mc.Database(testDbName).Collection("coll"), bson.D{})
+	require.NoError(t, err, "FindAll error")
+	assert.NotNil(t, got, "expected an empty slice, got nil")
+	assert.Len(t, got, 0, "expected no results, got %v", got)
+}
+
+func TestCollection_FindOneAssertSingleMatch(t *testing.T) {
+	mc := newMockClient(t, nil)
+
+	coll := mc.Database(testDbName).Collection("coll")
+	findReply := func(docs ...interface{}) bson.D {
+		return bson.D{{"ok", 1}, {"cursor", bson.D{
+			{"id", int64(0)},
+			{"ns", testDbName + ".coll"},
+			{"firstBatch", append(bson.A{}, docs...)},
+		}}}
+	}
+	opts := options.FindOne().SetAssertSingleMatch(true)
+
+	t.Run("multiple matches", func(t *testing.T) {
+		mc.started = nil
+		mc.md.ClearResponses()
+		mc.md.AddResponses(findReply(bson.D{{"_id", 1}, {"x", 1}}, bson.D{{"_id", 2}, {"x", 1}}))
+
+		res := coll.FindOne(context.Background(), bson.D{{"x", 1}}, opts)
+		assert.ErrorIs(t, res.Err(), ErrMultipleDocuments, "expected error %v, got %v", ErrMultipleDocuments, res.Err())
+
+		var got bson.D
+		err := res.Decode(&got)
+		assert.ErrorIs(t, err, ErrMultipleDocuments, "expected error %v, got %v", ErrMultipleDocuments, err)
+
+		require.Len(t, mc.started, 1, "expected 1 started event, got %d", len(mc.started))
+		limit := mc.started[0].Command.Lookup("limit").Int64()
+		assert.Equal(t, int64(2), limit, "expected limit 2, got %d", limit)
+		singleBatch := mc.started[0].Command.Lookup("singleBatch").Boolean()
+		assert.True(t, singleBatch, "expected singleBatch to be true")
+	})
+	t.Run("single match", func(t *testing.T) {
+		mc.md.ClearResponses()
+		mc.md.AddResponses(findReply(bson.D{{"_id", 1}, {"x", 1}}))
+
+		var got struct {
+			ID int32 `bson:"_id"`
+		}
+		err := coll.FindOne(context.Background(), bson.D{{"x", 1}}, opts).Decode(&got)
+		require.NoError(t, err, "Decode error")
+		assert.Equal(t, int32(1), got.ID, "expected _id 1, got %v", got.ID)
+	})
+	t.Run("no matches", func(t *testing.T) {
+		mc.md.ClearResponses()
+		mc.md.AddResponses(findReply())
+
+		err := coll.FindOne(context.Background(), bson.D{{"x", 1}}, opts).Err()
+		assert.ErrorIs(t, err, ErrNoDocuments, "expected error %v, got %v", ErrNoDocuments, err)
+	})
+}
+
+func TestCollection_DefaultMaxTime(t *testing.T) {
+	countReply := bson.D{{"ok", 1}, {"n", int64(1)}}
+
+	testCases := []struct {
+		name          string
+		clientTimeout *time.Duration
+		dbMaxTime     *time.Duration
+		collMaxTime   *time.Duration
+		cloneMaxTime  *time.Duration
+		ctxTimeout    time.Duration
+		// wantMin and wantMax bound the expected maxTimeMS. A zero wantMax means that maxTimeMS
+		// must be omitted.
+		wantMin, wantMax int64
+	}{
+		{
+			name: "no defaults",
+		},
+		{
+			name:      "database default",
+			dbMaxTime: ptrutil.Ptr(3 * time.Second),
+			wantMin:   3000, wantMax: 3000,
+		},
+		{
+			name:        "collection default overrides database default",
+			dbMaxTime:   ptrutil.Ptr(3 * time.Second),
+			collMaxTime: ptrutil.Ptr(2 * time.Second),
+			wantMin:     2000, wantMax: 2000,
+		},
+		{
+			name:         "cloned collection default overrides collection default",
+			collMaxTime:  ptrutil.Ptr(2 * time.Second),
+			cloneMaxTime: ptrutil.Ptr(time.Second),
+			wantMin:      1000, wantMax: 1000,
+		},
+		{
+			name:        "context deadline overrides collection default",
+			collMaxTime: ptrutil.Ptr(2 * time.Second),
+			ctxTimeout:  time.Minute,
+			wantMin:     30000, wantMax: 60000,
+		},
+		{
+			name:          "client timeout ignores collection default",
+			clientTimeout: ptrutil.Ptr(time.Minute),
+			collMaxTime:   ptrutil.Ptr(2 * time.Second),
+			wantMin:       30000, wantMax: 60000,
+		},
+		{
+			name:          "zero client timeout ignores database default",
+			clientTimeout: ptrutil.Ptr(time.Duration(0)),
+			dbMaxTime:     ptrutil.Ptr(3 * time.Second),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			clientOpts := options.Client()
+			if tc.clientTimeout != nil {
+				clientOpts.SetTimeout(*tc.clientTimeout)
+			}
+
+			mc := newMockClient(t, clientOpts)
+			mc.md.AddResponses(countReply)
+
+			dbOpts := options.Database()
+			if tc.dbMaxTime != nil {
+				dbOpts.SetDefaultMaxTime(*tc.dbMaxTime)
+			}
+			collOpts := options.Collection()
+			if tc.collMaxTime != nil {
+				collOpts.SetDefaultMaxTime(*tc.collMaxTime)
+			}
+			coll := mc.Database(testDbName, dbOpts).Collection("coll", collOpts)
+			if tc.cloneMaxTime != nil {
+				coll = coll.Clone(options.Collection().SetDefaultMaxTime(*tc.cloneMaxTime))
+			}
+
+			ctx := context.Background()
+			if tc.ctxTimeout != 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tc.ctxTimeout)
+				defer cancel()
+			}
+
+			_, err := coll.EstimatedDocumentCount(ctx)
+			require.NoError(t, err, "EstimatedDocumentCount error")
+			require.Len(t, mc.started, 1, "expected 1 started event, got %d", len(mc.started))
+
+			val, err := mc.started[0].Command.LookupErr("maxTimeMS")
+			if tc.wantMax == 0 {
+				assert.Error(t, err, "expected maxTimeMS to be omitted, got %v", val)
+				return
+			}
+			require.NoError(t, err, "expected maxTimeMS to be set")
+			got := val.Int64()
+			assert.GreaterOrEqual(t, got, tc.wantMin, "expected maxTimeMS >= %d, got %d", tc.wantMin, got)
+			assert.LessOrEqual(t, got, tc.wantMax, "expected maxTimeMS <= %d, got %d", tc.wantMax, got)
+		})
+	}
+}
+
+func TestCollection_ReadPrefForContext(t *testing.T) {
+	client, err := Connect(options.Client().ApplyURI("mongodb://localhost:27017"))
+	require.NoError(t, err, "Connect error")
+
+	defaultColl := client.Database(testDbName).Collection("coll")
+	secondaryColl := client.Database(testDbName).Collection("coll",
+		options.Collection().SetReadPreference(readpref.Secondary()))
+
+	testCases := []struct {
+		name string
+		coll *Collection
+		ctx  context.Context
+		want *readpref.ReadPref
+	}{
+		{"collection default", defaultColl, context.Background(), readpref.Primary()},
+		{"collection read preference", secondaryColl, context.Background(), readpref.Secondary()},
+		{"context overrides collection default", defaultColl,
+			WithReadPreference(context.Background(), readpref.Nearest()), readpref.Nearest()},
+		{"context overrides collection read preference", secondaryColl,
+			WithReadPreference(context.Background(), readpref.Nearest()), readpref.Nearest()},
+		{"nil context read preference", secondaryColl,
+			WithReadPreference(context.Background(), nil), readpref.Secondary()},
+		{"nil context", secondaryColl, nil, readpref.Secondary()},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rp, selector := tc.coll.readPrefForContext(tc.ctx)
+			assert.Equal(t, tc.want, rp, "expected read preference %v, got %v", tc.want, rp)
+
+			composite, ok := selector.(*serverselector.Composite)
+			require.True(t, ok, "expected selector of type %T, got %T", composite, selector)
+			rpSelector, ok := composite.Selectors[0].(*serverselector.ReadPref)
+			require.True(t, ok, "expected first selector to be a read preference selector, got %T", composite.Selectors[0])
+			assert.Equal(t, tc.want, rpSelector.ReadPref,
+				"expected selector read preference %v, got %v", tc.want, rpSelector.ReadPref)
+		})
+	}
+}
+
+func TestCollection_InsertManyInsertedIDs(t *testing.T) {
+	mc := newMockClient(t, nil)
+
+	coll := mc.Database(testDbName).Collection("coll")
+
+	t.Run("preserves types", func(t *testing.T) {
+		mc.md.ClearResponses()
+		mc.md.AddResponses(bson.D{{"ok", 1}, {"n", 4}})
+
+		docs := []interface{}{
+			bson.D{{"x", 1}},
+			bson.D{{"_id", int32(5)}},
+			bson.D{{"_id", int64(7)}},
+			bson.D{{"_id", "str"}},
+		}
+		res, err := coll.InsertMany(context.Background(), docs)
+		require.NoError(t, err, "InsertMany error")
+		require.Len(t, res.InsertedIDs, 4, "expected 4 inserted IDs")
+		require.Len(t, res.InsertedIDValues, 4, "expected 4 inserted ID values")
+
+		oid, ok := res.InsertedIDs[0].(bson.ObjectID)
+		require.True(t, ok, "expected generated ID to be a bson.ObjectID, got %T", res.InsertedIDs[0])
+		assert.Equal(t, oid, res.InsertedIDValues[0].ObjectID(), "expected raw ID to match generated ID")
+
+		testCases := []struct {
+			idx   int
+			value interface{}
+			typ   bson.Type
+		}{
+			{0, oid, bson.TypeObjectID},
+			{1, int32(5), bson.TypeInt32},
+			{2, int64(7), bson.TypeInt64},
+			{3, "str", bson.TypeString},
+		}
+		for _, tc := range testCases {
+			value, typ, err := res.InsertedIDAt(tc.idx)
+			require.NoError(t, err, "InsertedIDAt error")
+			assert.Equal(t, tc.value, value, "expected ID %v (%T), got %v (%T)", tc.value, tc.value, value, value)
+			assert.Equal(t, tc.typ, typ, "expected type %v, got %v", tc.typ, typ)
+			assert.Equal(t, tc.typ, res.InsertedIDValues[tc.idx].Type, "expected raw type %v, got %v",
+				tc.typ, res.InsertedIDValues[tc.idx].Type)
+		}
+
+		_, _, err = res.InsertedIDAt(4)
+		assert.NotNil(t, err, "expected InsertedIDAt error for out of range index, got nil")
+		_, _, err = res.InsertedIDAt(-1)
+		assert.NotNil(t, err, "expected InsertedIDAt error for negative index, got nil")
+	})
+	t.Run("write errors", func(t *testing.T) {
+		mc.md.ClearResponses()
+		mc.md.AddResponses(bson.D{{"ok", 1}, {"n", 2}, {"writeErrors", bson.A{
+			bson.D{{"index", 1}, {"code", 11000}, {"errmsg", "duplicate key"}},
+		}}})
+
+		docs := []interface{}{
+			bson.D{{"_id", int64(1)}},
+			bson.D{{"_id", int64(2)}},
+			bson.D{{"_id", int32(3)}},
+		}
+		res, err := coll.InsertMany(context.Background(), docs, options.InsertMany().SetOrdered(false))
+		assert.True(t, IsDuplicateKeyError(err), "expected duplicate key error, got %v", err)
+
+		assert.Equal(t, []interface{}{int64(1), int32(3)}, res.InsertedIDs, "expected InsertedIDs to match")
+		require.Len(t, res.InsertedIDValues, 2, "expected 2 inserted ID values")
+		assert.Equal(t, bson.TypeInt64, res.InsertedIDValues[0].Type, "expected first raw ID to be int64")
+		assert.Equal(t, bson.TypeInt32, res.InsertedIDValues[1].Type, "expected second raw ID to be int32")
+	})
+}
+
+func TestFindOneGeneric(t *testing.T) {
+	mc := newMockClient(t, nil)
+
+	coll := mc.Database(testDbName).Collection("coll")
+	ns := testDbName + ".coll"
+	findReply := func(docs ...interface{}) bson.D {
+		return bson.D{{"ok", 1}, {"cursor", bson.D{
+			{"id", int64(0)},
+			{"ns", ns},
+			{"firstBatch", append(bson.A{}, docs...)},
+		}}}
+	}
+
+	t.Run("struct", func(t *testing.T) {
+		type doc struct {
+			X int32 `bson:"x"`
+		}
+
+		mc.md.ClearResponses()
+		mc.md.AddResponses(findReply(bson.D{{"x", int32(1)}}))
+
+		got, err := FindOne[doc](context.Background(), coll, bson.D{})
+		require.NoError(t, err, "FindOne error")
+		assert.Equal(t, doc{X: 1}, got, "expected result %v, got %v", doc{X: 1}, got)
+	})
+	t.Run("map", func(t *testing.T) {
+		mc.md.ClearResponses()
+		mc.md.AddResponses(findReply(bson.D{{"x", int32(1)}}))
+
+		got, err := FindOne[map[string]interface{}](context.Background(), coll, bson.D{})
+		require.NoError(t, err, "FindOne error")
+		want := map[string]interface{}{"x": int32(1)}
+		assert.Equal(t, want, got, "expected result %v, got %v", want, got)
+	})
+	t.Run("bson.Raw", func(t *testing.T) {
+		mc.md.ClearResponses()
+		mc.md.AddResponses(findReply(bson.D{{"x", int32(1)}}))
+
+		got, err := FindOne[bson.Raw](context.Background(), coll, bson.D{})
+		require.NoError(t, err, "FindOne error")
+		assert.Equal(t, int32(1), got.Lookup("x").Int32(), "expected x to be 1, got %v", got.Lookup("x"))
+	})
+	t.Run("no documents", func(t *testing.T) {
+		mc.md.ClearResponses()
+		mc.md.AddResponses(findReply())
+
+		got, err := FindOne[bson.Raw](context.Background(), coll, bson.D{})
+		assert.Equal(t, ErrNoDocuments, err, "expected error %v, got %v", ErrNoDocuments, err)
+		assert.Nil(t, got, "expected nil result, got %v", got)
+	})
+}
+
+func TestCollection_BulkWriteInsertedIDs(t *testing.T) {
+	mc := newMockClient(t, nil)
+
+	coll := mc.Database(testDbName).Collection("coll")
+
+	t.Run("mixed models", func(t *testing.T) {
+		mc.md.ClearResponses()
+		mc.md.AddResponses(
+			bson.D{{"ok", 1}, {"n", 1}},
+			bson.D{{"ok", 1}, {"n", 1}, {"nModified", 1}},
+			bson.D{{"ok", 1}, {"n", 1}},
+		)
+
+		models := []WriteModel{
+			NewInsertOneModel().SetDocument(bson.D{{"_id", "a"}}),
+			NewUpdateOneModel().SetFilter(bson.D{{"_id", 1}}).SetUpdate(bson.D{{"$inc", bson.D{{"x", 1}}}}),
+			NewInsertOneModel().SetDocument(bson.D{{"x", 2}}),
+		}
+		res, err := coll.BulkWrite(context.Background(), models)
+		require.NoError(t, err, "BulkWrite error")
+
+		require.Len(t, res.InsertedIDs, 2, "expected 2 inserted IDs")
+		assert.Equal(t, "a", res.InsertedIDs[0], "expected inserted ID %v, got %v", "a", res.InsertedIDs[0])
+		_, ok := res.InsertedIDs[2].(bson.ObjectID)
+		assert.True(t, ok, "expected generated ID to be an ObjectID, got %T", res.InsertedIDs[2])
+	})
+	t.Run("unordered write error", func(t *testing.T) {
+		mc.md.ClearResponses()
+		mc.md.AddResponses(bson.D{
+			{"ok", 1},
+			{"n", 2},
+			{"writeErrors", bson.A{bson.D{{"index", 1}, {"code", 11000}, {"errmsg", "duplicate key"}}}},
+		})
+
+		models := []WriteModel{
+			NewInsertOneModel().SetDocument(bson.D{{"_id", "a"}}),
+			NewInsertOneModel().SetDocument(bson.D{{"_id", "a"}}),
+			NewInsertOneModel().SetDocument(bson.D{{"_id", "b"}}),
+		}
+		res, err := coll.BulkWrite(context.Background(), models, options.BulkWrite().SetOrdered(false))
+		var bwe BulkWriteException
+		require.True(t, errors.As(err, &bwe), "expected BulkWriteException, got %v", err)
+
+		want := map[int64]interface{}{0: "a", 2: "b"}
+		assert.Equal(t, want, res.InsertedIDs, "expected inserted IDs %v, got %v", want, res.InsertedIDs)
+	})
+}
+
+func TestCollection_UpdateShorthandFields(t *testing.T) {
+	mc := newMockClient(t, nil)
+
+	coll := mc.Database(testDbName).Collection("coll")
+	filter := bson.D{{"_id", 1}}
+
+	testCases := []struct {
+		name string
+		opts *options.UpdateOneOptionsBuilder
+		want bson.D
+	}{
+		{
+			name: "set",
+			opts: options.UpdateOne().SetSetFields(map[string]interface{}{"b": 2, "a": "x"}),
+			want: bson.D{{"$set", bson.D{{"a", "x"}, {"b", 2}}}},
+		},
+		{
+			name: "unset",
+			opts: options.UpdateOne().SetUnsetFields([]string{"a", "b"}),
+			want: bson.D{{"$unset", bson.D{{"a", ""}, {"b", ""}}}},
+		},
+		{
+			name: "push",
+			opts: options.UpdateOne().SetPushFields(map[string]interface{}{"tags": "new"}),
+			want: bson.D{{"$push", bson.D{{"tags", "new"}}}},
+		},
+		{
+			name: "pull",
+			opts: options.UpdateOne().SetPullFields(map[string]interface{}{"tags": "old"}),
+			want: bson.D{{"$pull", bson.D{{"tags", "old"}}}},
+		},
+		{
+			name: "inc",
+			opts: options.UpdateOne().SetIncFields(map[string]int64{"count": 1}),
+			want: bson.D{{"$inc", bson.D{{"count", int64(1)}}}},
+		},
+		{
+			name: "combined",
+			opts: options.UpdateOne().
+				SetIncFields(map[string]int64{"count": -1}).
+				SetPullFields(map[string]interface{}{"tags": "old"}).
+				SetPushFields(map[string]interface{}{"tags": "new"}).
+				SetUnsetFields([]string{"tmp"}).
+				SetSetFields(map[string]interface{}{"name": "alice"}),
+			want: bson.D{
+				{"$set", bson.D{{"name", "alice"}}},
+				{"$unset", bson.D{{"tmp", ""}}},
+				{"$push", bson.D{{"tags", "new"}}},
+				{"$pull", bson.D{{"tags", "old"}}},
+				{"$inc", bson.D{{"count", int64(-1)}}},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mc.started = nil
+			mc.md.ClearResponses()
+			mc.md.AddResponses(bson.D{{"ok", 1}, {"n", 1}, {"nModified", 1}})
+
+			_, err := coll.UpdateOne(context.Background(), filter, nil, tc.opts)
+			require.NoError(t, err, "UpdateOne error")
+			require.Len(t, mc.started, 1, "expected 1 started event, got %d", len(mc.started))
+
+			want, err := bson.Marshal(tc.want)
+			require.NoError(t, err, "Marshal error")
+			got := bson.Raw(mc.started[0].Command.Lookup("updates", "0", "u").Document())
+			assert.Equal(t, bson.Raw(want), got, "expected update %v, got %v", bson.Raw(want), got)
+		})
+	}
+
+	t.Run("update many", func(t *testing.T) {
+		mc.started = nil
+		mc.md.ClearResponses()
+		mc.md.AddResponses(bson.D{{"ok", 1}, {"n", 2}, {"nModified", 2}})
+
+		opts := options.UpdateMany().SetSetFields(map[string]interface{}{"a": 1})
+		_, err := coll.UpdateMany(context.Background(), bson.D{}, nil, opts)
+		require.NoError(t, err, "UpdateMany error")
+		require.Len(t, mc.started, 1, "expected 1 started event, got %d", len(mc.started))
+
+		update := mc.started[0].Command.Lookup("updates", "0")
+		want, err := bson.Marshal(bson.D{{"$set", bson.D{{"a", 1}}}})
+		require.NoError(t, err, "Marshal error")
+		got := bson.Raw(update.Document().Lookup("u").Document())
+		assert.Equal(t, bson.Raw(want), got, "expected update %v, got %v", bson.Raw(want), got)
+		assert.True(t, update.Document().Lookup("multi").Boolean(), "expected multi to be true")
+	})
+	t.Run("conflicting update spec", func(t *testing.T) {
+		mc.started = nil
+
+		update := bson.D{{"$set", bson.D{{"a", 1}}}}
+		_, err := coll.UpdateOne(context.Background(), filter, update, options.UpdateOne().SetUnsetFields([]string{"b"}))
+		assert.ErrorIs(t, err, ErrConflictingUpdateSpec, "expected error %v, got %v", ErrConflictingUpdateSpec, err)
+
+		_, err = coll.UpdateMany(context.Background(), filter, update, options.UpdateMany().SetIncFields(map[string]int64{"c": 1}))
+		assert.ErrorIs(t, err, ErrConflictingUpdateSpec, "expected error %v, got %v", ErrConflictingUpdateSpec, err)
+		assert.Len(t, mc.started, 0, "expected no commands to be sent, got %d", len(mc.started))
+	})
+}
+
+func TestCollection_SkipDollarKeyValidation(t *testing.T) {
+	mc := newMockClient(t, nil)
+
+	coll := mc.Database(testDbName).Collection("coll")
+	filter := bson.D{{"_id", 1}}
+	update := bson.D{{"x", 1}}
+
+	testCases := []struct {
+		name   string
+		update func(skip ...bool) error
+	}{
+		{
+			name: "update one",
+			update: func(skip ...bool) error {
+				opts := options.UpdateOne()
+				if len(skip) > 0 {
+					opts.SetSkipDollarKeyValidation(skip[0])
+				}
+				_, err := coll.UpdateOne(context.Background(), filter, update, opts)
+				return err
+			},
+		},
+		{
+			name: "update many",
+			update: func(skip ...bool) error {
+				opts := options.UpdateMany()
+				if len(skip) > 0 {
+					opts.SetSkipDollarKeyValidation(skip[0])
+				}
+				_, err := coll.UpdateMany(context.Background(), filter, update, opts)
+				return err
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Run("enforced by default", func(t *testing.T) {
+				mc.started = nil
+
+				err := tc.update()
+				assert.ErrorContains(t, err, "update document must contain key beginning with '$'")
+				assert.Len(t, mc.started, 0, "expected no commands to be sent, got %d", len(mc.started))
+			})
+			t.Run("enforced when false", func(t *testing.T) {
+				mc.started = nil
+
+				err := tc.update(false)
+				assert.ErrorContains(t, err, "update document must contain key beginning with '$'")
+				assert.Len(t, mc.started, 0, "expected no commands to be sent, got %d", len(mc.started))
+			})
+			t.Run("skipped when true", func(t *testing.T) {
+				mc.started = nil
+				mc.md.ClearResponses()
+				mc.md.AddResponses(bson.D{{"ok", 1}, {"n", 1}, {"nModified", 1}})
+
+				err := tc.update(true)
+				require.NoError(t, err, "update error")
+				require.Len(t, mc.started, 1, "expected 1 started event, got %d", len(mc.started))
+
+				want, err := bson.Marshal(update)
+				require.NoError(t, err, "Marshal error")
+				got := bson.Raw(mc.started[0].Command.Lookup("updates", "0", "u").Document())
+				assert.Equal(t, bson.Raw(want), got, "expected update %v, got %v", bson.Raw(want), got)
+			})
+		})
+	}
+}
+
+func TestCollection_DeleteLet(t *testing.T) {
+	mc := newMockClient(t, nil)
+
+	coll := mc.Database(testDbName).Collection("coll")
+	filter := bson.D{{"$expr", bson.D{{"$eq", bson.A{"$x", "$$target"}}}}}
+	let := bson.D{{"target", 3}}
+
+	want, err := bson.Marshal(let)
+	require.NoError(t, err, "Marshal error")
+
+	testCases := []struct {
+		name    string
+		execute func() error
+	}{
+		{
+			name: "delete one",
+			execute: func() error {
+				_, err := coll.DeleteOne(context.Background(), filter, options.DeleteOne().SetLet(let))
+				return err
+			},
+		},
+		{
+			name: "delete many",
+			execute: func() error {
+				_, err := coll.DeleteMany(context.Background(), filter, options.DeleteMany().SetLet(let))
+				return err
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mc.started = nil
+			mc.md.ClearResponses()
+			mc.md.AddResponses(bson.D{{"ok", 1}, {"n", 1}})
+
+			require.NoError(t, tc.execute(), "delete error")
+			require.Len(t, mc.started, 1, "expected 1 started event, got %d", len(mc.started))
+
+			got, err := mc.started[0].Command.LookupErr("let")
+			require.NoError(t, err, "expected let in command %v", mc.started[0].Command)
+			assert.Equal(t, bson.Raw(want), bson.Raw(got.Document()), "expected let %v, got %v", bson.Raw(want), got)
+		})
+	}
+}
+
+func TestCollection_Explain(t *testing.T) {
+	mc := newMockClient(t, nil)
+
+	collOpts := options.Collection().SetReadConcern(readconcern.Majority())
+	coll := mc.Database(testDbName).Collection("coll", collOpts)
+	pipeline := Pipeline{{{"$match", bson.D{{"x", 1}}}}}
+	filter := bson.D{{"x", 1}}
+
+	testCases := []struct {
+		name          string
+		execute       func() *SingleResult
+		wantCommand   string
+		wantVerbosity string
+	}{
+		{
+			name: "aggregate",
+			execute: func() *SingleResult {
+				return coll.ExplainAggregate(context.Background(), pipeline, ExecutionStats)
+			},
+			wantCommand:   "aggregate",
+			wantVerbosity: "executionStats",
+		},
+		{
+			name: "aggregate default verbosity",
+			execute: func() *SingleResult {
+				return coll.ExplainAggregate(context.Background(), pipeline, "")
+			},
+			wantCommand:   "aggregate",
+			wantVerbosity: "queryPlanner",
+		},
+		{
+			name: "find",
+			execute: func() *SingleResult {
+				return coll.ExplainFind(context.Background(), filter, AllPlansExecution, options.Find().SetLimit(5))
+			},
+			wantCommand:   "find",
+			wantVerbosity: "allPlansExecution",
+		},
+		{
+			name: "count",
+			execute: func() *SingleResult {
+				return coll.ExplainCount(context.Background(), filter, QueryPlanner)
+			},
+			wantCommand:   "aggregate",
+			wantVerbosity: "queryPlanner",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mc.started = nil
+			mc.md.ClearResponses()
+			mc.md.AddResponses(bson.D{{"ok", 1}, {"queryPlanner", bson.D{{"namespace", "db.coll"}}}})
+
+			res, err := tc.execute().Raw()
+			require.NoError(t, err, "explain error")
+			_, err = res.LookupErr("queryPlanner")
+			assert.NoError(t, err, "expected queryPlanner in explain output %v", res)
+
+			require.Len(t, mc.started, 1, "expected 1 started event, got %d", len(mc.started))
+			cmd := mc.started[0].Command
+			assert.Equal(t, "explain", mc.started[0].CommandName, "expected command name explain, got %q", mc.started[0].CommandName)
+			assert.Equal(t, tc.wantVerbosity, cmd.Lookup("verbosity").StringValue(),
+				"expected verbosity %q, got %v", tc.wantVerbosity, cmd.Lookup("verbosity"))
+
+			explained := cmd.Lookup("explain").Document()
+			assert.Equal(t, tc.wantCommand, explained.Index(0).Key(),
+				"expected explained command %q, got %q", tc.wantCommand, explained.Index(0).Key())
+			assert.Equal(t, "coll", explained.Index(0).Value().StringValue(),
+				"expected collection coll, got %v", explained.Index(0).Value())
+
+			_, err = cmd.LookupErr("readConcern")
+			assert.Error(t, err, "expected no readConcern in explain command %v", cmd)
+		})
+	}
+
+	t.Run("aggregate with explain option", func(t *testing.T) {
+		mc.started = nil
+		mc.md.ClearResponses()
+		mc.md.AddResponses(bson.D{{"ok", 1}, {"stages", bson.A{}}})
+
+		cursor, err := coll.Aggregate(context.Background(), pipeline, options.Aggregate().SetExplain(true))
+		require.NoError(t, err, "Aggregate error")
+
+		var docs []bson.Raw
+		require.NoError(t, cursor.All(context.Background(), &docs), "All error")
+		require.Len(t, docs, 1, "expected 1 document, got %d", len(docs))
+		_, err = docs[0].LookupErr("stages")
+		assert.NoError(t, err, "expected stages in explain output %v", docs[0])
+
+		require.Len(t, mc.started, 1, "expected 1 started event, got %d", len(mc.started))
+		verbosity := mc.started[0].Command.Lookup("verbosity").StringValue()
+		assert.Equal(t, "queryPlanner", verbosity, "expected verbosity queryPlanner, got %q", verbosity)
+	})
+	t.Run("aggregate reply without cursor", func(t *testing.T) {
+		mc.started = nil
+		mc.md.ClearResponses()
+		mc.md.AddResponses(bson.D{{"ok", 1}, {"queryPlanner", bson.D{{"namespace", "db.coll"}}}})
+
+		opts := options.Aggregate().SetCustom(bson.M{"explain": true})
+		cursor, err := coll.Aggregate(context.Background(), pipeline, opts)
+		require.NoError(t, err, "Aggregate error")
+
+		var docs []bson.Raw
+		require.NoError(t, cursor.All(context.Background(), &docs), "All error")
+		require.Len(t, docs, 1, "expected 1 document, got %d", len(docs))
+		_, err = docs[0].LookupErr("queryPlanner")
+		assert.NoError(t, err, "expected queryPlanner in reply %v", docs[0])
+
+		require.Len(t, mc.started, 1, "expected 1 started event, got %d", len(mc.started))
+		assert.Equal(t, "aggregate", mc.started[0].CommandName, "expected command name aggregate, got %q", mc.started[0].CommandName)
+	})
+	t.Run("find error", func(t *testing.T) {
+		err := coll.ExplainFind(context.Background(), bson.D{{"x", 1}}, QueryPlanner,
+			options.Find().SetSort(bson.M{"a": 1, "b": 1})).Err()
+		assert.Equal(t, ErrMapForOrderedArgument{"sort"}, err, "expected error %v, got %v", ErrMapForOrderedArgument{"sort"}, err)
+	})
+}
+
+func TestCollection_Comment(t *testing.T) {
+	mc := newMockClient(t, nil)
+
+	coll := mc.Database(testDbName).Collection("coll")
+	ns := testDbName + ".coll"
+	cursorResponse := bson.D{{"ok", 1}, {"cursor", bson.D{{"id", int64(0)}, {"ns", ns}, {"firstBatch", bson.A{}}}}}
+
+	comments := []struct {
+		name    string
+		comment interface{}
+		want    bson.RawValue
+	}{
+		{
+			name:    "string",
+			comment: options.CommentString("find users"),
+			want:    bson.RawValue{Type: bson.TypeString, Value: bsoncore.AppendString(nil, "find users")},
+		},
+		{
+			name:    "document",
+			comment: options.CommentDocument(bson.D{{"app", "reports"}, {"requestID", 42}}),
+			want: bson.RawValue{
+				Type:  bson.TypeEmbeddedDocument,
+				Value: bsoncore.NewDocumentBuilder().AppendString("app", "reports").AppendInt32("requestID", 42).Build(),
+			},
+		},
+	}
+	operations := []struct {
+		name        string
+		commandName string
+		execute     func(comment interface{}) error
+	}{
+		{
+			name:        "find",
+			commandName: "find",
+			execute: func(comment interface{}) error {
+				_, err := coll.Find(context.Background(), bson.D{}, options.Find().SetComment(comment))
+				return err
+			},
+		},
+		{
+			name:        "find one",
+			commandName: "find",
+			execute: func(comment interface{}) error {
+				err := coll.FindOne(context.Background(), bson.D{}, options.FindOne().SetComment(comment)).Err()
+				if errors.Is(err, ErrNoDocuments) {
+					return nil
+				}
+				return err
+			},
+		},
+		{
+			name:        "count documents",
+			commandName: "aggregate",
+			execute: func(comment interface{}) error {
+				_, err := coll.CountDocuments(context.Background(), bson.D{}, options.Count().SetComment(comment))
+				return err
+			},
+		},
+	}
+	for _, op := range operations {
+		for _, tc := range comments {
+			t.Run(op.name+" "+tc.name, func(t *testing.T) {
+				mc.started = nil
+				mc.md.ClearResponses()
+				mc.md.AddResponses(cursorResponse)
+
+				require.NoError(t, op.execute(tc.comment), "%s error", op.name)
+				require.Len(t, mc.started, 1, "expected 1 started event, got %d", len(mc.started))
+				assert.Equal(t, op.commandName, mc.started[0].CommandName,
+					"expected command %q, got %q", op.commandName, mc.started[0].CommandName)
+
+				got, err := mc.started[0].Command.LookupErr("comment")
+				require.NoError(t, err, "expected comment in command %v", mc.started[0].Command)
+				assert.True(t, tc.want.Equal(got), "expected comment %v, got %v", tc.want, got)
+			})
+		}
+	}
+}
+
+// concernLogSink records the keys and values of command started log messages.
+type concernLogSink struct {
+	started []map[string]interface{}
+}
+
+func (s *concernLogSink) Info(_ int, msg string, keysAndValues ...interface{}) {
+	if msg != logger.CommandStarted {
+		return
+	}
+
+	kv := make(map[string]interface{}, len(keysAndValues)/2)
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		kv[keysAndValues[i].(string)] = keysAndValues[i+1]
+	}
+	s.started = append(s.started, kv)
+}
+
+func (s *concernLogSink) Error(error, string, ...interface{}) {}
+
+func TestCollection_ConcernSourcesLogging(t *testing.T) {
+	newClient := func(t *testing.T, sink *concernLogSink, clientOpts *options.ClientOptions) (*Client, *drivertest.MockDeployment) {
+		t.Helper()
+
+		clientOpts.SetLoggerOptions(options.Logger().
+			SetSink(sink).
+			SetComponentLevel(options.LogComponentCommand, options.LogLevelDebug))
+
+		mc := newMockClient(t, clientOpts)
+		return mc.Client, mc.md
+	}
+
+	testCases := []struct {
+		name           string
+		clientOpts     *options.ClientOptions
+		dbOpts         *options.DatabaseOptionsBuilder
+		collOpts       *options.CollectionOptionsBuilder
+		cloneOpts      *options.CollectionOptionsBuilder
+		wantRCSource   string
+		wantWCSource   string
+		wantReadLevel  string
+		wantWriteValue string
+	}{
+		{
+			name:       "defaults",
+			clientOpts: options.Client(),
+		},
+		{
+			name:           "client",
+			clientOpts:     options.Client().SetReadConcern(readconcern.Local()).SetWriteConcern(writeconcern.W1()),
+			wantRCSource:   "client",
+			wantWCSource:   "client",
+			wantReadLevel:  "local",
+			wantWriteValue: `{"w": {"$numberInt":"1"}}`,
+		},
+		{
+			name:           "database overrides client",
+			clientOpts:     options.Client().SetReadConcern(readconcern.Local()).SetWriteConcern(writeconcern.W1()),
+			dbOpts:         options.Database().SetWriteConcern(writeconcern.Majority()),
+			wantRCSource:   "client",
+			wantWCSource:   "database",
+			wantReadLevel:  "local",
+			wantWriteValue: `{"w": "majority"}`,
+		},
+		{
+			name:           "collection overrides database",
+			clientOpts:     options.Client(),
+			dbOpts:         options.Database().SetReadConcern(readconcern.Local()).SetWriteConcern(writeconcern.W1()),
+			collOpts:       options.Collection().SetReadConcern(readconcern.Majority()),
+			wantRCSource:   "collection",
+			wantWCSource:   "database",
+			wantReadLevel:  "majority",
+			wantWriteValue: `{"w": {"$numberInt":"1"}}`,
+		},
+		{
+			name:           "clone overrides client",
+			clientOpts:     options.Client().SetWriteConcern(writeconcern.W1()),
+			cloneOpts:      options.Collection().SetWriteConcern(writeconcern.Majority()),
+			wantWCSource:   "collection",
+			wantWriteValue: `{"w": "majority"}`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sink := &concernLogSink{}
+			client, md := newClient(t, sink, tc.clientOpts)
+
+			dbOpts := options.Database()
+			if tc.dbOpts != nil {
+				dbOpts = tc.dbOpts
+			}
+			collOpts := options.Collection()
+			if tc.collOpts != nil {
+				collOpts = tc.collOpts
+			}
+			coll := client.Database(testDbName, dbOpts).Collection("coll", collOpts)
+			if tc.cloneOpts != nil {
+				coll = coll.Clone(tc.cloneOpts)
+			}
+
+			md.AddResponses(
+				bson.D{{"ok", 1}, {"n", 1}},
+				bson.D{{"ok", 1}, {"cursor", bson.D{
+					{"id", int64(0)},
+					{"ns", testDbName + ".coll"},
+					{"firstBatch", bson.A{}},
+				}}},
+			)
+
+			_, err := coll.InsertOne(context.Background(), bson.D{{"x", 1}})
+			require.NoError(t, err, "InsertOne error")
+			_, err = coll.Find(context.Background(), bson.D{})
+			require.NoError(t, err, "Find error")
+
+			require.Len(t, sink.started, 2, "expected 2 command started messages, got %d", len(sink.started))
+			insert, find := sink.started[0], sink.started[1]
+
+			// A source is only logged for a concern that was sent with the command.
+			if tc.wantWriteValue == "" {
+				assert.NotContains(t, insert, logger.KeyWriteConcern, "expected no write concern to be logged")
+				assert.NotContains(t, insert, logger.KeyWriteConcernSource, "expected no write concern source to be logged")
+			} else {
+				assert.Equal(t, tc.wantWriteValue, insert[logger.KeyWriteConcern],
+					"expected write concern %q, got %v", tc.wantWriteValue, insert[logger.KeyWriteConcern])
+				assert.Equal(t, tc.wantWCSource, insert[logger.KeyWriteConcernSource],
+					"expected write concern source %q, got %v", tc.wantWCSource, insert[logger.KeyWriteConcernSource])
+			}
+			if tc.wantReadLevel == "" {
+				assert.NotContains(t, find, logger.KeyReadConcern, "expected no read concern to be logged")
+				assert.NotContains(t, find, logger.KeyReadConcernSource, "expected no read concern source to be logged")
+			} else {
+				want := fmt.Sprintf(`{"level": "%s"}`, tc.wantReadLevel)
+				assert.Equal(t, want, find[logger.KeyReadConcern],
+					"expected read concern %q, got %v", want, find[logger.KeyReadConcern])
+				assert.Equal(t, tc.wantRCSource, find[logger.KeyReadConcernSource],
+					"expected read concern source %q, got %v", tc.wantRCSource, find[logger.KeyReadConcernSource])
+			}
+		})
+	}
+
+	t.Run("transaction", func(t *testing.T) {
+		sink := &concernLogSink{}
+		client, md := newClient(t, sink, options.Client().SetWriteConcern(writeconcern.W1()))
+		coll := client.Database(testDbName).Collection("coll")
+
+		md.AddResponses(bson.D{{"ok", 1}, {"n", 1}}, bson.D{{"ok", 1}})
+
+		sess, err := client.StartSession()
+		require.NoError(t, err, "StartSession error")
+		defer sess.EndSession(context.Background())
+
+		err = sess.StartTransaction(options.Transaction().
+			SetReadConcern(readconcern.Majority()).
+			SetWriteConcern(writeconcern.Majority()))
+		require.NoError(t, err, "StartTransaction error")
+
+		ctx := NewSessionContext(context.Background(), sess)
+		_, err = coll.InsertOne(ctx, bson.D{{"x", 1}})
+		require.NoError(t, err, "InsertOne error")
+		err = sess.CommitTransaction(context.Background())
+		require.NoError(t, err, "CommitTransaction error")
+
+		// Commands run inside a transaction use the transaction's concerns regardless of the Collection's.
+		require.Len(t, sink.started, 1, "expected 1 command started message, got %d", len(sink.started))
+		insert := sink.started[0]
+		assert.Equal(t, "transaction", insert[logger.KeyReadConcernSource],
+			"expected read concern source %q, got %v", "transaction", insert[logger.KeyReadConcernSource])
+		assert.NotContains(t, insert, logger.KeyWriteConcern, "expected no write concern to be sent inside a transaction")
+		assert.NotContains(t, insert, logger.KeyWriteConcernSource, "expected no write concern source to be logged")
+	})
+	t.Run("other operations", func(t *testing.T) {
+		cursorResponse := func(ns string) bson.D {
+			return bson.D{{"ok", 1}, {"cursor", bson.D{
+				{"id", int64(0)},
+				{"ns", ns},
+				{"firstBatch", bson.A{}},
+			}}}
+		}
+
+		testCases := []struct {
+			name       string
+			clientOpts *options.ClientOptions
+			responses  []bson.D
+			run        func(*Client) error
+			key        string
+			wantSource string
+		}{
+			{
+				name:       "IndexView",
+				clientOpts: options.Client(),
+				responses:  []bson.D{{{"ok", 1}}},
+				run: func(client *Client) error {
+					coll := client.Database(testDbName).Collection("coll",
+						options.Collection().SetWriteConcern(writeconcern.Majority()))
+					_, err := coll.Indexes().CreateOne(context.Background(), IndexModel{Keys: bson.D{{"x", 1}}})
+					return err
+				},
+				key:        logger.KeyWriteConcernSource,
+				wantSource: "collection",
+			},
+			{
+				name:       "Watch",
+				clientOpts: options.Client(),
+				responses:  []bson.D{cursorResponse(testDbName + ".$cmd.aggregate")},
+				run: func(client *Client) error {
+					db := client.Database(testDbName, options.Database().SetReadConcern(readconcern.Majority()))
+					cs, err := db.Watch(context.Background(), Pipeline{})
+					if err != nil {
+						return err
+					}
+					return cs.Close(context.Background())
+				},
+				key:        logger.KeyReadConcernSource,
+				wantSource: "database",
+			},
+			{
+				name:       "GridFS",
+				clientOpts: options.Client().SetWriteConcern(writeconcern.W1()),
+				responses:  []bson.D{{{"ok", 1}, {"n", 1}}, {{"ok", 1}, {"n", 1}}},
+				run: func(client *Client) error {
+					bucket := client.Database(testDbName).GridFSBucket(
+						options.GridFSBucket().SetWriteConcern(writeconcern.Majority()))
+					return bucket.Delete(context.Background(), int32(1))
+				},
+				key:        logger.KeyWriteConcernSource,
+				wantSource: "bucket",
+			},
+			{
+				name:       "Client.BulkWrite",
+				clientOpts: options.Client().SetWriteConcern(writeconcern.W1()),
+				responses: []bson.D{{
+					{"ok", 1},
+					{"nErrors", 0},
+					{"nInserted", 1},
+					{"cursor", bson.D{{"id", int64(0)}, {"ns", "admin.$cmd.bulkWrite"}, {"firstBatch", bson.A{}}}},
+				}},
+				run: func(client *Client) error {
+					_, err := client.BulkWrite(context.Background(), []ClientBulkWrite{{
+						Database:   testDbName,
+						Collection: "coll",
+						Model:      NewClientInsertOneModel().SetDocument(bson.D{{"x", 1}}),
+					}})
+					return err
+				},
+				key:        logger.KeyWriteConcernSource,
+				wantSource: "client",
+			},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				sink := &concernLogSink{}
+				client, md := newClient(t, sink, tc.clientOpts)
+				md.AddResponses(tc.responses...)
+
+				err := tc.run(client)
+				require.NoError(t, err, "operation error")
+
+				require.NotEqual(t, 0, len(sink.started), "expected command started messages")
+				got := sink.started[0][tc.key]
+				assert.Equal(t, tc.wantSource, got, "expected %s %q, got %v", tc.key, tc.wantSource, got)
+			})
+		}
+	})
+	t.Run("nil context", func(t *testing.T) {
+		sink := &concernLogSink{}
+		client, md := newClient(t, sink, options.Client())
+		db := client.Database(testDbName, options.Database().SetReadConcern(readconcern.Majority()))
+
+		aggregateReply := func(ns string) bson.D {
+			return bson.D{{"ok", 1}, {"cursor", bson.D{{"id", int64(0)}, {"ns", ns}, {"firstBatch", bson.A{}}}}}
+		}
+		md.AddResponses(aggregateReply(testDbName+".coll"), aggregateReply(testDbName+".$cmd.aggregate"))
+
+		var nilCtx context.Context
+		_, err := db.Collection("coll").Aggregate(nilCtx, Pipeline{})
+		require.NoError(t, err, "Collection.Aggregate error")
+		_, err = db.Aggregate(nilCtx, Pipeline{})
+		require.NoError(t, err, "Database.Aggregate error")
+
+		require.Len(t, sink.started, 2, "expected 2 command started messages, got %d", len(sink.started))
+		for _, started := range sink.started {
+			assert.Equal(t, "database", started[logger.KeyReadConcernSource],
+				"expected read concern source %q, got %v", "database", started[logger.KeyReadConcernSource])
+		}
+	})
+}
+
+func TestCollection_EstimatedDocumentCountCollStats(t *testing.T) {
+	mc := newMockClient(t, nil)
+
+	coll := mc.Database(testDbName).Collection("coll",
+		options.Collection().SetReadConcern(readconcern.Majority()))
+	ns := testDbName + ".coll"
+	opts := options.EstimatedDocumentCount().SetUseCollStats(true)
+
+	t.Run("sums shard counts", func(t *testing.T) {
+		mc.md.ClearResponses()
+		mc.md.AddResponses(bson.D{{"ok", 1}, {"cursor", bson.D{
+			{"id", int64(0)},
+			{"ns", ns},
+			{"firstBatch", bson.A{bson.D{{"_id", 1}, {"n", int64(7)}}}},
+		}}})
+		mc.started = nil
+
+		count, err := coll.EstimatedDocumentCount(context.Background(), opts)
+		require.NoError(t, err, "EstimatedDocumentCount error")
+		assert.Equal(t, int64(7), count, "expected count 7, got %d", count)
+
+		require.Len(t, mc.started, 1, "expected 1 command")
+		cmd := mc.started[0].Command
+		assert.Equal(t, "aggregate", mc.started[0].CommandName, "expected aggregate command, got %q", mc.started[0].CommandName)
+
+		stages, err := cmd.Lookup("pipeline").Array().Values()
+		require.NoError(t, err, "error reading pipeline")
+		require.Len(t, stages, 2, "expected 2 stages")
+		_, err = stages[0].Document().LookupErr("$collStats", "count")
+		assert.NoError(t, err, "expected $collStats stage with count, got %v", stages[0])
+		sum, err := stages[1].Document().LookupErr("$group", "n", "$sum")
+		require.NoError(t, err, "expected $group stage summing counts, got %v", stages[1])
+		assert.Equal(t, "$count", sum.StringValue(), "expected $sum of $count, got %v", sum)
+
+		level := cmd.Lookup("readConcern", "level").StringValue()
+		assert.Equal(t, "local", level, "expected read concern level local, got %q", level)
+	})
+	t.Run("empty result", func(t *testing.T) {
+		mc.md.ClearResponses()
+		mc.md.AddResponses(bson.D{{"ok", 1}, {"cursor", bson.D{
+			{"id", int64(0)},
+			{"ns", ns},
+			{"firstBatch", bson.A{}},
+		}}})
+
+		count, err := coll.EstimatedDocumentCount(context.Background(), opts)
+		require.NoError(t, err, "EstimatedDocumentCount error")
+		assert.Equal(t, int64(0), count, "expected count 0, got %d", count)
+	})
+	t.Run("namespace not found", func(t *testing.T) {
+		mc.md.ClearResponses()
+		mc.md.AddResponses(bson.D{{"ok", 0}, {"code", 26}, {"errmsg", "ns not found"}})
+
+		count, err := coll.EstimatedDocumentCount(context.Background(), opts)
+		require.NoError(t, err, "EstimatedDocumentCount error")
+		assert.Equal(t, int64(0), count, "expected count 0, got %d", count)
+	})
+	t.Run("error", func(t *testing.T) {
+		mc.md.ClearResponses()
+		mc.md.AddResponses(bson.D{{"ok", 0}, {"code", 13}, {"errmsg", "unauthorized"}})
+
+		_, err := coll.EstimatedDocumentCount(context.Background(), opts)
+		var ce CommandError
+		require.True(t, errors.As(err, &ce), "expected CommandError, got %v", err)
+		assert.Equal(t, int32(13), ce.Code, "expected error code 13, got %d", ce.Code)
+	})
+}
+
+func TestCollection_UpdatePipeline(t *testing.T) {
+	mc := newMockClient(t, nil)
+
+	coll := mc.Database(testDbName).Collection("coll")
+	filter := bson.D{{"_id", 1}}
+	updateReply := bson.D{{"ok", 1}, {"n", 1}, {"nModified", 1}}
+	findAndModifyReply := bson.D{{"ok", 1}, {"value", bson.D{{"_id", 1}}}}
+
+	testCases := []struct {
+		name  string
+		reply bson.D
+		run   func(update interface{}) error
+		// sentUpdate returns the update that was sent in cmd.
+		sentUpdate func(cmd bson.Raw) bson.RawValue
+	}{
+		{
+			name:  "UpdateOne",
+			reply: updateReply,
+			run: func(update interface{}) error {
+				_, err := coll.UpdateOne(context.Background(), filter, update)
+				return err
+			},
+		},
+		{
+			name:  "UpdateMany",
+			reply: updateReply,
+			run: func(update interface{}) error {
+				_, err := coll.UpdateMany(context.Background(), filter, update)
+				return err
+			},
+		},
+		{
+			name:  "BulkWrite UpdateOneModel",
+			reply: updateReply,
+			run: func(update interface{}) error {
+				model := NewUpdateOneModel().SetFilter(filter).SetUpdate(update)
+				_, err := coll.BulkWrite(context.Background(), []WriteModel{model})
+				return err
+			},
+		},
+		{
+			name:  "BulkWrite UpdateManyModel",
+			reply: updateReply,
+			run: func(update interface{}) error {
+				model := NewUpdateManyModel().SetFilter(filter).SetUpdate(update)
+				_, err := coll.BulkWrite(context.Background(), []WriteModel{model})
+				return err
+			},
+		},
+		{
+			name:  "FindOneAndUpdate",
+			reply: findAndModifyReply,
+			run: func(update interface{}) error {
+				return coll.FindOneAndUpdate(context.Background(), filter, update).Err()
+			},
+			sentUpdate: func(cmd bson.Raw) bson.RawValue {
+				return cmd.Lookup("update")
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		if tc.sentUpdate == nil {
+			tc.sentUpdate = func(cmd bson.Raw) bson.RawValue {
+				return cmd.Lookup("updates", "0", "u")
+			}
+		}
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Run("pipeline", func(t *testing.T) {
+				for _, update := range []interface{}{
+					Pipeline{{{"$set", bson.D{{"x", 1}}}}, {{"$unset", "y"}}},
+					[]bson.D{{{"$set", bson.D{{"x", 1}}}}, {{"$unset", "y"}}},
+				} {
+					mc.md.ClearResponses()
+					mc.md.AddResponses(tc.reply)
+					mc.started = nil
+
+					err := tc.run(update)
+					require.NoError(t, err, "error running %T update", update)
+					require.Len(t, mc.started, 1, "expected 1 command")
+
+					sent := tc.sentUpdate(mc.started[0].Command)
+					stages, ok := sent.ArrayOK()
+					require.True(t, ok, "expected update to be sent as an array, got %v", sent.Type)
+					values, err := stages.Values()
+					require.NoError(t, err, "error reading pipeline")
+					require.Len(t, values, 2, "expected 2 stages")
+					assert.Equal(t, "$set", values[0].Document().Index(0).Key(), "expected first stage $set")
+					assert.Equal(t, "$unset", values[1].Document().Index(0).Key(), "expected second stage $unset")
+				}
+			})
+			t.Run("invalid", func(t *testing.T) {
+				invalid := []struct {
+					name    string
+					update  interface{}
+					wantErr string
+				}{
+					{"empty pipeline", Pipeline{}, "update pipeline must contain at least one stage"},
+					{"non-document stage", bson.A{bson.D{{"$set", bson.D{{"x", 1}}}}, 1}, "update pipeline stage 1 must be a document"},
+					{"multi-key stage", []bson.D{{{"$set", bson.D{{"x", 1}}}, {"$unset", "y"}}}, "must be the only field"},
+					{"plain document", bson.D{{"x", 1}}, "update document must contain key beginning with '$'"},
+				}
+				for _, inv := range invalid {
+					mc.md.ClearResponses()
+					mc.started = nil
+
+					err := tc.run(inv.update)
+					assert.ErrorContains(t, err, inv.wantErr, "unexpected error for %s", inv.name)
+					assert.Len(t, mc.started, 0, "expected no command to be sent for %s", inv.name)
+				}
+			})
+		})
+	}
+}
@@ -624,7 +624,7 @@ func ExampleWithSession() {
 	// and majority, respectively.
 	txnOpts := options.Transaction().SetReadConcern(readconcern.Majority())
 	opts := options.Session().SetDefaultTransactionOptions(txnOpts)
-	sess, err := client.StartSession(opts)
+	sess, err := client.StartSessionWithContext(context.TODO(), opts)
 	if err != nil {
 		log.Panic(err)
 	}
@@ -738,7 +738,7 @@ func ExampleClient_UseSessionWithOptions() {
 	}
 }
 
-func ExampleClient_StartSession_withTransaction() {
+func ExampleClient_StartSessionWithContext_withTransaction() {
 	// Assume client is configured with write concern majority and read
 	// preference primary.
 	var client *mongo.Client
@@ -750,7 +750,7 @@ func ExampleClient_StartSession_withTransaction() {
 	// and majority, respectively.
 	txnOpts := options.Transaction().SetReadConcern(readconcern.Majority())
 	opts := options.Session().SetDefaultTransactionOptions(txnOpts)
-	sess, err := client.StartSession(opts)
+	sess, err := client.StartSessionWithContext(context.TODO(), opts)
 	if err != nil {
 		log.Panic(err)
 	}
@@ -793,7 +793,7 @@ func ExampleNewSessionContext() {
 	var client *mongo.Client
 
 	// Create a new Session and SessionContext.
-	sess, err := client.StartSession()
+	sess, err := client.StartSessionWithContext(context.TODO())
 	if err != nil {
 		panic(err)
 	}
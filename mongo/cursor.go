@@ -13,6 +13,7 @@ import (
 	"fmt"
 	"io"
 	"reflect"
+	"runtime"
 	"time"
 
 	"go.mongodb.org/mongo-driver/v2/bson"
@@ -37,7 +38,15 @@ type Cursor struct {
 	registry      *bson.Registry
 	clientSession *session.Client
 
-	err error
+	err    error
+	closed bool
+
+	// tracked is true if the cursor reaper set a finalizer on the cursor.
+	tracked bool
+
+	// cacheResults, if non-nil, is called by All with all of the documents returned by the cursor. It is cleared by
+	// Next and TryNext, because All does not see the documents they return.
+	cacheResults func([]bson.Raw)
 }
 
 func newCursor(
@@ -145,7 +154,8 @@ func (c *Cursor) ID() int64 { return c.bc.ID() }
 // Next blocks until a document is available or an error occurs. If the context expires, the cursor's error will
 // be set to ctx.Err(). In case of an error, Next will return false.
 //
-// If Next returns false, subsequent calls will also return false.
+// If Next returns false, subsequent calls will also return false. Because Next returns false both when the cursor is
+// exhausted and when an error occurs, callers must check Err after Next returns false to tell the two apart.
 func (c *Cursor) Next(ctx context.Context) bool {
 	return c.next(ctx, false)
 }
@@ -167,8 +177,10 @@ func (c *Cursor) TryNext(ctx context.Context) bool {
 }
 
 func (c *Cursor) next(ctx context.Context, nonBlocking bool) bool {
-	// return false right away if the cursor has already errored.
-	if c.err != nil {
+	c.cacheResults = nil
+
+	// return false right away if the cursor has already errored or been closed.
+	if c.err != nil || c.closed {
 		return false
 	}
 
@@ -238,7 +250,16 @@ func getDecoder(
 	opts *options.BSONOptions,
 	reg *bson.Registry,
 ) *bson.Decoder {
-	dec := bson.NewDecoder(bson.NewDocumentReader(bytes.NewReader(data)))
+	return newDecoder(bson.NewDocumentReader(bytes.NewReader(data)), opts, reg)
+}
+
+// newDecoder returns a Decoder that reads from vr and is configured with opts and reg.
+func newDecoder(
+	vr bson.ValueReader,
+	opts *options.BSONOptions,
+	reg *bson.Registry,
+) *bson.Decoder {
+	dec := bson.NewDecoder(vr)
 
 	if opts != nil {
 		if opts.AllowTruncatingDoubles {
@@ -250,6 +271,9 @@ func getDecoder(
 		if opts.DefaultDocumentM {
 			dec.DefaultDocumentM()
 		}
+		if opts.DurationUnit > 0 {
+			dec.DurationUnit(opts.DurationUnit)
+		}
 		if opts.ObjectIDAsHexString {
 			dec.ObjectIDAsHexString()
 		}
@@ -265,6 +289,9 @@ func getDecoder(
 		if opts.ZeroStructs {
 			dec.ZeroStructs()
 		}
+		if opts.RequireAllFields {
+			dec.RequireAllFields()
+		}
 	}
 
 	if reg != nil {
@@ -282,20 +309,48 @@ func (c *Cursor) Decode(val interface{}) error {
 	return dec.Decode(val)
 }
 
-// Err returns the last error seen by the Cursor, or nil if no error has occurred.
+// Err returns the last error seen by the Cursor while iterating, or nil if no error has occurred. Once set, the error
+// is not cleared, and Next and TryNext will return false. Errors from Decode and Close are returned directly by those
+// methods and are not reported by Err.
+//
+// Err should be checked after an iteration loop ends to distinguish a failure from normal exhaustion:
+//
+//	for cursor.Next(ctx) {
+//		// Decode and process cursor.Current.
+//	}
+//	if err := cursor.Err(); err != nil {
+//		return err
+//	}
 func (c *Cursor) Err() error { return c.err }
 
-// Close closes this cursor. Next and TryNext must not be called after Close has been called. Close is idempotent. After
-// the first call, any subsequent calls will not change the state.
+// Closed returns true if Close has been called on the cursor, either directly or by a method such as All that closes
+// the cursor when it is done. An exhausted cursor that has not been closed returns false; use ID to check whether the
+// server-side cursor has been exhausted.
+func (c *Cursor) Closed() bool { return c.closed }
+
+// Close closes this cursor. Next and TryNext must not be called after Close has been called and will return false if
+// they are. Close is idempotent. After the first call, any subsequent calls will not change the state.
+//
+// Cursors should always be closed. As a best-effort safety net, if a Cursor with an open server-side cursor that does
+// not use an explicit Session is garbage collected without being closed, the Client logs a warning and kills the
+// server-side cursor in the background.
 func (c *Cursor) Close(ctx context.Context) error {
 	defer c.closeImplicitSession()
+	c.closed = true
+	if c.tracked {
+		// SetFinalizer panics if c is not the pointer the finalizer was set on, e.g. a Cursor embedded in another
+		// struct, so only clear a finalizer that the reaper set.
+		c.tracked = false
+		runtime.SetFinalizer(c, nil)
+	}
 	return replaceErrors(c.bc.Close(ctx))
 }
 
 // All iterates the cursor and decodes each document into results. The results parameter must be a pointer to a slice.
 // The slice pointed to by results will be completely overwritten. A nil slice pointer will not be modified if the cursor
 // has been closed, exhausted, or is empty. This method will close the cursor after retrieving all documents. If the
-// cursor has been iterated, any previously iterated documents will not be included in results.
+// cursor has been iterated, any previously iterated documents will not be included in results. Decoding is aborted
+// with an error wrapping ctx.Err() if ctx is done.
 //
 // This method requires driver version >= 1.1.0.
 func (c *Cursor) All(ctx context.Context, results interface{}) error {
@@ -322,9 +377,14 @@ func (c *Cursor) All(ctx context.Context, results interface{}) error {
 	// completes even if the context passed to All has errored.
 	defer c.Close(context.Background())
 
+	var cached *[]bson.Raw
+	if c.cacheResults != nil {
+		cached = new([]bson.Raw)
+	}
+
 	batch := c.batch // exhaust the current batch before iterating the batch cursor
 	for {
-		sliceVal, index, err = c.addFromBatch(sliceVal, elementType, batch, index)
+		sliceVal, index, err = c.addFromBatch(ctx, sliceVal, elementType, batch, index, cached)
 		if err != nil {
 			return err
 		}
@@ -341,19 +401,124 @@ func (c *Cursor) All(ctx context.Context, results interface{}) error {
 	}
 
 	resultsVal.Elem().Set(sliceVal.Slice(0, index))
+	if cached != nil {
+		c.cacheResults(*cached)
+	}
 	return nil
 }
 
+// ErrStopIteration can be returned by the callback passed to Cursor.ForEach or ForEachAs to stop iterating the
+// cursor early without returning an error.
+var ErrStopIteration = errors.New("mongo: stop iteration")
+
+// ForEach iterates the cursor and calls fn with each document. The document passed to fn is only valid until fn
+// returns; use bson.Raw's Clone method to retain it. If fn returns a non-nil error, iteration stops and the error is
+// returned, unless the error is ErrStopIteration, in which case ForEach returns nil. If ctx is cancelled or its
+// deadline is exceeded, iteration stops before the next call to fn and the context's error is returned. The cursor
+// is always closed before ForEach returns.
+func (c *Cursor) ForEach(ctx context.Context, fn func(bson.Raw) error) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	// Use context.Background() to ensure Close completes even if ctx has errored.
+	defer c.Close(context.Background())
+
+	for c.Next(ctx) {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := fn(c.Current); err != nil {
+			if errors.Is(err, ErrStopIteration) {
+				return nil
+			}
+			return err
+		}
+	}
+
+	return c.Err()
+}
+
+// ForEachAs iterates cursor, decodes each document into a value of type T, and calls fn with the decoded value. It
+// otherwise behaves like Cursor.ForEach.
+func ForEachAs[T any](ctx context.Context, cursor *Cursor, fn func(T) error) error {
+	return cursor.ForEach(ctx, func(bson.Raw) error {
+		var v T
+		if err := cursor.Decode(&v); err != nil {
+			return err
+		}
+		return fn(v)
+	})
+}
+
+// CollectAll iterates cursor and decodes each document into a value of type T, returning the decoded values in
+// order. If the cursor has no documents, an empty, non-nil slice is returned. The cursor is always closed before
+// CollectAll returns. If the cursor has been iterated, any previously iterated documents will not be included in the
+// returned slice.
+func CollectAll[T any](ctx context.Context, cursor *Cursor) ([]T, error) {
+	// Use context.Background() to ensure Close completes even if ctx has errored.
+	defer cursor.Close(context.Background())
+
+	results := []T{}
+	for cursor.Next(ctx) {
+		var v T
+		if err := cursor.Decode(&v); err != nil {
+			return nil, err
+		}
+		results = append(results, v)
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
 // RemainingBatchLength returns the number of documents left in the current batch. If this returns zero, the subsequent
 // call to Next or TryNext will do a network request to fetch the next batch.
 func (c *Cursor) RemainingBatchLength() int {
 	return c.batchLength
 }
 
+// RawBatch returns the documents left in the current batch, i.e. the documents that will be returned by subsequent
+// calls to Next or TryNext before another batch is fetched from the server. The length of the returned slice is equal to
+// RemainingBatchLength. RawBatch does not advance the cursor, so calling it does not affect Current or subsequent calls to
+// Next, TryNext, Decode, or All.
+//
+// The returned documents reference the cursor's internal buffer and are only valid until the next call to Next,
+// TryNext, All, or Close. Callers that need to retain the documents after that must copy them.
+func (c *Cursor) RawBatch() []bson.Raw {
+	if c.batchLength == 0 {
+		return nil
+	}
+
+	batch := c.batch
+	if batch == nil {
+		// The first batch has not been pulled up from the batch cursor yet.
+		batch = c.bc.Batch()
+	}
+	if batch == nil {
+		return nil
+	}
+
+	// Iterate a copy of the batch iterator so the cursor's position is not changed.
+	iter := *batch
+	docs := make([]bson.Raw, 0, c.batchLength)
+	for {
+		val, err := iter.Next()
+		if err != nil {
+			break
+		}
+		docs = append(docs, bson.Raw(val.Data))
+	}
+	return docs
+}
+
 // addFromBatch adds all documents from batch to sliceVal starting at the given index. It returns the new slice value,
-// the next empty index in the slice, and an error if one occurs.
-func (c *Cursor) addFromBatch(sliceVal reflect.Value, elemType reflect.Type, batch *bsoncore.Iterator,
-	index int) (reflect.Value, int, error) {
+// the next empty index in the slice, and an error if one occurs. If cached is non-nil, a copy of each document is
+// appended to it.
+func (c *Cursor) addFromBatch(ctx context.Context, sliceVal reflect.Value, elemType reflect.Type,
+	batch *bsoncore.Iterator, index int, cached *[]bson.Raw) (reflect.Value, int, error) {
 
 	docs, err := batch.Documents()
 	if err != nil {
@@ -368,8 +533,13 @@ func (c *Cursor) addFromBatch(sliceVal reflect.Value, elemType reflect.Type, bat
 			sliceVal = sliceVal.Slice(0, sliceVal.Cap())
 		}
 
+		if cached != nil {
+			*cached = append(*cached, bson.Raw(append([]byte(nil), doc...)))
+		}
+
 		currElem := sliceVal.Index(index).Addr().Interface()
 		dec := getDecoder(doc, c.bsonOpts, c.registry)
+		dec.SetContext(ctx)
 		err = dec.Decode(currElem)
 		if err != nil {
 			return sliceVal, index, err
@@ -387,6 +557,16 @@ func (c *Cursor) closeImplicitSession() {
 	}
 }
 
+// LastResponse returns the raw server response for the most recent command run
+// by the cursor: the command that created the cursor or the latest getMore. If a
+// getMore failed with a server error, the response containing the error is
+// returned. LastResponse is intended for diagnosing decoding errors and
+// responses with an unexpected shape. It returns nil unless the Client was
+// configured with options.ClientOptions.SetRetainRawResponses(true).
+func (c *Cursor) LastResponse() bson.Raw {
+	return bson.Raw(c.bc.LastResponse())
+}
+
 // SetBatchSize sets the number of documents to fetch from the database with
 // each iteration of the cursor's "Next" method. Note that some operations set
 // an initial cursor batch size, so this setting only affects subsequent
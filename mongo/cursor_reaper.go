@@ -0,0 +1,170 @@
+// Copyright (C) MongoDB, Inc. 2026-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"runtime"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/internal/logger"
+)
+
+const (
+	// cursorReaperQueueSize is the maximum number of leaked cursors waiting to be killed. Cursors that are garbage
+	// collected while the queue is full are dropped and left for the server to time out.
+	cursorReaperQueueSize = 256
+
+	// cursorReaperKillTimeout bounds each killCursors command sent for a leaked cursor.
+	cursorReaperKillTimeout = 10 * time.Second
+)
+
+// leakedCursor is a Cursor that was garbage collected without being closed.
+type leakedCursor struct {
+	cursor *Cursor
+	ns     string
+	stack  string
+}
+
+// cursorReaper kills the server-side cursors of Cursors that are garbage collected without being closed. It is a
+// best-effort safety net for forgotten Cursor.Close calls: the server would otherwise keep the cursors open until they
+// time out.
+//
+// A finalizer set by track hands each leaked Cursor to a background goroutine, which is started on first use, logs a
+// warning, and closes the Cursor. The goroutine is stopped by Client.Disconnect.
+//
+// Only cursors that use an implicit session, or no session, are tracked. Killing a cursor that is bound to an explicit
+// Session would run killCursors on that Session from the background goroutine while the application may be using it,
+// possibly inside a transaction, and Sessions are not goroutine safe.
+type cursorReaper struct {
+	logger *logger.Logger
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	mu      sync.Mutex
+	queue   chan leakedCursor
+	started bool
+	closed  bool
+}
+
+func newCursorReaper(l *logger.Logger) *cursorReaper {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &cursorReaper{
+		logger: l,
+		ctx:    ctx,
+		cancel: cancel,
+		done:   make(chan struct{}),
+		queue:  make(chan leakedCursor, cursorReaperQueueSize),
+	}
+}
+
+// track arranges for the server-side cursor of c to be killed if c is garbage collected before it is closed, unless c
+// is bound to an explicit session. ns is the namespace reported in the warning logged for a leaked cursor. The stack of
+// the caller is recorded for the warning only if debug logging is enabled for commands, because capturing it on every
+// cursor would be expensive.
+func (r *cursorReaper) track(c *Cursor, ns string) {
+	if r == nil || c == nil || c.bc.ID() == 0 {
+		return
+	}
+	if c.clientSession != nil && !c.clientSession.IsImplicit {
+		return
+	}
+
+	var stack string
+	if r.logger != nil && r.logger.LevelComponentEnabled(logger.LevelDebug, logger.ComponentCommand) {
+		stack = string(debug.Stack())
+	}
+
+	// The finalizer must not capture c, or c would never become unreachable.
+	c.tracked = true
+	runtime.SetFinalizer(c, func(c *Cursor) {
+		if c.closed || c.bc.ID() == 0 {
+			return
+		}
+		r.enqueue(leakedCursor{cursor: c, ns: ns, stack: stack})
+	})
+}
+
+// enqueue hands lc to the background goroutine without blocking, because it is called from the runtime's finalizer
+// goroutine. lc is dropped if the queue is full or the reaper has been stopped.
+func (r *cursorReaper) enqueue(lc leakedCursor) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.closed {
+		return
+	}
+	if !r.started {
+		r.started = true
+		go r.run()
+	}
+
+	select {
+	case r.queue <- lc:
+	default:
+	}
+}
+
+func (r *cursorReaper) run() {
+	defer close(r.done)
+
+	for lc := range r.queue {
+		r.kill(lc)
+	}
+}
+
+func (r *cursorReaper) kill(lc leakedCursor) {
+	if r.logger != nil {
+		keysAndValues := logger.KeyValues{
+			logger.KeyNamespace, lc.ns,
+			logger.KeyCursorID, lc.cursor.bc.ID(),
+		}
+		if lc.stack != "" {
+			keysAndValues.Add(logger.KeyStack, lc.stack)
+		}
+		r.logger.Print(logger.LevelInfo, logger.ComponentCommand, logger.CursorLeaked, keysAndValues...)
+	}
+
+	ctx, cancel := context.WithTimeout(r.ctx, cursorReaperKillTimeout)
+	defer cancel()
+
+	_ = lc.cursor.Close(ctx)
+}
+
+// stop stops accepting leaked cursors and waits for the queued ones to be killed. If ctx expires first, the remaining
+// killCursors commands are abandoned and the queued cursors are dropped.
+func (r *cursorReaper) stop(ctx context.Context) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	if r.closed {
+		r.mu.Unlock()
+		return
+	}
+	r.closed = true
+	started := r.started
+	close(r.queue)
+	r.mu.Unlock()
+
+	defer r.cancel()
+	if !started {
+		return
+	}
+
+	select {
+	case <-r.done:
+	case <-ctx.Done():
+		r.cancel()
+		<-r.done
+	}
+}
@@ -0,0 +1,219 @@
+// Copyright (C) MongoDB, Inc. 2026-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/event"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/logger"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// leakLogSink records the keys and values of leaked cursor log messages.
+type leakLogSink struct {
+	mu     sync.Mutex
+	leaked []map[string]interface{}
+}
+
+func (s *leakLogSink) Info(_ int, msg string, keysAndValues ...interface{}) {
+	if msg != logger.CursorLeaked {
+		return
+	}
+
+	kv := make(map[string]interface{}, len(keysAndValues)/2)
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		kv[keysAndValues[i].(string)] = keysAndValues[i+1]
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.leaked = append(s.leaked, kv)
+}
+
+func (s *leakLogSink) Error(error, string, ...interface{}) {}
+
+func (s *leakLogSink) messages() []map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]map[string]interface{}(nil), s.leaked...)
+}
+
+func TestCursorReaper(t *testing.T) {
+	const cursorID int64 = 42
+
+	newClient := func(t *testing.T, level options.LogLevel) (*mockClient, *leakLogSink, func() []bson.Raw) {
+		t.Helper()
+
+		var mu sync.Mutex
+		var killed []bson.Raw
+		monitor := &event.CommandMonitor{
+			Started: func(_ context.Context, evt *event.CommandStartedEvent) {
+				if evt.CommandName != "killCursors" {
+					return
+				}
+				mu.Lock()
+				defer mu.Unlock()
+				killed = append(killed, evt.Command)
+			},
+		}
+
+		sink := &leakLogSink{}
+		clientOpts := options.Client().
+			SetMonitor(monitor).
+			SetLoggerOptions(options.Logger().SetSink(sink).SetComponentLevel(options.LogComponentCommand, level))
+
+		mc := newMockClient(t, clientOpts)
+
+		return mc, sink, func() []bson.Raw {
+			mu.Lock()
+			defer mu.Unlock()
+			return append([]bson.Raw(nil), killed...)
+		}
+	}
+	// leakCursor runs a find that returns a live cursor and drops the only reference to it.
+	leakCursor := func(t *testing.T, mc *mockClient) {
+		t.Helper()
+
+		mc.md.AddResponses(
+			bson.D{{"ok", 1}, {"cursor", bson.D{
+				{"id", cursorID},
+				{"ns", testDbName + ".coll"},
+				{"firstBatch", bson.A{bson.D{{"_id", 1}}}},
+			}}},
+			bson.D{{"ok", 1}, {"cursorsKilled", bson.A{cursorID}}},
+		)
+
+		_, err := mc.Database(testDbName).Collection("coll").Find(context.Background(), bson.D{})
+		require.NoError(t, err, "Find error")
+	}
+
+	t.Run("kills leaked cursor and logs a warning", func(t *testing.T) {
+		mc, sink, killed := newClient(t, options.LogLevelDebug)
+
+		leakCursor(t, mc)
+
+		assert.Eventually(t, func() bool {
+			runtime.GC()
+			return len(killed()) == 1
+		}, 5*time.Second, 10*time.Millisecond, "expected killCursors to be sent for the leaked cursor")
+
+		got := killed()[0].Lookup("cursors").Array().Index(0).Int64()
+		assert.Equal(t, cursorID, got, "expected killed cursor ID %d, got %d", cursorID, got)
+
+		msgs := sink.messages()
+		require.Len(t, msgs, 1, "expected 1 leaked cursor message, got %d", len(msgs))
+		assert.Equal(t, testDbName+".coll", msgs[0][logger.KeyNamespace], "expected namespace %q, got %v",
+			testDbName+".coll", msgs[0][logger.KeyNamespace])
+		assert.Equal(t, cursorID, msgs[0][logger.KeyCursorID], "expected cursor ID %d, got %v",
+			cursorID, msgs[0][logger.KeyCursorID])
+		assert.Contains(t, msgs[0][logger.KeyStack], "TestCursorReaper", "expected creation stack in message")
+	})
+	t.Run("omits stack without debug logging", func(t *testing.T) {
+		mc, sink, killed := newClient(t, options.LogLevelInfo)
+
+		leakCursor(t, mc)
+
+		assert.Eventually(t, func() bool {
+			runtime.GC()
+			return len(killed()) == 1
+		}, 5*time.Second, 10*time.Millisecond, "expected killCursors to be sent for the leaked cursor")
+
+		msgs := sink.messages()
+		require.Len(t, msgs, 1, "expected 1 leaked cursor message, got %d", len(msgs))
+		_, ok := msgs[0][logger.KeyStack]
+		assert.False(t, ok, "expected no stack in message without debug logging")
+	})
+	t.Run("closed cursor is not tracked", func(t *testing.T) {
+		mc, sink, killed := newClient(t, options.LogLevelDebug)
+
+		mc.md.AddResponses(
+			bson.D{{"ok", 1}, {"cursor", bson.D{
+				{"id", cursorID},
+				{"ns", testDbName + ".coll"},
+				{"firstBatch", bson.A{}},
+			}}},
+			bson.D{{"ok", 1}, {"cursorsKilled", bson.A{cursorID}}},
+		)
+		cursor, err := mc.Database(testDbName).Collection("coll").Find(context.Background(), bson.D{})
+		require.NoError(t, err, "Find error")
+		require.NoError(t, cursor.Close(context.Background()), "Close error")
+
+		runtime.GC()
+		runtime.GC()
+
+		assert.Len(t, killed(), 1, "expected only the killCursors sent by Close")
+		assert.Len(t, sink.messages(), 0, "expected no leaked cursor messages")
+	})
+	t.Run("cursor with explicit session is not tracked", func(t *testing.T) {
+		mc, _, _ := newClient(t, options.LogLevelDebug)
+
+		sess, err := mc.StartSession()
+		require.NoError(t, err, "StartSession error")
+		defer sess.EndSession(context.Background())
+
+		mc.md.AddResponses(bson.D{{"ok", 1}, {"cursor", bson.D{
+			{"id", cursorID},
+			{"ns", testDbName + ".coll"},
+			{"firstBatch", bson.A{}},
+		}}})
+		ctx := NewSessionContext(context.Background(), sess)
+		cursor, err := mc.Database(testDbName).Collection("coll").Find(ctx, bson.D{})
+		require.NoError(t, err, "Find error")
+
+		assert.False(t, cursor.tracked, "expected cursor with an explicit session not to be tracked")
+	})
+	t.Run("close untracked embedded cursor", func(t *testing.T) {
+		// Clearing a finalizer on a pointer into the middle of an allocation panics.
+		holder := &struct {
+			n      int
+			cursor Cursor
+		}{cursor: *newEmptyCursor()}
+
+		assert.Nil(t, holder.cursor.Close(context.Background()), "Close error")
+		assert.True(t, holder.cursor.Closed(), "expected cursor to be closed")
+	})
+	t.Run("disconnect flushes queued cursors", func(t *testing.T) {
+		mc, _, killed := newClient(t, options.LogLevelInfo)
+
+		mc.md.AddResponses(
+			bson.D{{"ok", 1}, {"cursor", bson.D{
+				{"id", cursorID},
+				{"ns", testDbName + ".coll"},
+				{"firstBatch", bson.A{}},
+			}}},
+			bson.D{{"ok", 1}, {"cursorsKilled", bson.A{cursorID}}},
+		)
+		cursor, err := mc.Database(testDbName).Collection("coll").Find(context.Background(), bson.D{})
+		require.NoError(t, err, "Find error")
+
+		// Simulate the finalizer running for the cursor.
+		mc.cursorReaper.enqueue(leakedCursor{cursor: cursor, ns: testDbName + ".coll"})
+		require.NoError(t, mc.Disconnect(context.Background()), "Disconnect error")
+
+		assert.Len(t, killed(), 1, "expected the queued cursor to be killed before Disconnect returned")
+		assert.True(t, cursor.Closed(), "expected the queued cursor to be closed")
+	})
+	t.Run("enqueue after stop is dropped", func(t *testing.T) {
+		r := newCursorReaper(nil)
+		r.stop(context.Background())
+
+		r.enqueue(leakedCursor{cursor: newEmptyCursor()})
+		r.stop(context.Background())
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		assert.False(t, r.started, "expected reaper goroutine not to be started after stop")
+	})
+}
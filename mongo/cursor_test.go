@@ -8,6 +8,7 @@ package mongo
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
 	"testing"
@@ -96,9 +97,10 @@ func (tbc *testBatchCursor) Close(context.Context) error {
 	return nil
 }
 
-func (tbc *testBatchCursor) SetBatchSize(int32)            {}
-func (tbc *testBatchCursor) SetComment(interface{})        {}
-func (tbc *testBatchCursor) SetMaxAwaitTime(time.Duration) {}
+func (tbc *testBatchCursor) SetBatchSize(int32)              {}
+func (tbc *testBatchCursor) SetComment(interface{})          {}
+func (tbc *testBatchCursor) LastResponse() bsoncore.Document { return nil }
+func (tbc *testBatchCursor) SetMaxAwaitTime(time.Duration)   {}
 
 func TestCursor(t *testing.T) {
 	t.Run("TestAll", func(t *testing.T) {
@@ -200,6 +202,34 @@ func TestCursor(t *testing.T) {
 			assert.True(t, tbc.closed, "expected batch cursor to be closed but was not")
 		})
 
+		t.Run("cursor is closed after All returns a decode error", func(t *testing.T) {
+			var docs []struct {
+				Foo string `bson:"foo"`
+			}
+
+			tbc := newTestBatchCursor(2, 5)
+			cursor, err := newCursor(tbc, nil, nil)
+			require.NoError(t, err, "newCursor error: %v", err)
+
+			err = cursor.All(context.Background(), &docs)
+			assert.Error(t, err, "expected decode error, got nil")
+			assert.True(t, tbc.closed, "expected batch cursor to be closed but was not")
+		})
+
+		t.Run("cursor is closed after All is called with a cancelled context", func(t *testing.T) {
+			var docs []bson.D
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			tbc := newTestBatchCursor(2, 5)
+			cursor, err := newCursor(tbc, nil, nil)
+			require.NoError(t, err, "newCursor error: %v", err)
+
+			_ = cursor.All(ctx, &docs)
+			assert.True(t, tbc.closed, "expected batch cursor to be closed but was not")
+		})
+
 		t.Run("does not error given interface as parameter", func(t *testing.T) {
 			var docs interface{} = []bson.D{}
 
@@ -240,6 +270,88 @@ func TestCursor(t *testing.T) {
 
 			assert.Equal(t, want, got, "expected and actual All results are different")
 		})
+		t.Run("with RequireAllFields BSONOption", func(t *testing.T) {
+			type myDocument struct {
+				Foo int32
+				Bar int32
+				Baz *int32
+				Qux int32 `bson:",omitempty"`
+			}
+
+			cursor, err := newCursor(newTestBatchCursor(1, 5), nil, nil)
+			require.NoError(t, err, "newCursor error: %v", err)
+
+			var got []myDocument
+			err = cursor.All(context.Background(), &got)
+			require.NoError(t, err, "All error without RequireAllFields: %v", err)
+			assert.Len(t, got, 5, "expected 5 documents, got %d", len(got))
+
+			cursor, err = newCursor(
+				newTestBatchCursor(1, 5),
+				&options.BSONOptions{
+					RequireAllFields: true,
+				},
+				nil)
+			require.NoError(t, err, "newCursor error: %v", err)
+
+			err = cursor.All(context.Background(), &got)
+			require.Error(t, err, "expected All error for missing field")
+			assert.Contains(t, err.Error(), "bar", "expected error to name the missing field, got: %v", err)
+			assert.NotContains(t, err.Error(), "baz", "expected pointer field to be optional, got: %v", err)
+			assert.NotContains(t, err.Error(), "qux", "expected omitempty field to be optional, got: %v", err)
+		})
+	})
+}
+
+func TestCursor_RawBatch(t *testing.T) {
+	t.Run("from documents", func(t *testing.T) {
+		docs := []interface{}{bson.D{{"foo", int32(0)}}, bson.D{{"foo", int32(1)}}, bson.D{{"foo", int32(2)}}}
+		cursor, err := NewCursorFromDocuments(docs, nil, nil)
+		require.NoError(t, err, "NewCursorFromDocuments error")
+
+		batch := cursor.RawBatch()
+		require.Len(t, batch, 3, "expected 3 documents in the batch")
+		assert.Equal(t, 3, cursor.RemainingBatchLength(), "expected RawBatch not to consume documents")
+
+		for i, want := range batch {
+			assert.Equal(t, len(batch)-i, cursor.RemainingBatchLength(),
+				"expected %d documents remaining", len(batch)-i)
+			require.True(t, cursor.Next(context.Background()), "expected Next to return true")
+
+			// The raw document must be identical to the bytes Decode sees.
+			assert.Equal(t, want, cursor.Current, "expected document %v, got %v", want, cursor.Current)
+			var got bson.Raw
+			err = cursor.Decode(&got)
+			require.NoError(t, err, "Decode error")
+			assert.Equal(t, want, got, "expected decoded document %v, got %v", want, got)
+
+			remaining := cursor.RawBatch()
+			require.Len(t, remaining, len(batch)-i-1, "expected RawBatch to return the remaining documents")
+			for j, doc := range remaining {
+				assert.Equal(t, batch[i+1+j], doc, "expected document %v, got %v", batch[i+1+j], doc)
+			}
+		}
+		assert.Equal(t, 0, cursor.RemainingBatchLength(), "expected no documents remaining")
+		assert.Nil(t, cursor.RawBatch(), "expected empty batch")
+	})
+	t.Run("multiple batches", func(t *testing.T) {
+		cursor, err := newCursor(newTestBatchCursor(2, 3), nil, nil)
+		require.NoError(t, err, "newCursor error")
+
+		var seen []bson.Raw
+		for cursor.Next(context.Background()) {
+			remaining := cursor.RawBatch()
+			assert.Equal(t, cursor.RemainingBatchLength(), len(remaining),
+				"expected RawBatch length to match RemainingBatchLength")
+
+			// Copy the current document because it is only valid until the next call to Next.
+			seen = append(seen, append(bson.Raw(nil), cursor.Current...))
+		}
+		require.NoError(t, cursor.Err(), "cursor error")
+		require.Len(t, seen, 6, "expected 6 documents")
+		for i, doc := range seen {
+			assert.Equal(t, int32(i), doc.Lookup("foo").Int32(), "expected document %d to have foo=%d", i, i)
+		}
 	})
 }
 
@@ -347,3 +459,460 @@ func BenchmarkNewCursorFromDocuments(b *testing.B) {
 		}
 	}
 }
+
+func TestCollectAll(t *testing.T) {
+	docs := []interface{}{
+		bson.D{{"_id", int32(0)}, {"foo", "bar"}},
+		bson.D{{"_id", int32(1)}, {"foo", "baz"}},
+	}
+
+	t.Run("struct", func(t *testing.T) {
+		type doc struct {
+			ID  int32  `bson:"_id"`
+			Foo string `bson:"foo"`
+		}
+
+		cur, err := NewCursorFromDocuments(docs, nil, nil)
+		require.NoError(t, err, "NewCursorFromDocuments error")
+
+		got, err := CollectAll[doc](context.Background(), cur)
+		require.NoError(t, err, "CollectAll error")
+		want := []doc{{ID: 0, Foo: "bar"}, {ID: 1, Foo: "baz"}}
+		assert.Equal(t, want, got, "expected results %v, got %v", want, got)
+	})
+	t.Run("map", func(t *testing.T) {
+		cur, err := NewCursorFromDocuments(docs, nil, nil)
+		require.NoError(t, err, "NewCursorFromDocuments error")
+
+		got, err := CollectAll[bson.M](context.Background(), cur)
+		require.NoError(t, err, "CollectAll error")
+		want := []bson.M{{"_id": int32(0), "foo": "bar"}, {"_id": int32(1), "foo": "baz"}}
+		assert.Equal(t, want, got, "expected results %v, got %v", want, got)
+	})
+	t.Run("bson.Raw", func(t *testing.T) {
+		cur, err := NewCursorFromDocuments(docs, nil, nil)
+		require.NoError(t, err, "NewCursorFromDocuments error")
+
+		got, err := CollectAll[bson.Raw](context.Background(), cur)
+		require.NoError(t, err, "CollectAll error")
+		require.Len(t, got, 2, "expected 2 results")
+		for i, raw := range got {
+			want, err := bson.Marshal(docs[i])
+			require.NoError(t, err, "Marshal error")
+			assert.Equal(t, bson.Raw(want), raw, "expected document %v, got %v", bson.Raw(want), raw)
+		}
+	})
+	t.Run("empty cursor", func(t *testing.T) {
+		cur, err := NewCursorFromDocuments(nil, nil, nil)
+		require.NoError(t, err, "NewCursorFromDocuments error")
+
+		got, err := CollectAll[bson.D](context.Background(), cur)
+		require.NoError(t, err, "CollectAll error")
+		assert.NotNil(t, got, "expected an empty slice, got nil")
+		assert.Len(t, got, 0, "expected no results, got %v", got)
+	})
+	t.Run("cursor error", func(t *testing.T) {
+		mockErr := errors.New("mock error")
+		cur, err := NewCursorFromDocuments(docs, mockErr, nil)
+		require.NoError(t, err, "NewCursorFromDocuments error")
+
+		got, err := CollectAll[bson.D](context.Background(), cur)
+		assert.Equal(t, mockErr, err, "expected error %v, got %v", mockErr, err)
+		assert.Nil(t, got, "expected nil results, got %v", got)
+	})
+}
+
+func BenchmarkCollectAll(b *testing.B) {
+	type doc struct {
+		ID  int32  `bson:"_id"`
+		Foo string `bson:"foo"`
+	}
+
+	documents := make([]interface{}, 0, 100)
+	for i := 0; i < 100; i++ {
+		documents = append(documents, bson.D{{"_id", int32(i)}, {"foo", "bar"}})
+	}
+
+	b.Run("CollectAll", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			cur, err := NewCursorFromDocuments(documents, nil, nil)
+			if err != nil {
+				b.Fatalf("NewCursorFromDocuments error: %v", err)
+			}
+			b.StartTimer()
+
+			if _, err := CollectAll[doc](context.Background(), cur); err != nil {
+				b.Fatalf("CollectAll error: %v", err)
+			}
+		}
+	})
+	b.Run("manual loop", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			cur, err := NewCursorFromDocuments(documents, nil, nil)
+			if err != nil {
+				b.Fatalf("NewCursorFromDocuments error: %v", err)
+			}
+			b.StartTimer()
+
+			var results []doc
+			for cur.Next(context.Background()) {
+				var d doc
+				if err := cur.Decode(&d); err != nil {
+					b.Fatalf("Decode error: %v", err)
+				}
+				results = append(results, d)
+			}
+			if err := cur.Err(); err != nil {
+				b.Fatalf("cursor error: %v", err)
+			}
+			_ = cur.Close(context.Background())
+		}
+	})
+}
+
+func TestCursor_ForEach(t *testing.T) {
+	t.Run("full iteration", func(t *testing.T) {
+		tbc := newTestBatchCursor(2, 3)
+		cur, err := newCursor(tbc, nil, nil)
+		require.NoError(t, err, "newCursor error")
+
+		var got []int32
+		err = cur.ForEach(context.Background(), func(doc bson.Raw) error {
+			got = append(got, doc.Lookup("foo").Int32())
+			return nil
+		})
+		require.NoError(t, err, "ForEach error")
+		assert.Equal(t, []int32{0, 1, 2, 3, 4, 5}, got, "expected values %v, got %v", []int32{0, 1, 2, 3, 4, 5}, got)
+		assert.True(t, tbc.closed, "expected batch cursor to be closed")
+	})
+	t.Run("early exit via error", func(t *testing.T) {
+		tbc := newTestBatchCursor(2, 3)
+		cur, err := newCursor(tbc, nil, nil)
+		require.NoError(t, err, "newCursor error")
+
+		mockErr := errors.New("mock error")
+		var calls int
+		err = cur.ForEach(context.Background(), func(bson.Raw) error {
+			calls++
+			if calls == 2 {
+				return mockErr
+			}
+			return nil
+		})
+		assert.Equal(t, mockErr, err, "expected error %v, got %v", mockErr, err)
+		assert.Equal(t, 2, calls, "expected 2 calls, got %d", calls)
+		assert.True(t, tbc.closed, "expected batch cursor to be closed")
+	})
+	t.Run("early exit via ErrStopIteration", func(t *testing.T) {
+		tbc := newTestBatchCursor(2, 3)
+		cur, err := newCursor(tbc, nil, nil)
+		require.NoError(t, err, "newCursor error")
+
+		var calls int
+		err = cur.ForEach(context.Background(), func(bson.Raw) error {
+			calls++
+			if calls == 4 {
+				return ErrStopIteration
+			}
+			return nil
+		})
+		require.NoError(t, err, "ForEach error")
+		assert.Equal(t, 4, calls, "expected 4 calls, got %d", calls)
+		assert.True(t, tbc.closed, "expected batch cursor to be closed")
+	})
+	t.Run("context cancelled mid-iteration", func(t *testing.T) {
+		tbc := newTestBatchCursor(2, 3)
+		cur, err := newCursor(tbc, nil, nil)
+		require.NoError(t, err, "newCursor error")
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var calls int
+		err = cur.ForEach(ctx, func(bson.Raw) error {
+			calls++
+			if calls == 2 {
+				cancel()
+			}
+			return nil
+		})
+		assert.Equal(t, context.Canceled, err, "expected error %v, got %v", context.Canceled, err)
+		assert.Equal(t, 2, calls, "expected 2 calls, got %d", calls)
+		assert.True(t, tbc.closed, "expected batch cursor to be closed")
+	})
+}
+
+func TestForEachAs(t *testing.T) {
+	type doc struct {
+		Foo int32 `bson:"foo"`
+	}
+
+	t.Run("full iteration", func(t *testing.T) {
+		cur, err := newCursor(newTestBatchCursor(2, 2), nil, nil)
+		require.NoError(t, err, "newCursor error")
+
+		var got []doc
+		err = ForEachAs(context.Background(), cur, func(d doc) error {
+			got = append(got, d)
+			return nil
+		})
+		require.NoError(t, err, "ForEachAs error")
+		want := []doc{{0}, {1}, {2}, {3}}
+		assert.Equal(t, want, got, "expected values %v, got %v", want, got)
+	})
+	t.Run("early exit via ErrStopIteration", func(t *testing.T) {
+		cur, err := newCursor(newTestBatchCursor(2, 2), nil, nil)
+		require.NoError(t, err, "newCursor error")
+
+		var got []doc
+		err = ForEachAs(context.Background(), cur, func(d doc) error {
+			got = append(got, d)
+			return ErrStopIteration
+		})
+		require.NoError(t, err, "ForEachAs error")
+		assert.Equal(t, []doc{{0}}, got, "expected values %v, got %v", []doc{{0}}, got)
+	})
+	t.Run("decode error", func(t *testing.T) {
+		cur, err := newCursor(newTestBatchCursor(1, 1), nil, nil)
+		require.NoError(t, err, "newCursor error")
+
+		err = ForEachAs(context.Background(), cur, func(string) error {
+			t.Fatal("expected fn not to be called")
+			return nil
+		})
+		assert.NotNil(t, err, "expected decode error, got nil")
+	})
+}
+
+func BenchmarkCursor_ForEach(b *testing.B) {
+	b.Run("ForEach", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			cur, err := newCursor(newTestBatchCursor(10, 100), nil, nil)
+			if err != nil {
+				b.Fatalf("newCursor error: %v", err)
+			}
+			b.StartTimer()
+
+			var sum int32
+			err = cur.ForEach(context.Background(), func(doc bson.Raw) error {
+				sum += doc.Lookup("foo").Int32()
+				return nil
+			})
+			if err != nil {
+				b.Fatalf("ForEach error: %v", err)
+			}
+		}
+	})
+	b.Run("manual loop", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			cur, err := newCursor(newTestBatchCursor(10, 100), nil, nil)
+			if err != nil {
+				b.Fatalf("newCursor error: %v", err)
+			}
+			b.StartTimer()
+
+			var sum int32
+			for cur.Next(context.Background()) {
+				sum += cur.Current.Lookup("foo").Int32()
+			}
+			if err := cur.Err(); err != nil {
+				b.Fatalf("cursor error: %v", err)
+			}
+			_ = cur.Close(context.Background())
+		}
+	})
+}
+
+func TestCursor_SetBatchSize(t *testing.T) {
+	mc := newMockClient(t, nil)
+
+	coll := mc.Database(testDbName).Collection("coll")
+	ns := testDbName + ".coll"
+
+	mc.md.AddResponses(
+		bson.D{{"ok", 1}, {"cursor", bson.D{
+			{"id", int64(1)},
+			{"ns", ns},
+			{"firstBatch", bson.A{bson.D{{"x", 1}}, bson.D{{"x", 2}}}},
+		}}},
+		bson.D{{"ok", 1}, {"cursor", bson.D{
+			{"id", int64(1)},
+			{"ns", ns},
+			{"nextBatch", bson.A{bson.D{{"x", 3}}}},
+		}}},
+		bson.D{{"ok", 1}, {"cursor", bson.D{
+			{"id", int64(0)},
+			{"ns", ns},
+			{"nextBatch", bson.A{bson.D{{"x", 4}}}},
+		}}},
+	)
+
+	cursor, err := coll.Find(context.Background(), bson.D{}, options.Find().SetBatchSize(2))
+	require.NoError(t, err, "Find error")
+
+	// Exhaust the first batch before changing the batch size.
+	for i := 0; i < 2; i++ {
+		require.True(t, cursor.Next(context.Background()), "expected Next to return true, got false")
+	}
+
+	for _, batchSize := range []int32{5, 3} {
+		cursor.SetBatchSize(batchSize)
+		require.True(t, cursor.Next(context.Background()), "expected Next to return true, got false")
+
+		evt := mc.started[len(mc.started)-1]
+		require.Equal(t, "getMore", evt.CommandName, "expected getMore, got %q", evt.CommandName)
+		got := evt.Command.Lookup("batchSize").Int32()
+		assert.Equal(t, batchSize, got, "expected getMore batchSize %v, got %v", batchSize, got)
+	}
+
+	assert.False(t, cursor.Next(context.Background()), "expected Next to return false, got true")
+	assert.NoError(t, cursor.Err(), "cursor error")
+	require.Len(t, mc.started, 3, "expected 3 started events, got %d", len(mc.started))
+}
+
+func TestCursor_LastResponse(t *testing.T) {
+	mc := newMockClient(t, options.Client().SetRetainRawResponses(true))
+
+	coll := mc.Database(testDbName).Collection("coll")
+	ns := testDbName + ".coll"
+
+	findReply := bson.D{{"ok", 1}, {"cursor", bson.D{
+		{"id", int64(1)},
+		{"ns", ns},
+		{"firstBatch", bson.A{bson.D{{"x", 1}}}},
+	}}}
+	getMoreReply := bson.D{{"ok", 1}, {"cursor", bson.D{
+		{"id", int64(1)},
+		{"ns", ns},
+		{"nextBatch", bson.A{bson.D{{"x", "not a number"}}}},
+	}}}
+	errReply := bson.D{{"ok", 0}, {"code", 43}, {"errmsg", "cursor not found"}}
+	mc.md.AddResponses(findReply, getMoreReply, errReply)
+
+	assertLastResponse := func(t *testing.T, cursor *Cursor, doc bson.D) {
+		t.Helper()
+
+		want, err := bson.Marshal(doc)
+		require.NoError(t, err, "Marshal error")
+		got := cursor.LastResponse()
+		assert.Equal(t, bson.Raw(want), got, "expected last response %v, got %v", bson.Raw(want), got)
+	}
+
+	cursor, err := coll.Find(context.Background(), bson.D{})
+	require.NoError(t, err, "Find error")
+	assertLastResponse(t, cursor, findReply)
+
+	var mismatched struct {
+		X int32 `bson:"x"`
+	}
+	require.True(t, cursor.Next(context.Background()), "expected Next to return true, got false")
+	require.NoError(t, cursor.Decode(&mismatched), "Decode error")
+
+	require.True(t, cursor.Next(context.Background()), "expected Next to return true, got false")
+	require.Error(t, cursor.Decode(&mismatched), "expected Decode error")
+	assertLastResponse(t, cursor, getMoreReply)
+
+	require.False(t, cursor.Next(context.Background()), "expected Next to return false, got true")
+	require.Error(t, cursor.Err(), "expected cursor error")
+	assertLastResponse(t, cursor, errReply)
+}
+
+func TestCursor_ErrAndClosed(t *testing.T) {
+	mc := newMockClient(t, nil)
+
+	coll := mc.Database(testDbName).Collection("coll")
+	ns := testDbName + ".coll"
+
+	drain := func(cursor *Cursor) int {
+		var n int
+		for cursor.Next(context.Background()) {
+			n++
+		}
+		return n
+	}
+
+	t.Run("exhausted", func(t *testing.T) {
+		mc.md.ClearResponses()
+		mc.md.AddResponses(
+			bson.D{{"ok", 1}, {"cursor", bson.D{
+				{"id", int64(1)},
+				{"ns", ns},
+				{"firstBatch", bson.A{bson.D{{"x", 1}}}},
+			}}},
+			bson.D{{"ok", 1}, {"cursor", bson.D{
+				{"id", int64(0)},
+				{"ns", ns},
+				{"nextBatch", bson.A{bson.D{{"x", 2}}}},
+			}}},
+		)
+
+		cursor, err := coll.Find(context.Background(), bson.D{})
+		require.NoError(t, err, "Find error")
+
+		n := drain(cursor)
+		assert.Equal(t, 2, n, "expected 2 documents, got %d", n)
+		assert.NoError(t, cursor.Err(), "expected no cursor error")
+		assert.Equal(t, int64(0), cursor.ID(), "expected cursor ID 0, got %d", cursor.ID())
+		assert.False(t, cursor.Closed(), "expected exhausted cursor not to be closed")
+
+		err = cursor.Close(context.Background())
+		require.NoError(t, err, "Close error")
+		assert.True(t, cursor.Closed(), "expected cursor to be closed")
+		assert.False(t, cursor.Next(context.Background()), "expected Next to return false after Close")
+		assert.NoError(t, cursor.Err(), "expected no cursor error after Close")
+	})
+	t.Run("getMore error", func(t *testing.T) {
+		mc.md.ClearResponses()
+		mc.md.AddResponses(
+			bson.D{{"ok", 1}, {"cursor", bson.D{
+				{"id", int64(1)},
+				{"ns", ns},
+				{"firstBatch", bson.A{bson.D{{"x", 1}}}},
+			}}},
+			bson.D{{"ok", 0}, {"code", 43}, {"errmsg", "cursor not found"}},
+			bson.D{{"ok", 1}},
+		)
+
+		cursor, err := coll.Find(context.Background(), bson.D{})
+		require.NoError(t, err, "Find error")
+
+		n := drain(cursor)
+		assert.Equal(t, 1, n, "expected 1 document, got %d", n)
+
+		var ce CommandError
+		require.True(t, errors.As(cursor.Err(), &ce), "expected CommandError, got %v", cursor.Err())
+		assert.Equal(t, int32(43), ce.Code, "expected error code 43, got %d", ce.Code)
+		assert.False(t, cursor.Closed(), "expected cursor not to be closed")
+
+		assert.False(t, cursor.Next(context.Background()), "expected Next to return false after an error")
+		assert.Equal(t, ce, cursor.Err(), "expected error to be retained, got %v", cursor.Err())
+
+		err = cursor.Close(context.Background())
+		require.NoError(t, err, "Close error")
+		assert.True(t, cursor.Closed(), "expected cursor to be closed")
+	})
+	t.Run("All closes the cursor", func(t *testing.T) {
+		mc.md.ClearResponses()
+		mc.md.AddResponses(bson.D{{"ok", 1}, {"cursor", bson.D{
+			{"id", int64(0)},
+			{"ns", ns},
+			{"firstBatch", bson.A{bson.D{{"x", 1}}}},
+		}}})
+
+		cursor, err := coll.Find(context.Background(), bson.D{})
+		require.NoError(t, err, "Find error")
+
+		var docs []bson.D
+		err = cursor.All(context.Background(), &docs)
+		require.NoError(t, err, "All error")
+		assert.True(t, cursor.Closed(), "expected cursor to be closed")
+	})
+}
@@ -15,6 +15,7 @@ import (
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/internal/csfle"
 	"go.mongodb.org/mongo-driver/v2/internal/csot"
+	"go.mongodb.org/mongo-driver/v2/internal/driverutil"
 	"go.mongodb.org/mongo-driver/v2/internal/mongoutil"
 	"go.mongodb.org/mongo-driver/v2/internal/serverselector"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
@@ -43,14 +44,21 @@ type Database struct {
 	writeSelector  description.ServerSelector
 	bsonOpts       *options.BSONOptions
 	registry       *bson.Registry
+	defaultMaxTime *time.Duration
+
+	// concernSources records where readConcern and writeConcern were configured.
+	concernSources driverutil.ConcernSources
 }
 
 func newDatabase(client *Client, name string, opts ...options.Lister[options.DatabaseOptions]) *Database {
 	args, _ := mongoutil.NewOptions[options.DatabaseOptions](opts...)
 
+	sources := client.concernSources
+
 	rc := client.readConcern
 	if args.ReadConcern != nil {
 		rc = args.ReadConcern
+		sources.ReadConcern = driverutil.ConcernSourceDatabase
 	}
 
 	rp := client.readPreference
@@ -61,6 +69,7 @@ func newDatabase(client *Client, name string, opts ...options.Lister[options.Dat
 	wc := client.writeConcern
 	if args.WriteConcern != nil {
 		wc = args.WriteConcern
+		sources.WriteConcern = driverutil.ConcernSourceDatabase
 	}
 
 	bsonOpts := client.bsonOpts
@@ -81,6 +90,8 @@ func newDatabase(client *Client, name string, opts ...options.Lister[options.Dat
 		writeConcern:   wc,
 		bsonOpts:       bsonOpts,
 		registry:       reg,
+		defaultMaxTime: args.DefaultMaxTime,
+		concernSources: sources,
 	}
 
 	db.readSelector = &serverselector.Composite{
@@ -100,6 +111,12 @@ func newDatabase(client *Client, name string, opts ...options.Lister[options.Dat
 	return db
 }
 
+// withConcernSources returns ctx annotated with where the Database's read and write concerns were configured. See the
+// package-level withConcernSources.
+func (db *Database) withConcernSources(ctx context.Context) context.Context {
+	return withConcernSources(ctx, db.client.logger, db.concernSources)
+}
+
 // Client returns the Client the Database was created from.
 func (db *Database) Client() *Client {
 	return db.client
@@ -110,6 +127,26 @@ func (db *Database) Name() string {
 	return db.name
 }
 
+// ReadConcern returns the read concern used by the Database. If no read concern was specified when the Database was
+// created, the Client's read concern is returned. The returned value is a copy, so modifying it does not affect the
+// Database.
+func (db *Database) ReadConcern() *readconcern.ReadConcern {
+	return copyReadConcern(db.readConcern)
+}
+
+// WriteConcern returns the write concern used by the Database. If no write concern was specified when the Database
+// was created, the Client's write concern is returned. The returned value is a copy, so modifying it does not affect
+// the Database.
+func (db *Database) WriteConcern() *writeconcern.WriteConcern {
+	return copyWriteConcern(db.writeConcern)
+}
+
+// ReadPreference returns the read preference used by the Database. If no read preference was specified when the
+// Database was created, the Client's read preference is returned.
+func (db *Database) ReadPreference() *readpref.ReadPref {
+	return db.readPreference
+}
+
 // Collection returns a handle for a collection with the given name and options.
 //
 // If the collection does not exist on the server, it will be created when a
@@ -136,7 +173,7 @@ func (db *Database) Aggregate(
 	opts ...options.Lister[options.AggregateOptions],
 ) (*Cursor, error) {
 	a := aggregateParams{
-		ctx:            ctx,
+		ctx:            db.withConcernSources(ctx),
 		pipeline:       pipeline,
 		client:         db.client,
 		registry:       db.registry,
@@ -164,7 +201,7 @@ func (db *Database) processRunCommand(
 	}
 
 	sess := sessionFromContext(ctx)
-	if sess == nil && db.client.sessionPool != nil {
+	if sess == nil && db.client.sessionPool != nil && !implicitSessionDisabled(ctx) {
 		sess = session.NewImplicitClientSession(db.client.sessionPool, db.client.id)
 	}
 
@@ -253,7 +290,7 @@ func (db *Database) RunCommand(
 	err = op.Execute(ctx)
 	// RunCommand can be used to run a write, thus execute may return a write error
 	rr, convErr := processWriteError(err)
-	return &SingleResult{
+	sr := &SingleResult{
 		ctx:          ctx,
 		err:          convErr,
 		rdr:          bson.Raw(op.Result()),
@@ -261,6 +298,10 @@ func (db *Database) RunCommand(
 		reg:          db.registry,
 		Acknowledged: rr.isAcknowledged(),
 	}
+	if db.client.retainRawResponses {
+		sr.rawResponse = sr.rdr
+	}
+	return sr
 }
 
 // RunCommandCursor executes the given command against the database and parses the response as a cursor. If the command
@@ -307,6 +348,7 @@ func (db *Database) RunCommandCursor(
 		return nil, replaceErrors(err)
 	}
 	cursor, err := newCursorWithSession(bc, db.bsonOpts, db.registry, sess)
+	db.client.cursorReaper.track(cursor, db.name+".$cmd")
 	return cursor, replaceErrors(err)
 }
 
@@ -318,7 +360,7 @@ func (db *Database) Drop(ctx context.Context) error {
 	}
 
 	sess := sessionFromContext(ctx)
-	if sess == nil && db.client.sessionPool != nil {
+	if sess == nil && db.client.sessionPool != nil && !implicitSessionDisabled(ctx) {
 		sess = session.NewImplicitClientSession(db.client.sessionPool, db.client.id)
 		defer sess.EndSession()
 	}
@@ -344,7 +386,7 @@ func (db *Database) Drop(ctx context.Context) error {
 		Database(db.name).Deployment(db.client.deployment).Crypt(db.client.cryptFLE).
 		ServerAPI(db.client.serverAPI).Authenticator(db.client.authenticator)
 
-	err = op.Execute(ctx)
+	err = op.Execute(db.withConcernSources(ctx))
 
 	var driverErr driver.Error
 	if err != nil && (!errors.As(err, &driverErr) || !driverErr.NamespaceNotFound()) {
@@ -447,7 +489,7 @@ func (db *Database) ListCollections(
 	}
 
 	sess := sessionFromContext(ctx)
-	if sess == nil && db.client.sessionPool != nil {
+	if sess == nil && db.client.sessionPool != nil && !implicitSessionDisabled(ctx) {
 		sess = session.NewImplicitClientSession(db.client.sessionPool, db.client.id)
 	}
 
@@ -507,6 +549,7 @@ func (db *Database) ListCollections(
 		return nil, replaceErrors(err)
 	}
 	cursor, err := newCursorWithSession(bc, db.bsonOpts, db.registry, sess)
+	db.client.cursorReaper.track(cursor, db.name+".$cmd.listCollections")
 	return cursor, replaceErrors(err)
 }
 
@@ -518,7 +561,8 @@ func (db *Database) ListCollections(
 // collections.
 //
 // The opts parameter can be used to specify options for the operation (see the options.ListCollectionsOptions
-// documentation).
+// documentation). The nameOnly option is set automatically: it is only used if the filter is empty or only contains
+// conditions on the "name" field, because other fields are not returned by the server when nameOnly is true.
 //
 // For more information about the command, see https://www.mongodb.com/docs/manual/reference/command/listCollections/.
 //
@@ -529,9 +573,18 @@ func (db *Database) ListCollectionNames(
 	filter interface{},
 	opts ...options.Lister[options.ListCollectionsOptions],
 ) ([]string, error) {
-	opts = append(opts, options.ListCollections().SetNameOnly(true))
+	filterDoc, err := marshal(filter, db.bsonOpts, db.registry)
+	if err != nil {
+		return nil, err
+	}
 
-	res, err := db.ListCollections(ctx, filter, opts...)
+	nameOnly, err := filtersOnlyOnName(filterDoc)
+	if err != nil {
+		return nil, err
+	}
+	opts = append(opts, options.ListCollections().SetNameOnly(nameOnly))
+
+	res, err := db.ListCollections(ctx, bson.Raw(filterDoc), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -557,6 +610,23 @@ func (db *Database) ListCollectionNames(
 	return names, nil
 }
 
+// filtersOnlyOnName reports whether the given listCollections filter is empty or only contains conditions on the
+// "name" field. Other fields are not returned by the server when nameOnly is true, so filters on them cannot be
+// combined with nameOnly.
+func filtersOnlyOnName(filter bsoncore.Document) (bool, error) {
+	elems, err := filter.Elements()
+	if err != nil {
+		return false, err
+	}
+
+	for _, elem := range elems {
+		if elem.Key() != "name" {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
 // Watch returns a change stream for all changes to the corresponding database. See
 // https://www.mongodb.com/docs/manual/changeStreams/ for more information about change streams.
 //
@@ -582,6 +652,7 @@ func (db *Database) Watch(ctx context.Context, pipeline interface{},
 		streamType:     DatabaseStream,
 		databaseName:   db.Name(),
 		crypt:          db.client.cryptFLE,
+		concernSources: db.concernSources,
 	}
 	return newChangeStream(ctx, csConfig, pipeline, opts...)
 }
@@ -609,12 +680,38 @@ func (db *Database) CreateCollection(ctx context.Context, name string, opts ...o
 		ef = db.getEncryptedFieldsFromMap(name)
 	}
 	if ef != nil {
+		if args.Validator != nil {
+			hasSchema, err := db.validatorHasJSONSchema(args.Validator)
+			if err != nil {
+				return err
+			}
+			if hasSchema {
+				return errors.New("encryptedFields and a $jsonSchema validator cannot both be set when creating a " +
+					"collection; use encryptedFields for Queryable Encryption or a $jsonSchema validator for " +
+					"client-side field level encryption")
+			}
+		}
+
 		return db.createCollectionWithEncryptedFields(ctx, name, ef, opts...)
 	}
 
 	return db.createCollection(ctx, name, opts...)
 }
 
+// validatorHasJSONSchema reports whether the validator document contains a top-level $jsonSchema key.
+func (db *Database) validatorHasJSONSchema(validator interface{}) (bool, error) {
+	doc, err := marshal(validator, db.bsonOpts, db.registry)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = doc.LookupErr("$jsonSchema")
+	if errors.Is(err, bsoncore.ErrElementNotFound) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
 // getEncryptedFieldsFromServer tries to get an "encryptedFields" document associated with collectionName by running the "listCollections" command.
 // Returns nil and no error if the listCollections command succeeds, but "encryptedFields" is not present.
 func (db *Database) getEncryptedFieldsFromServer(ctx context.Context, collectionName string) (interface{}, error) {
@@ -663,6 +760,30 @@ func (db *Database) getEncryptedFieldsFromMap(collectionName string) interface{}
 	return nil
 }
 
+// EncryptedStateCollectionNames returns the names of the ESC (encrypted state collection) and ECOC (encrypted
+// compaction collection) that are associated with the Queryable Encryption data collection named dataCollection
+// and configured with the given encryptedFields document. The names are taken from the "escCollection" and
+// "ecocCollection" fields of encryptedFields if present, or derived from dataCollection otherwise. No collections
+// are created.
+func EncryptedStateCollectionNames(encryptedFields interface{}, dataCollection string) (esc, ecoc string, err error) {
+	efBSON, err := marshal(encryptedFields, nil, nil)
+	if err != nil {
+		return "", "", fmt.Errorf("error transforming document: %w", err)
+	}
+
+	esc, err = csfle.GetEncryptedStateCollectionName(efBSON, dataCollection, csfle.EncryptedStateCollection)
+	if err != nil {
+		return "", "", err
+	}
+
+	ecoc, err = csfle.GetEncryptedStateCollectionName(efBSON, dataCollection, csfle.EncryptedCompactionCollection)
+	if err != nil {
+		return "", "", err
+	}
+
+	return esc, ecoc, nil
+}
+
 // createCollectionWithEncryptedFields creates a collection with an EncryptedFields.
 func (db *Database) createCollectionWithEncryptedFields(
 	ctx context.Context,
@@ -837,29 +958,7 @@ func (db *Database) createCollectionOperation(
 			return nil, fmt.Errorf("failed to construct DefaultIndexArgs from options: %w", err)
 		}
 
-		idx, doc := bsoncore.AppendDocumentStart(nil)
-		doc = bsoncore.AppendStringElement(doc, "timeField", timeSeriesArgs.TimeField)
-
-		if timeSeriesArgs.MetaField != nil {
-			doc = bsoncore.AppendStringElement(doc, "metaField", *timeSeriesArgs.MetaField)
-		}
-		if timeSeriesArgs.Granularity != nil {
-			doc = bsoncore.AppendStringElement(doc, "granularity", *timeSeriesArgs.Granularity)
-		}
-
-		if timeSeriesArgs.BucketMaxSpan != nil {
-			bmss := int64(*timeSeriesArgs.BucketMaxSpan / time.Second)
-
-			doc = bsoncore.AppendInt64Element(doc, "bucketMaxSpanSeconds", bmss)
-		}
-
-		if timeSeriesArgs.BucketRounding != nil {
-			brs := int64(*timeSeriesArgs.BucketRounding / time.Second)
-
-			doc = bsoncore.AppendInt64Element(doc, "bucketRoundingSeconds", brs)
-		}
-
-		doc, err = bsoncore.AppendDocumentEnd(doc, idx)
+		doc, err := marshalTimeSeriesOptions(timeSeriesArgs)
 		if err != nil {
 			return nil, err
 		}
@@ -877,6 +976,34 @@ func (db *Database) createCollectionOperation(
 	return op, nil
 }
 
+// marshalTimeSeriesOptions marshals opts into the document used for the timeseries option of the create command and
+// the $out aggregation stage.
+func marshalTimeSeriesOptions(opts *options.TimeSeriesOptions) (bsoncore.Document, error) {
+	idx, doc := bsoncore.AppendDocumentStart(nil)
+	doc = bsoncore.AppendStringElement(doc, "timeField", opts.TimeField)
+
+	if opts.MetaField != nil {
+		doc = bsoncore.AppendStringElement(doc, "metaField", *opts.MetaField)
+	}
+	if opts.Granularity != nil {
+		doc = bsoncore.AppendStringElement(doc, "granularity", *opts.Granularity)
+	}
+
+	if opts.BucketMaxSpan != nil {
+		bmss := int64(*opts.BucketMaxSpan / time.Second)
+
+		doc = bsoncore.AppendInt64Element(doc, "bucketMaxSpanSeconds", bmss)
+	}
+
+	if opts.BucketRounding != nil {
+		brs := int64(*opts.BucketRounding / time.Second)
+
+		doc = bsoncore.AppendInt64Element(doc, "bucketRoundingSeconds", brs)
+	}
+
+	return bsoncore.AppendDocumentEnd(doc, idx)
+}
+
 // CreateView creates a view on the server.
 //
 // The viewName parameter specifies the name of the view to create. The viewOn
@@ -917,7 +1044,7 @@ func (db *Database) CreateView(ctx context.Context, viewName, viewOn string, pip
 
 func (db *Database) executeCreateOperation(ctx context.Context, op *operation.Create) error {
 	sess := sessionFromContext(ctx)
-	if sess == nil && db.client.sessionPool != nil {
+	if sess == nil && db.client.sessionPool != nil && !implicitSessionDisabled(ctx) {
 		sess = session.NewImplicitClientSession(db.client.sessionPool, db.client.id)
 		defer sess.EndSession()
 	}
@@ -945,7 +1072,7 @@ func (db *Database) executeCreateOperation(ctx context.Context, op *operation.Cr
 		Deployment(db.client.deployment).
 		Crypt(db.client.cryptFLE)
 
-	return replaceErrors(op.Execute(ctx))
+	return replaceErrors(op.Execute(db.withConcernSources(ctx)))
 }
 
 // GridFSBucket is used to construct a GridFS bucket which can be used as a
@@ -978,6 +1105,14 @@ func (db *Database) GridFSBucket(opts ...options.Lister[options.BucketOptions])
 
 	b.chunksColl = db.Collection(b.name+".chunks", collOpts)
 	b.filesColl = db.Collection(b.name+".files", collOpts)
+	for _, coll := range []*Collection{b.chunksColl, b.filesColl} {
+		if b.rc != nil {
+			coll.concernSources.ReadConcern = driverutil.ConcernSourceBucket
+		}
+		if b.wc != nil {
+			coll.concernSources.WriteConcern = driverutil.ConcernSourceBucket
+		}
+	}
 	b.readBuf = make([]byte, b.chunkSize)
 	b.writeBuf = make([]byte, b.chunkSize)
 
@@ -154,3 +154,267 @@ func TestDatabase(t *testing.T) {
 		assert.Equal(t, ErrNilDocument, err, "expected error %v, got %v", ErrNilDocument, err)
 	})
 }
+
+func TestDatabase_ListCollections(t *testing.T) {
+	mc := newMockClient(t, nil)
+
+	db := mc.Database(testDbName)
+	ns := testDbName + ".$cmd.listCollections"
+
+	t.Run("ListCollectionNames nameOnly", func(t *testing.T) {
+		testCases := []struct {
+			name         string
+			filter       interface{}
+			wantNameOnly bool
+		}{
+			{"empty filter", bson.D{}, true},
+			{"name filter", bson.D{{"name", "foo"}}, true},
+			{"name filter with operator", bson.D{{"name", bson.D{{"$regex", "^foo"}}}}, true},
+			{"capped filter", bson.D{{"options.capped", true}}, false},
+			{"name and capped filter", bson.D{{"name", "foo"}, {"options.capped", true}}, false},
+			{"type filter", bson.D{{"type", "view"}}, false},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				mc.started = nil
+				mc.md.ClearResponses()
+				mc.md.AddResponses(bson.D{
+					{"ok", 1},
+					{"cursor", bson.D{
+						{"id", int64(0)},
+						{"ns", ns},
+						{"firstBatch", bson.A{bson.D{{"name", "foo"}, {"type", "collection"}}}},
+					}},
+				})
+
+				// Setting nameOnly explicitly should not override the value derived from the filter.
+				names, err := db.ListCollectionNames(context.Background(), tc.filter,
+					options.ListCollections().SetNameOnly(true))
+				require.NoError(t, err, "ListCollectionNames error")
+				assert.Equal(t, []string{"foo"}, names, "expected names %v, got %v", []string{"foo"}, names)
+
+				require.Len(t, mc.started, 1, "expected 1 started event, got %d", len(mc.started))
+				got, ok := mc.started[0].Command.Lookup("nameOnly").BooleanOK()
+				if !ok {
+					got = false
+				}
+				assert.Equal(t, tc.wantNameOnly, got, "expected nameOnly %v, got %v", tc.wantNameOnly, got)
+
+				gotFilter := mc.started[0].Command.Lookup("filter").Document()
+				wantFilter, err := bson.Marshal(tc.filter)
+				require.NoError(t, err, "Marshal error")
+				assert.Equal(t, bson.Raw(wantFilter), gotFilter, "expected filter %v, got %v",
+					bson.Raw(wantFilter), gotFilter)
+			})
+		}
+	})
+	t.Run("ListCollectionNames nil filter", func(t *testing.T) {
+		_, err := db.ListCollectionNames(context.Background(), nil)
+		assert.Equal(t, ErrNilDocument, err, "expected error %v, got %v", ErrNilDocument, err)
+	})
+	t.Run("batch size is used for getMore", func(t *testing.T) {
+		mc.started = nil
+		mc.md.ClearResponses()
+		mc.md.AddResponses(
+			bson.D{
+				{"ok", 1},
+				{"cursor", bson.D{
+					{"id", int64(1)},
+					{"ns", ns},
+					{"firstBatch", bson.A{bson.D{{"name", "a"}}, bson.D{{"name", "b"}}}},
+				}},
+			},
+			bson.D{
+				{"ok", 1},
+				{"cursor", bson.D{
+					{"id", int64(0)},
+					{"ns", ns},
+					{"nextBatch", bson.A{bson.D{{"name", "c"}}}},
+				}},
+			},
+		)
+
+		names, err := db.ListCollectionNames(context.Background(), bson.D{},
+			options.ListCollections().SetBatchSize(2))
+		require.NoError(t, err, "ListCollectionNames error")
+		assert.Equal(t, []string{"a", "b", "c"}, names, "expected names %v, got %v", []string{"a", "b", "c"}, names)
+
+		require.Len(t, mc.started, 2, "expected 2 started events, got %d", len(mc.started))
+		assert.Equal(t, "getMore", mc.started[1].CommandName, "expected getMore, got %q", mc.started[1].CommandName)
+		batchSize, ok := mc.started[1].Command.Lookup("batchSize").Int32OK()
+		assert.True(t, ok, "expected getMore to contain batchSize, got %v", mc.started[1].Command)
+		assert.Equal(t, int32(2), batchSize, "expected getMore batchSize 2, got %v", batchSize)
+	})
+}
+
+func TestDatabase_CreateCollectionEncryptedFieldsValidator(t *testing.T) {
+	mc := newMockClient(t, nil)
+
+	db := mc.Database(testDbName)
+	encryptedFields := bson.D{{"fields", bson.A{}}}
+	validator := bson.D{{"$jsonSchema", bson.D{{"bsonType", "object"}}}}
+
+	t.Run("both set", func(t *testing.T) {
+		mc.started = nil
+		mc.md.ClearResponses()
+
+		opts := options.CreateCollection().SetEncryptedFields(encryptedFields).SetValidator(validator)
+		err := db.CreateCollection(context.Background(), "coll", opts)
+		require.Error(t, err, "expected CreateCollection error")
+		assert.Contains(t, err.Error(), "$jsonSchema")
+		names := mc.commandNames()
+		assert.Len(t, names, 0, "expected no commands to be sent, got %v", names)
+	})
+	t.Run("non-schema validator with encryptedFields", func(t *testing.T) {
+		mc.started = nil
+		mc.md.ClearResponses()
+		mc.md.AddResponses(bson.D{{"ok", 1}}, bson.D{{"ok", 1}}, bson.D{{"ok", 1}}, bson.D{{"ok", 1}})
+
+		opts := options.CreateCollection().SetEncryptedFields(encryptedFields).
+			SetValidator(bson.D{{"x", bson.D{{"$exists", true}}}})
+		err := db.CreateCollection(context.Background(), "coll", opts)
+		require.NoError(t, err, "CreateCollection error")
+	})
+	t.Run("encryptedFields only", func(t *testing.T) {
+		mc.started = nil
+		mc.md.ClearResponses()
+		mc.md.AddResponses(bson.D{{"ok", 1}}, bson.D{{"ok", 1}}, bson.D{{"ok", 1}}, bson.D{{"ok", 1}})
+
+		opts := options.CreateCollection().SetEncryptedFields(encryptedFields)
+		err := db.CreateCollection(context.Background(), "coll", opts)
+		require.NoError(t, err, "CreateCollection error")
+		want := []string{"create", "create", "create", "createIndexes"}
+		names := mc.commandNames()
+		assert.Equal(t, want, names, "expected commands %v, got %v", want, names)
+	})
+	t.Run("validator only", func(t *testing.T) {
+		mc.started = nil
+		mc.md.ClearResponses()
+		mc.md.AddResponses(bson.D{{"ok", 1}})
+
+		opts := options.CreateCollection().SetValidator(validator)
+		err := db.CreateCollection(context.Background(), "coll", opts)
+		require.NoError(t, err, "CreateCollection error")
+		names := mc.commandNames()
+		assert.Equal(t, []string{"create"}, names, "expected commands %v, got %v", []string{"create"}, names)
+	})
+}
+
+func TestEncryptedStateCollectionNames(t *testing.T) {
+	testCases := []struct {
+		name            string
+		encryptedFields interface{}
+		wantESC         string
+		wantECOC        string
+		wantErr         bool
+	}{
+		{
+			name: "explicit names",
+			encryptedFields: bson.D{
+				{"escCollection", "customESC"},
+				{"ecocCollection", "customECOC"},
+				{"fields", bson.A{}},
+			},
+			wantESC:  "customESC",
+			wantECOC: "customECOC",
+		},
+		{
+			name:            "default names",
+			encryptedFields: bson.D{{"fields", bson.A{}}},
+			wantESC:         "enxcol_.coll.esc",
+			wantECOC:        "enxcol_.coll.ecoc",
+		},
+		{
+			name:            "explicit ESC name only",
+			encryptedFields: bson.M{"escCollection": "customESC"},
+			wantESC:         "customESC",
+			wantECOC:        "enxcol_.coll.ecoc",
+		},
+		{
+			name:            "non-string name",
+			encryptedFields: bson.D{{"escCollection", int32(1)}},
+			wantErr:         true,
+		},
+		{
+			name:            "nil encryptedFields",
+			encryptedFields: nil,
+			wantErr:         true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			esc, ecoc, err := EncryptedStateCollectionNames(tc.encryptedFields, "coll")
+			if tc.wantErr {
+				assert.NotNil(t, err, "expected EncryptedStateCollectionNames error, got nil")
+				return
+			}
+			require.NoError(t, err, "EncryptedStateCollectionNames error")
+			assert.Equal(t, tc.wantESC, esc, "expected ESC name %q, got %q", tc.wantESC, esc)
+			assert.Equal(t, tc.wantECOC, ecoc, "expected ECOC name %q, got %q", tc.wantECOC, ecoc)
+		})
+	}
+}
+
+func TestDatabase_RunCommandError(t *testing.T) {
+	mc := newMockClient(t, nil)
+
+	db := mc.Database("admin")
+
+	testCases := []struct {
+		name     string
+		reply    bson.D
+		wantCode int32
+		wantName string
+		wantMsg  string
+	}{
+		{
+			name: "int32 code",
+			reply: bson.D{
+				{"ok", 0},
+				{"code", 13},
+				{"codeName", "Unauthorized"},
+				{"errmsg", "command serverStatus requires authentication"},
+			},
+			wantCode: 13,
+			wantName: "Unauthorized",
+			wantMsg:  "command serverStatus requires authentication",
+		},
+		{
+			name: "double ok and code",
+			reply: bson.D{
+				{"ok", 0.0},
+				{"code", 59.0},
+				{"codeName", "CommandNotFound"},
+				{"errmsg", "no such command: 'fooStatus'"},
+			},
+			wantCode: 59,
+			wantName: "CommandNotFound",
+			wantMsg:  "no such command: 'fooStatus'",
+		},
+		{
+			name:     "no errmsg",
+			reply:    bson.D{{"ok", 0}, {"code", int64(8000)}},
+			wantCode: 8000,
+			wantMsg:  "command failed",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mc.md.ClearResponses()
+			mc.md.AddResponses(tc.reply)
+
+			sr := db.RunCommand(context.Background(), bson.D{{"serverStatus", 1}})
+
+			var ce CommandError
+			require.True(t, errors.As(sr.Err(), &ce), "expected CommandError, got %T: %v", sr.Err(), sr.Err())
+			assert.Equal(t, tc.wantCode, ce.Code, "expected code %d, got %d", tc.wantCode, ce.Code)
+			assert.Equal(t, tc.wantName, ce.Name, "expected code name %q, got %q", tc.wantName, ce.Name)
+			assert.Equal(t, tc.wantMsg, ce.Message, "expected message %q, got %q", tc.wantMsg, ce.Message)
+			assert.NotNil(t, ce.Raw, "expected raw server response")
+
+			var res bson.Raw
+			err := sr.Decode(&res)
+			assert.Equal(t, sr.Err(), err, "expected Decode to return the command error")
+		})
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright (C) MongoDB, Inc. 2026-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"bytes"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
+)
+
+// Diff returns an update document that transforms the document oldVal into the document newVal. Both values are
+// marshaled with the default registry. Fields that were added or whose value changed are set with $set and fields
+// that were removed are removed with $unset. When a field holds an embedded document in both versions, Diff descends
+// into it and refers to the changed fields with dotted paths (e.g. "address.city"). Any other change, including a
+// change of type and any change to an array, replaces the whole field.
+//
+// If both versions are equal, Diff returns an empty document.
+//
+// Example usage:
+//
+//	update, err := mongo.Diff(oldUser, newUser)
+//	if err != nil {
+//		return err
+//	}
+//	if len(update) > 0 {
+//		_, err = coll.UpdateOne(ctx, bson.D{{"_id", newUser.ID}}, update)
+//	}
+func Diff(oldVal, newVal interface{}) (bson.D, error) {
+	oldDoc, err := marshal(oldVal, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	newDoc, err := marshal(newVal, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var set, unset bson.D
+	if err := diffDocuments("", oldDoc, newDoc, &set, &unset); err != nil {
+		return nil, err
+	}
+
+	update := bson.D{}
+	if len(set) > 0 {
+		update = append(update, bson.E{Key: "$set", Value: set})
+	}
+	if len(unset) > 0 {
+		update = append(update, bson.E{Key: "$unset", Value: unset})
+	}
+	return update, nil
+}
+
+// diffDocuments appends the changes between oldDoc and newDoc to set and unset. The keys of the appended elements are
+// prefixed with prefix.
+func diffDocuments(prefix string, oldDoc, newDoc bsoncore.Document, set, unset *bson.D) error {
+	newElems, err := newDoc.Elements()
+	if err != nil {
+		return err
+	}
+	for _, elem := range newElems {
+		key := elem.Key()
+		path := prefix + key
+		newVal := elem.Value()
+
+		oldVal, err := oldDoc.LookupErr(key)
+		if err != nil {
+			*set = append(*set, bson.E{Key: path, Value: rawValue(newVal)})
+			continue
+		}
+
+		if oldVal.Type == bsoncore.TypeEmbeddedDocument && newVal.Type == bsoncore.TypeEmbeddedDocument {
+			err := diffDocuments(path+".", oldVal.Document(), newVal.Document(), set, unset)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		if oldVal.Type != newVal.Type || !bytes.Equal(oldVal.Data, newVal.Data) {
+			*set = append(*set, bson.E{Key: path, Value: rawValue(newVal)})
+		}
+	}
+
+	oldElems, err := oldDoc.Elements()
+	if err != nil {
+		return err
+	}
+	for _, elem := range oldElems {
+		key := elem.Key()
+		if _, err := newDoc.LookupErr(key); err != nil {
+			*unset = append(*unset, bson.E{Key: prefix + key, Value: ""})
+		}
+	}
+	return nil
+}
+
+func rawValue(val bsoncore.Value) bson.RawValue {
+	return bson.RawValue{Type: bson.Type(val.Type), Value: val.Data}
+}
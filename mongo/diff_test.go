@@ -0,0 +1,118 @@
+// Copyright (C) MongoDB, Inc. 2026-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"errors"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+)
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	type address struct {
+		City string `bson:"city"`
+		Zip  string `bson:"zip,omitempty"`
+	}
+	type user struct {
+		Name    string   `bson:"name"`
+		Age     int32    `bson:"age"`
+		Email   string   `bson:"email,omitempty"`
+		Tags    []string `bson:"tags,omitempty"`
+		Address *address `bson:"address,omitempty"`
+	}
+
+	testCases := []struct {
+		name   string
+		oldVal interface{}
+		newVal interface{}
+		want   bson.D
+	}{
+		{
+			name:   "equal",
+			oldVal: user{Name: "alice", Age: 30},
+			newVal: user{Name: "alice", Age: 30},
+			want:   bson.D{},
+		},
+		{
+			name:   "field change",
+			oldVal: user{Name: "alice", Age: 30},
+			newVal: user{Name: "alice", Age: 31},
+			want:   bson.D{{"$set", bson.D{{"age", int32(31)}}}},
+		},
+		{
+			name:   "field addition",
+			oldVal: user{Name: "alice", Age: 30},
+			newVal: user{Name: "alice", Age: 30, Email: "alice@example.com"},
+			want:   bson.D{{"$set", bson.D{{"email", "alice@example.com"}}}},
+		},
+		{
+			name:   "field removal",
+			oldVal: user{Name: "alice", Age: 30, Email: "alice@example.com"},
+			newVal: user{Name: "alice", Age: 30},
+			want:   bson.D{{"$unset", bson.D{{"email", ""}}}},
+		},
+		{
+			name:   "nested change",
+			oldVal: user{Name: "alice", Address: &address{City: "NYC", Zip: "10001"}},
+			newVal: user{Name: "alice", Address: &address{City: "Boston"}},
+			want: bson.D{
+				{"$set", bson.D{{"address.city", "Boston"}}},
+				{"$unset", bson.D{{"address.zip", ""}}},
+			},
+		},
+		{
+			name:   "nested addition",
+			oldVal: user{Name: "alice"},
+			newVal: user{Name: "alice", Address: &address{City: "NYC"}},
+			want:   bson.D{{"$set", bson.D{{"address", bson.D{{"city", "NYC"}}}}}},
+		},
+		{
+			name:   "array change",
+			oldVal: user{Name: "alice", Tags: []string{"a", "b"}},
+			newVal: user{Name: "alice", Tags: []string{"a", "c"}},
+			want:   bson.D{{"$set", bson.D{{"tags", bson.A{"a", "c"}}}}},
+		},
+		{
+			name:   "type change",
+			oldVal: bson.D{{"x", int32(1)}},
+			newVal: bson.D{{"x", bson.D{{"y", int32(1)}}}},
+			want:   bson.D{{"$set", bson.D{{"x", bson.D{{"y", int32(1)}}}}}},
+		},
+		{
+			name:   "set and unset",
+			oldVal: bson.D{{"a", int32(1)}, {"b", int32(2)}},
+			newVal: bson.D{{"a", int32(2)}, {"c", int32(3)}},
+			want: bson.D{
+				{"$set", bson.D{{"a", int32(2)}, {"c", int32(3)}}},
+				{"$unset", bson.D{{"b", ""}}},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Diff(tc.oldVal, tc.newVal)
+			require.NoError(t, err, "Diff error")
+			assertStageBytesEqual(t, tc.want, got)
+		})
+	}
+
+	t.Run("nil document", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := Diff(nil, bson.D{})
+		assert.True(t, errors.Is(err, ErrNilDocument), "expected error %v, got %v", ErrNilDocument, err)
+	})
+}
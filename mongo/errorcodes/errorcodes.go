@@ -0,0 +1,86 @@
+// Copyright (C) MongoDB, Inc. 2026-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+// Package errorcodes defines named constants for commonly encountered MongoDB server error codes.
+//
+// The constants can be passed to the HasErrorCode method of the mongo.ServerError implementations:
+//
+//	if se := mongo.ServerError(nil); errors.As(err, &se) && se.HasErrorCode(errorcodes.NamespaceNotFound) {
+//		// Handle the missing collection.
+//	}
+//
+// For the full list of server error codes, see
+// https://www.mongodb.com/docs/manual/reference/error-codes/
+package errorcodes
+
+// General command errors.
+const (
+	InternalError             = 1
+	BadValue                  = 2
+	Unauthorized              = 13
+	TypeMismatch              = 14
+	AuthenticationFailed      = 18
+	IllegalOperation          = 20
+	NamespaceNotFound         = 26
+	IndexNotFound             = 27
+	CursorNotFound            = 43
+	NamespaceExists           = 48
+	MaxTimeMSExpired          = 50
+	CommandNotFound           = 59
+	InvalidOptions            = 72
+	IndexOptionsConflict      = 85
+	IndexKeySpecsConflict     = 86
+	OperationFailed           = 96
+	DocumentValidationFailure = 121
+	ExceededTimeLimit         = 262
+	Interrupted               = 11601
+)
+
+// Duplicate key errors.
+const (
+	// DuplicateKey is returned when a write would violate a unique index.
+	DuplicateKey = 11000
+
+	// DuplicateKeyOnUpdate is returned by older servers when an update would violate a unique index.
+	DuplicateKeyOnUpdate = 11001
+
+	// DuplicateKeyCapped is returned when a write to a capped collection would violate a unique index. See
+	// SERVER-7164.
+	DuplicateKeyCapped = 12582
+
+	// MongosInsertError is returned by mongos for insert errors. It indicates a duplicate key error when the
+	// message contains "E11000". See SERVER-11493.
+	MongosInsertError = 16460
+)
+
+// Network and replica set state errors.
+const (
+	HostUnreachable                 = 6
+	HostNotFound                    = 7
+	NetworkTimeout                  = 89
+	ShutdownInProgress              = 91
+	ReadConcernMajorityNotAvailable = 134
+	PrimarySteppedDown              = 189
+	SocketException                 = 9001
+	NotWritablePrimary              = 10107
+	InterruptedAtShutdown           = 11600
+	InterruptedDueToReplStateChange = 11602
+	NotPrimaryNoSecondaryOk         = 13435
+	NotPrimaryOrSecondary           = 13436
+)
+
+// Write concern errors.
+const (
+	WriteConcernFailed        = 64
+	UnknownReplWriteConcern   = 79
+	UnsatisfiableWriteConcern = 100
+)
+
+// Transaction errors.
+const (
+	NoSuchTransaction = 251
+	TransactionTooOld = 225
+)
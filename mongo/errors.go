@@ -16,6 +16,7 @@ import (
 
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/internal/codecutil"
+	"go.mongodb.org/mongo-driver/v2/mongo/errorcodes"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/mongocrypt"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/topology"
@@ -36,6 +37,10 @@ var ErrEmptySlice = errors.New("must provide at least one element in input slice
 // ErrNotSlice is returned when a type other than slice is passed to InsertMany.
 var ErrNotSlice = errors.New("must provide a non-empty slice")
 
+// ErrConflictingUpdateSpec is returned by UpdateOne, UpdateMany, and UpdateByID when both an update document and
+// shorthand update fields (e.g. options.UpdateOneOptionsBuilder.SetSetFields) are provided.
+var ErrConflictingUpdateSpec = errors.New("cannot specify both an update document and shorthand update fields")
+
 // ErrMapForOrderedArgument is returned when a map with multiple keys is passed to a CRUD method for an ordered parameter
 type ErrMapForOrderedArgument struct {
 	ParamName string
@@ -46,6 +51,17 @@ func (e ErrMapForOrderedArgument) Error() string {
 	return fmt.Sprintf("multi-key map passed in for ordered parameter %v", e.ParamName)
 }
 
+// ErrReplacementContainsOperators is returned when a replacement document passed to a replace operation has top-level
+// keys beginning with '$', which usually means an update document was passed instead of a replacement.
+type ErrReplacementContainsOperators struct {
+	Keys []string
+}
+
+// Error implements the error interface.
+func (e ErrReplacementContainsOperators) Error() string {
+	return fmt.Sprintf("replacement document cannot contain keys beginning with '$': %v", strings.Join(e.Keys, ", "))
+}
+
 func replaceErrors(err error) error {
 	// Return nil when err is nil to avoid costly reflection logic below.
 	if err == nil {
@@ -88,7 +104,7 @@ func replaceErrors(err error) error {
 		}
 		code, err := qe.Response.LookupErr("code")
 		if err == nil {
-			ce.Code, _ = code.Int32OK()
+			ce.Code, _ = code.AsInt32OK()
 		}
 
 		return ce
@@ -111,19 +127,25 @@ func replaceErrors(err error) error {
 	return err
 }
 
-// IsDuplicateKeyError returns true if err is a duplicate key error. For BulkWriteExceptions,
-// IsDuplicateKeyError returns true if at least one of the errors is a duplicate key error.
+// ErrDuplicateKey is a sentinel error that matches duplicate key errors returned by the server. It can be used with
+// errors.Is to check CommandError, WriteError, WriteException, BulkWriteException, and ClientBulkWriteException
+// values.
+var ErrDuplicateKey = errors.New("duplicate key error")
+
+// IsDuplicateKeyError returns true if err is a duplicate key error. For WriteExceptions, BulkWriteExceptions, and
+// ClientBulkWriteExceptions, IsDuplicateKeyError returns true if at least one of the errors is a duplicate key error.
 func IsDuplicateKeyError(err error) bool {
-	if se := ServerError(nil); errors.As(err, &se) {
-		return se.HasErrorCode(11000) || // Duplicate key error.
-			se.HasErrorCode(11001) || // Duplicate key error on update.
-			// Duplicate key error in a capped collection. See SERVER-7164.
-			se.HasErrorCode(12582) ||
-			// Mongos insert error caused by a duplicate key error. See
-			// SERVER-11493.
-			se.HasErrorCodeWithMessage(16460, " E11000 ")
+	if se := ServerError(nil); errors.As(err, &se) && isDuplicateKey(se) {
+		return true
 	}
-	return false
+	return errors.Is(err, ErrDuplicateKey)
+}
+
+func isDuplicateKey(se ServerError) bool {
+	return se.HasErrorCode(errorcodes.DuplicateKey) ||
+		se.HasErrorCode(errorcodes.DuplicateKeyOnUpdate) ||
+		se.HasErrorCode(errorcodes.DuplicateKeyCapped) ||
+		se.HasErrorCodeWithMessage(errorcodes.MongosInsertError, " E11000 ")
 }
 
 // timeoutErrs is a list of error values that indicate a timeout happened.
@@ -134,6 +156,9 @@ var timeoutErrs = [...]error{
 
 // IsTimeout returns true if err was caused by a timeout. For error chains,
 // IsTimeout returns true if any error in the chain was caused by a timeout.
+// Server errors are considered timeouts if any of the contained errors,
+// including write errors and write concern errors, has the MaxTimeMSExpired
+// code.
 func IsTimeout(err error) bool {
 	// Check if the error chain contains any of the timeout error values.
 	for _, target := range timeoutErrs {
@@ -150,7 +175,10 @@ func IsTimeout(err error) bool {
 	if ce := (CommandError{}); errors.As(err, &ce) && ce.IsMaxTimeMSExpiredError() {
 		return true
 	}
-	if we := (WriteException{}); errors.As(err, &we) && we.WriteConcernError != nil && we.WriteConcernError.IsMaxTimeMSExpiredError() {
+	if se := ServerError(nil); errors.As(err, &se) && se.HasErrorCode(errorcodes.MaxTimeMSExpired) {
+		return true
+	}
+	if cbwe := (ClientBulkWriteException{}); errors.As(err, &cbwe) && cbwe.HasErrorCode(errorcodes.MaxTimeMSExpired) {
 		return true
 	}
 	if ne := net.Error(nil); errors.As(err, &ne) {
@@ -172,7 +200,9 @@ func errorHasLabel(err error, label string) bool {
 	return errors.As(err, &le) && le.HasErrorLabel(label)
 }
 
-// IsNetworkError returns true if err is a network error
+// IsNetworkError returns true if err is a network error. Network errors are
+// identified by the "NetworkError" label, which is checked on any
+// LabeledError in the error chain.
 func IsNetworkError(err error) bool {
 	return errorHasLabel(err, "NetworkError")
 }
@@ -318,7 +348,12 @@ func (e CommandError) HasErrorCodeWithMessage(code int, message string) bool {
 
 // IsMaxTimeMSExpiredError returns true if the error is a MaxTimeMSExpired error.
 func (e CommandError) IsMaxTimeMSExpiredError() bool {
-	return e.Code == 50 || e.Name == "MaxTimeMSExpired"
+	return e.Code == errorcodes.MaxTimeMSExpired || e.Name == "MaxTimeMSExpired"
+}
+
+// Is returns true if target is ErrDuplicateKey and e is a duplicate key error.
+func (e CommandError) Is(target error) bool {
+	return target == ErrDuplicateKey && isDuplicateKey(e)
 }
 
 // serverError implements the ServerError interface.
@@ -372,6 +407,11 @@ func (we WriteError) HasErrorCodeWithMessage(code int, message string) bool {
 	return we.Code == code && strings.Contains(we.Message, message)
 }
 
+// Is returns true if target is ErrDuplicateKey and we is a duplicate key error.
+func (we WriteError) Is(target error) bool {
+	return target == ErrDuplicateKey && isDuplicateKey(we)
+}
+
 // serverError implements the ServerError interface.
 func (we WriteError) serverError() {}
 
@@ -409,6 +449,7 @@ type WriteConcernError struct {
 	Code    int
 	Message string
 	Details bson.Raw
+	Labels  []string // Categories to which the write concern error belongs
 	Raw     bson.Raw // The original write concern error from the server response.
 }
 
@@ -422,7 +463,17 @@ func (wce WriteConcernError) Error() string {
 
 // IsMaxTimeMSExpiredError returns true if the error is a MaxTimeMSExpired error.
 func (wce WriteConcernError) IsMaxTimeMSExpiredError() bool {
-	return wce.Code == 50
+	return wce.Code == errorcodes.MaxTimeMSExpired
+}
+
+// HasErrorLabel returns true if the write concern error contains the specified label.
+func (wce WriteConcernError) HasErrorLabel(label string) bool {
+	for _, l := range wce.Labels {
+		if l == label {
+			return true
+		}
+	}
+	return false
 }
 
 // WriteException is the error type returned by the InsertOne, DeleteOne, DeleteMany, UpdateOne, UpdateMany, and
@@ -479,13 +530,16 @@ func (mwe WriteException) ErrorCodes() []int {
 	return errorCodes
 }
 
-// HasErrorLabel returns true if the error contains the specified label.
+// HasErrorLabel returns true if the error or its write concern error contains the specified label.
 func (mwe WriteException) HasErrorLabel(label string) bool {
 	for _, l := range mwe.Labels {
 		if l == label {
 			return true
 		}
 	}
+	if mwe.WriteConcernError != nil {
+		return mwe.WriteConcernError.HasErrorLabel(label)
+	}
 	return false
 }
 
@@ -516,6 +570,11 @@ func (mwe WriteException) HasErrorCodeWithMessage(code int, message string) bool
 	return false
 }
 
+// Is returns true if target is ErrDuplicateKey and any of the errors is a duplicate key error.
+func (mwe WriteException) Is(target error) bool {
+	return target == ErrDuplicateKey && isDuplicateKey(mwe)
+}
+
 // serverError implements the ServerError interface.
 func (mwe WriteException) serverError() {}
 
@@ -529,6 +588,7 @@ func convertDriverWriteConcernError(wce *driver.WriteConcernError) *WriteConcern
 		Code:    int(wce.Code),
 		Message: wce.Message,
 		Details: bson.Raw(wce.Details),
+		Labels:  wce.Labels,
 		Raw:     bson.Raw(wce.Raw),
 	}
 }
@@ -597,13 +657,16 @@ func (bwe BulkWriteException) ErrorCodes() []int {
 	return errorCodes
 }
 
-// HasErrorLabel returns true if the error contains the specified label.
+// HasErrorLabel returns true if the error or its write concern error contains the specified label.
 func (bwe BulkWriteException) HasErrorLabel(label string) bool {
 	for _, l := range bwe.Labels {
 		if l == label {
 			return true
 		}
 	}
+	if bwe.WriteConcernError != nil {
+		return bwe.WriteConcernError.HasErrorLabel(label)
+	}
 	return false
 }
 
@@ -634,6 +697,11 @@ func (bwe BulkWriteException) HasErrorCodeWithMessage(code int, message string)
 	return false
 }
 
+// Is returns true if target is ErrDuplicateKey and any of the errors is a duplicate key error.
+func (bwe BulkWriteException) Is(target error) bool {
+	return target == ErrDuplicateKey && isDuplicateKey(bwe)
+}
+
 // serverError implements the ServerError interface.
 func (bwe BulkWriteException) serverError() {}
 
@@ -687,6 +755,41 @@ func (bwe ClientBulkWriteException) Error() string {
 	return "bulk write exception: " + strings.Join(causes, ", ")
 }
 
+// HasErrorCode returns true if the top-level error, any of the write concern errors, or any of the write errors have
+// the specified code.
+func (bwe ClientBulkWriteException) HasErrorCode(code int) bool {
+	if bwe.WriteError != nil && bwe.WriteError.Code == code {
+		return true
+	}
+	for _, wce := range bwe.WriteConcernErrors {
+		if wce.Code == code {
+			return true
+		}
+	}
+	for _, we := range bwe.WriteErrors {
+		if we.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// Is returns true if target is ErrDuplicateKey and any of the errors is a duplicate key error.
+func (bwe ClientBulkWriteException) Is(target error) bool {
+	if target != ErrDuplicateKey {
+		return false
+	}
+	if bwe.WriteError != nil && isDuplicateKey(*bwe.WriteError) {
+		return true
+	}
+	for _, we := range bwe.WriteErrors {
+		if isDuplicateKey(we) {
+			return true
+		}
+	}
+	return false
+}
+
 // returnResult is used to determine if a function calling processWriteError should return
 // the result or return nil. Since the processWriteError function is used by many different
 // methods, both *One and *Many, we need a way to differentiate if the method should return
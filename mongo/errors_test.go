@@ -16,6 +16,7 @@ import (
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/internal/assert"
 	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/mongo/errorcodes"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/topology"
 )
@@ -153,6 +154,22 @@ func TestServerError(t *testing.T) {
 			hasCodeWithMessage: true,
 			isResult:           false,
 		},
+		{
+			name: "WriteException label in writeConcernError",
+			err: WriteException{
+				WriteConcernError: &WriteConcernError{
+					Name:    "name",
+					Code:    matchCode,
+					Message: "foo",
+					Labels:  []string{label},
+				},
+			},
+			hasCode:            true,
+			hasLabel:           true,
+			hasMessage:         true,
+			hasCodeWithMessage: true,
+			isResult:           false,
+		},
 		{
 			name: "WriteException all in writeError",
 			err: WriteException{
@@ -238,6 +255,22 @@ func TestServerError(t *testing.T) {
 			hasCodeWithMessage: true,
 			isResult:           false,
 		},
+		{
+			name: "BulkWriteException label in writeConcernError",
+			err: BulkWriteException{
+				WriteConcernError: &WriteConcernError{
+					Name:    "name",
+					Code:    matchCode,
+					Message: "foo",
+					Labels:  []string{label},
+				},
+			},
+			hasCode:            true,
+			hasLabel:           true,
+			hasMessage:         true,
+			hasCodeWithMessage: true,
+			isResult:           false,
+		},
 		{
 			name: "BulkWriteException all in writeError",
 			err: BulkWriteException{
@@ -483,6 +516,41 @@ func TestIsDuplicateKeyError(t *testing.T) {
 			err:    fmt.Errorf("%w", CommandError{Code: 11000, Name: "blah"}),
 			result: true,
 		},
+		{
+			name: "wrapped BulkWriteException",
+			err: fmt.Errorf("insert failed: %w", BulkWriteException{
+				WriteErrors: []BulkWriteError{
+					{WriteError: WriteError{Code: errorcodes.DuplicateKey}, Request: &InsertOneModel{}},
+				},
+			}),
+			result: true,
+		},
+		{
+			name: "ClientBulkWriteException top-level error",
+			err: ClientBulkWriteException{
+				WriteError: &WriteError{Code: errorcodes.DuplicateKey},
+			},
+			result: true,
+		},
+		{
+			name: "ClientBulkWriteException write errors",
+			err: ClientBulkWriteException{
+				WriteErrors: map[int]WriteError{
+					0: {Code: 100},
+					3: {Code: errorcodes.DuplicateKeyOnUpdate},
+				},
+			},
+			result: true,
+		},
+		{
+			name: "ClientBulkWriteException false",
+			err: ClientBulkWriteException{
+				WriteError:         &WriteError{Code: 100},
+				WriteConcernErrors: []WriteConcernError{{Code: errorcodes.DuplicateKey}},
+				WriteErrors:        map[int]WriteError{0: {Code: 100}},
+			},
+			result: false,
+		},
 		{
 			name:   "other error type",
 			err:    errors.New("foo"),
@@ -493,6 +561,9 @@ func TestIsDuplicateKeyError(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			res := IsDuplicateKeyError(tc.err)
 			assert.Equal(t, res, tc.result, "expected IsDuplicateKeyError %v, got %v", tc.result, res)
+
+			res = errors.Is(tc.err, ErrDuplicateKey)
+			assert.Equal(t, res, tc.result, "expected errors.Is(err, ErrDuplicateKey) %v, got %v", tc.result, res)
 		})
 	}
 }
@@ -532,6 +603,29 @@ func TestIsNetworkError(t *testing.T) {
 			}),
 			result: true,
 		},
+		{
+			name: "WriteException label",
+			err: WriteException{
+				WriteErrors: WriteErrors{{Code: 100}},
+				Labels:      []string{networkLabel},
+			},
+			result: true,
+		},
+		{
+			name: "wrapped BulkWriteException label",
+			err: fmt.Errorf("%w", BulkWriteException{
+				WriteErrors: []BulkWriteError{{WriteError: WriteError{Code: 100}}},
+				Labels:      []string{networkLabel},
+			}),
+			result: true,
+		},
+		{
+			name: "BulkWriteException write concern error label",
+			err: BulkWriteException{
+				WriteConcernError: &WriteConcernError{Code: 100, Labels: []string{networkLabel}},
+			},
+			result: true,
+		},
 		{
 			name:   "other error type",
 			err:    errors.New("foo"),
@@ -653,6 +747,53 @@ func TestIsTimeout(t *testing.T) {
 			err:    netErr{false},
 			result: false,
 		},
+		{
+			name:   "CommandError MaxTimeMSExpired",
+			err:    CommandError{Code: errorcodes.MaxTimeMSExpired},
+			result: true,
+		},
+		{
+			name: "WriteException write concern MaxTimeMSExpired",
+			err: WriteException{
+				WriteConcernError: &WriteConcernError{Code: errorcodes.MaxTimeMSExpired},
+			},
+			result: true,
+		},
+		{
+			name: "WriteException write error MaxTimeMSExpired",
+			err: WriteException{
+				WriteErrors: WriteErrors{{Code: errorcodes.MaxTimeMSExpired}},
+			},
+			result: true,
+		},
+		{
+			name: "wrapped BulkWriteException write concern MaxTimeMSExpired",
+			err: fmt.Errorf("%w", BulkWriteException{
+				WriteConcernError: &WriteConcernError{Code: errorcodes.MaxTimeMSExpired},
+			}),
+			result: true,
+		},
+		{
+			name: "BulkWriteException write error MaxTimeMSExpired",
+			err: BulkWriteException{
+				WriteErrors: []BulkWriteError{{WriteError: WriteError{Code: errorcodes.MaxTimeMSExpired}}},
+			},
+			result: true,
+		},
+		{
+			name: "ClientBulkWriteException MaxTimeMSExpired",
+			err: ClientBulkWriteException{
+				WriteConcernErrors: []WriteConcernError{{Code: errorcodes.MaxTimeMSExpired}},
+			},
+			result: true,
+		},
+		{
+			name: "BulkWriteException false",
+			err: BulkWriteException{
+				WriteErrors: []BulkWriteError{{WriteError: WriteError{Code: errorcodes.DuplicateKey}}},
+			},
+			result: false,
+		},
 		{
 			name: "wrapped error",
 			err: fmt.Errorf("%w", CommandError{
@@ -0,0 +1,26 @@
+// Copyright (C) MongoDB, Inc. 2026-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+// ExplainVerbosity is the verbosity mode of an explain command. It determines how much information the server
+// returns about the explained command.
+//
+// See https://www.mongodb.com/docs/manual/reference/command/explain/#verbosity-modes for more information.
+type ExplainVerbosity string
+
+// These constants specify the valid explain verbosity modes.
+const (
+	// QueryPlanner runs the query optimizer to choose the winning plan and returns it without executing the command.
+	QueryPlanner ExplainVerbosity = "queryPlanner"
+
+	// ExecutionStats chooses and executes the winning plan and returns statistics describing its execution.
+	ExecutionStats ExplainVerbosity = "executionStats"
+
+	// AllPlansExecution behaves like ExecutionStats and additionally returns statistics for the other candidate plans
+	// considered during plan selection.
+	AllPlansExecution ExplainVerbosity = "allPlansExecution"
+)
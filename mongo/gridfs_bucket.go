@@ -210,9 +210,15 @@ func (b *GridFSBucket) OpenDownloadStreamByName(
 		return nil, fmt.Errorf("failed to construct options from builder: %w", err)
 	}
 
+	return b.openDownloadStream(ctx, bson.D{{"filename", filename}}, revisionFindOneOptions(args.Revision))
+}
+
+// revisionFindOneOptions returns the find options that select the given revision of a file from the files
+// collection (see options.GridFSNameOptions.Revision). If revision is nil, options.DefaultRevision is used.
+func revisionFindOneOptions(revision *int32) *options.FindOneOptionsBuilder {
 	numSkip := options.DefaultRevision
-	if args.Revision != nil {
-		numSkip = *args.Revision
+	if revision != nil {
+		numSkip = *revision
 	}
 
 	var sortOrder int32 = 1
@@ -222,9 +228,7 @@ func (b *GridFSBucket) OpenDownloadStreamByName(
 		numSkip = (-1 * numSkip) - 1
 	}
 
-	findOpts := options.FindOne().SetSkip(int64(numSkip)).SetSort(bson.D{{"uploadDate", sortOrder}})
-
-	return b.openDownloadStream(ctx, bson.D{{"filename", filename}}, findOpts)
+	return options.FindOne().SetSkip(int64(numSkip)).SetSort(bson.D{{"uploadDate", sortOrder}})
 }
 
 // DownloadToStreamByName downloads the file with the given name to the given
@@ -305,6 +309,116 @@ func (b *GridFSBucket) Find(
 	return b.filesColl.Find(ctx, filter, find)
 }
 
+// FindFiles returns the files collection documents that match the given filter
+// as GridFSFile values. It runs the underlying find query with the provided
+// context and options, and decodes all results before returning.
+func (b *GridFSBucket) FindFiles(
+	ctx context.Context,
+	filter interface{},
+	opts ...options.Lister[options.GridFSFindOptions],
+) ([]GridFSFile, error) {
+	ctx, cancel := csot.WithTimeout(ctx, b.db.client.timeout)
+	defer cancel()
+
+	cursor, err := b.Find(ctx, filter, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var resps []findFileResponse
+	if err := cursor.All(ctx, &resps); err != nil {
+		return nil, fmt.Errorf("error decoding files collection documents: %w", err)
+	}
+
+	files := make([]GridFSFile, 0, len(resps))
+	for _, resp := range resps {
+		files = append(files, *newFileFromResponse(resp))
+	}
+	return files, nil
+}
+
+// FindOneFile returns the first files collection document that matches the
+// given filter as a GridFSFile. The Sort and Skip options can be used to select
+// which document is returned. If no document matches, ErrFileNotFound is
+// returned.
+func (b *GridFSBucket) FindOneFile(
+	ctx context.Context,
+	filter interface{},
+	opts ...options.Lister[options.GridFSFindOptions],
+) (*GridFSFile, error) {
+	ctx, cancel := csot.WithTimeout(ctx, b.db.client.timeout)
+	defer cancel()
+
+	// Append a limit after the user-provided options so that it takes precedence.
+	opts = append(opts, options.GridFSFind().SetLimit(1))
+
+	files, err := b.FindFiles(ctx, filter, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, ErrFileNotFound
+	}
+	return &files[0], nil
+}
+
+// FindOneByName returns the files collection document for the file with the
+// given filename as a GridFSFile. The Revision option selects which revision of
+// the file is returned and defaults to the most recent revision (-1). If no
+// matching revision exists, ErrFileNotFound is returned.
+func (b *GridFSBucket) FindOneByName(
+	ctx context.Context,
+	filename string,
+	opts ...options.Lister[options.GridFSNameOptions],
+) (*GridFSFile, error) {
+	args, err := mongoutil.NewOptions[options.GridFSNameOptions](opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to construct options from builder: %w", err)
+	}
+
+	ctx, cancel := csot.WithTimeout(ctx, b.db.client.timeout)
+	defer cancel()
+
+	result := b.filesColl.FindOne(ctx, bson.D{{"filename", filename}}, revisionFindOneOptions(args.Revision))
+
+	var resp findFileResponse
+	if err := result.Decode(&resp); err != nil {
+		if errors.Is(err, ErrNoDocuments) {
+			return nil, ErrFileNotFound
+		}
+
+		return nil, fmt.Errorf("error decoding files collection document: %w", err)
+	}
+	return newFileFromResponse(resp), nil
+}
+
+// DecodeFileMetadata decodes the Metadata of the given file into val using the
+// BSON options and registry of the bucket's database. If the file has no
+// metadata, val is not modified.
+func (b *GridFSBucket) DecodeFileMetadata(file *GridFSFile, val interface{}) error {
+	if file == nil || len(file.Metadata) == 0 {
+		return nil
+	}
+
+	dec := getDecoder(file.Metadata, b.filesColl.bsonOpts, b.filesColl.registry)
+	return dec.Decode(val)
+}
+
+// FileExists returns true if at least one file with the given filename is
+// stored in the bucket.
+func (b *GridFSBucket) FileExists(ctx context.Context, filename string) (bool, error) {
+	findOpts := options.FindOne().SetProjection(bson.D{{"_id", 1}})
+
+	err := b.filesColl.FindOne(ctx, bson.D{{"filename", filename}}, findOpts).Err()
+	if errors.Is(err, ErrNoDocuments) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 // Rename renames the stored file with the specified file ID.
 func (b *GridFSBucket) Rename(ctx context.Context, fileID interface{}, newFilename string) error {
 	res, err := b.filesColl.UpdateOne(ctx,
@@ -8,11 +8,13 @@ package mongo
 
 import (
 	"context"
+	"reflect"
 	"testing"
 
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/internal/assert"
 	"go.mongodb.org/mongo-driver/v2/internal/integtest"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 )
 
@@ -52,3 +54,40 @@ func TestBucket_openDownloadStream(t *testing.T) {
 		})
 	}
 }
+
+func TestBucket_DecodeFileMetadata(t *testing.T) {
+	type myInt int64
+
+	reg := bson.NewRegistry()
+	reg.RegisterTypeDecoder(reflect.TypeOf(myInt(0)), bson.ValueDecoderFunc(
+		func(_ bson.DecodeContext, vr bson.ValueReader, val reflect.Value) error {
+			i32, err := vr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			val.SetInt(int64(i32) * 10)
+			return nil
+		}))
+
+	client, err := newClient()
+	require.NoError(t, err, "newClient error")
+	bucket := client.Database("bucket", options.Database().SetRegistry(reg)).GridFSBucket()
+
+	metadata, err := bson.Marshal(bson.D{{"x", int32(4)}})
+	require.NoError(t, err, "Marshal error")
+
+	t.Run("uses database registry", func(t *testing.T) {
+		var got struct {
+			X myInt `bson:"x"`
+		}
+		err := bucket.DecodeFileMetadata(&GridFSFile{Metadata: metadata}, &got)
+		require.NoError(t, err, "DecodeFileMetadata error")
+		assert.Equal(t, myInt(40), got.X, "expected value %v, got %v", myInt(40), got.X)
+	})
+	t.Run("no metadata", func(t *testing.T) {
+		got := bson.D{{"y", 1}}
+		err := bucket.DecodeFileMetadata(&GridFSFile{}, &got)
+		require.NoError(t, err, "DecodeFileMetadata error")
+		assert.Equal(t, bson.D{{"y", 1}}, got, "expected value to be unmodified, got %v", got)
+	})
+}
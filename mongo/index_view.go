@@ -13,6 +13,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/internal/mongoutil"
 	"go.mongodb.org/mongo-driver/v2/internal/serverselector"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
@@ -63,7 +64,7 @@ func (iv IndexView) List(ctx context.Context, opts ...options.Lister[options.Lis
 	}
 
 	sess := sessionFromContext(ctx)
-	if sess == nil && iv.coll.client.sessionPool != nil {
+	if sess == nil && iv.coll.client.sessionPool != nil && !implicitSessionDisabled(ctx) {
 		sess = session.NewImplicitClientSession(iv.coll.client.sessionPool, iv.coll.client.id)
 	}
 
@@ -87,7 +88,8 @@ func (iv IndexView) List(ctx context.Context, opts ...options.Lister[options.Lis
 		ServerSelector(selector).ClusterClock(iv.coll.client.clock).
 		Database(iv.coll.db.name).Collection(iv.coll.name).
 		Deployment(iv.coll.client.deployment).ServerAPI(iv.coll.client.serverAPI).
-		Timeout(iv.coll.client.timeout).Crypt(iv.coll.client.cryptFLE).Authenticator(iv.coll.client.authenticator)
+		Timeout(iv.coll.client.timeout).Crypt(iv.coll.client.cryptFLE).Logger(iv.coll.client.logger).
+		Authenticator(iv.coll.client.authenticator)
 
 	cursorOpts := iv.coll.client.createBaseCursorOptions()
 
@@ -109,7 +111,7 @@ func (iv IndexView) List(ctx context.Context, opts ...options.Lister[options.Lis
 	}
 	op.Retry(retry)
 
-	err = op.Execute(ctx)
+	err = op.Execute(iv.coll.withConcernSources(ctx))
 	if err != nil {
 		// for namespaceNotFound errors, return an empty cursor and do not throw an error
 		closeImplicitSession(sess)
@@ -127,6 +129,7 @@ func (iv IndexView) List(ctx context.Context, opts ...options.Lister[options.Lis
 		return nil, replaceErrors(err)
 	}
 	cursor, err := newCursorWithSession(bc, iv.coll.bsonOpts, iv.coll.registry, sess)
+	iv.coll.client.cursorReaper.track(cursor, iv.coll.db.name+"."+iv.coll.name)
 	return cursor, replaceErrors(err)
 }
 
@@ -179,7 +182,8 @@ func (iv IndexView) CreateOne(
 // given, it will be generated from the Keys document.
 //
 // The opts parameter can be used to specify options for this operation (see the options.CreateIndexesOptions
-// documentation).
+// documentation). If the IgnoreConflicts option is used, the returned names include the names of indexes that were
+// skipped because they already existed. Use CreateManyWithResult to tell created and skipped indexes apart.
 //
 // For more information about the command, see https://www.mongodb.com/docs/manual/reference/command/createIndexes/.
 func (iv IndexView) CreateMany(
@@ -187,36 +191,72 @@ func (iv IndexView) CreateMany(
 	models []IndexModel,
 	opts ...options.Lister[options.CreateIndexesOptions],
 ) ([]string, error) {
-	names := make([]string, 0, len(models))
+	names, _, err := iv.createMany(ctx, models, opts...)
+	if err != nil {
+		return nil, err
+	}
 
-	var indexes bsoncore.Document
-	aidx, indexes := bsoncore.AppendArrayStart(indexes)
+	return names, nil
+}
+
+// CreateManyWithResult is like CreateMany but returns a CreateManyResult that lists the names of the indexes that were
+// created and the names of the indexes that were skipped because an identical index already existed. Indexes are only
+// skipped if the IgnoreConflicts option is used.
+func (iv IndexView) CreateManyWithResult(
+	ctx context.Context,
+	models []IndexModel,
+	opts ...options.Lister[options.CreateIndexesOptions],
+) (*CreateManyResult, error) {
+	_, res, err := iv.createMany(ctx, models, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// indexToCreate is an index from an IndexModel that has been validated and marshalled.
+type indexToCreate struct {
+	name string
+	keys bsoncore.Document
+	// opts holds the option elements of the index, including its name, without a document header.
+	opts []byte
+}
 
-	for i, model := range models {
+// createMany creates the indexes for models. It returns the names of all of the indexes in the same order as models,
+// as well as the result that splits them into created and skipped indexes.
+func (iv IndexView) createMany(
+	ctx context.Context,
+	models []IndexModel,
+	opts ...options.Lister[options.CreateIndexesOptions],
+) ([]string, *CreateManyResult, error) {
+	args, err := mongoutil.NewOptions[options.CreateIndexesOptions](opts...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to construct options from builder: %w", err)
+	}
+
+	names := make([]string, 0, len(models))
+	toCreate := make([]indexToCreate, 0, len(models))
+
+	for _, model := range models {
 		if model.Keys == nil {
-			return nil, fmt.Errorf("index model keys cannot be nil")
+			return nil, nil, fmt.Errorf("index model keys cannot be nil")
 		}
 
 		if isUnorderedMap(model.Keys) {
-			return nil, ErrMapForOrderedArgument{"keys"}
+			return nil, nil, ErrMapForOrderedArgument{"keys"}
 		}
 
 		keys, err := marshal(model.Keys, iv.coll.bsonOpts, iv.coll.registry)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		name, err := getOrGenerateIndexName(keys, model)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
-		names = append(names, name)
-
-		var iidx int32
-		iidx, indexes = bsoncore.AppendDocumentElementStart(indexes, strconv.Itoa(i))
-		indexes = bsoncore.AppendDocumentElement(indexes, "key", keys)
-
 		if model.Options == nil {
 			model.Options = options.Index()
 		}
@@ -224,32 +264,61 @@ func (iv IndexView) CreateMany(
 
 		optsDoc, err := iv.createOptionsDoc(model.Options)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
-		indexes = bsoncore.AppendDocument(indexes, optsDoc)
+		names = append(names, name)
+		toCreate = append(toCreate, indexToCreate{name: name, keys: keys, opts: optsDoc})
+	}
+
+	res := &CreateManyResult{}
+
+	ignoreConflicts := args.IgnoreConflicts != nil && *args.IgnoreConflicts
+	if args.ErrorOnConflict != nil {
+		ignoreConflicts = !*args.ErrorOnConflict
+	}
+	if ignoreConflicts {
+		toCreate, res.Skipped, err = iv.removeExistingIndexes(ctx, toCreate)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// There is nothing to send if all of the indexes already exist.
+	if len(toCreate) == 0 {
+		return names, res, nil
+	}
+
+	var indexes bsoncore.Document
+	aidx, indexes := bsoncore.AppendArrayStart(indexes)
+
+	for i, index := range toCreate {
+		var iidx int32
+		iidx, indexes = bsoncore.AppendDocumentElementStart(indexes, strconv.Itoa(i))
+		indexes = bsoncore.AppendDocumentElement(indexes, "key", index.keys)
+		indexes = append(indexes, index.opts...)
 
 		indexes, err = bsoncore.AppendDocumentEnd(indexes, iidx)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
 
-	indexes, err := bsoncore.AppendArrayEnd(indexes, aidx)
+	indexes, err = bsoncore.AppendArrayEnd(indexes, aidx)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	sess := sessionFromContext(ctx)
 
-	if sess == nil && iv.coll.client.sessionPool != nil {
+	if sess == nil && iv.coll.client.sessionPool != nil && !implicitSessionDisabled(ctx) {
 		sess = session.NewImplicitClientSession(iv.coll.client.sessionPool, iv.coll.client.id)
 		defer sess.EndSession()
 	}
 
 	err = iv.coll.client.validSession(sess)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	wc := iv.coll.writeConcern
@@ -262,31 +331,161 @@ func (iv IndexView) CreateMany(
 
 	selector := makePinnedSelector(sess, iv.coll.writeSelector)
 
-	args, err := mongoutil.NewOptions[options.CreateIndexesOptions](opts...)
-	if err != nil {
-		return nil, fmt.Errorf("failed to construct options from builder: %w", err)
-	}
-
 	op := operation.NewCreateIndexes(indexes).
 		Session(sess).WriteConcern(wc).ClusterClock(iv.coll.client.clock).
 		Database(iv.coll.db.name).Collection(iv.coll.name).CommandMonitor(iv.coll.client.monitor).
 		Deployment(iv.coll.client.deployment).ServerSelector(selector).ServerAPI(iv.coll.client.serverAPI).
-		Timeout(iv.coll.client.timeout).Crypt(iv.coll.client.cryptFLE).Authenticator(iv.coll.client.authenticator)
+		Timeout(iv.coll.client.timeout).Crypt(iv.coll.client.cryptFLE).Logger(iv.coll.client.logger).
+		Authenticator(iv.coll.client.authenticator)
 	if args.CommitQuorum != nil {
 		commitQuorum, err := marshalValue(args.CommitQuorum, iv.coll.bsonOpts, iv.coll.registry)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		op.CommitQuorum(commitQuorum)
 	}
 
-	_, err = processWriteError(op.Execute(ctx))
+	_, err = processWriteError(op.Execute(iv.coll.withConcernSources(ctx)))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, index := range toCreate {
+		res.Created = append(res.Created, index.name)
+	}
+
+	return names, res, nil
+}
+
+// removeExistingIndexes lists the indexes on the collection and removes the indexes from toCreate that already exist
+// with the same name, keys, and options. It returns the remaining indexes and the names of the removed indexes.
+func (iv IndexView) removeExistingIndexes(
+	ctx context.Context,
+	toCreate []indexToCreate,
+) ([]indexToCreate, []string, error) {
+	cursor, err := iv.List(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var specs []bson.Raw
+	if err := cursor.All(ctx, &specs); err != nil {
+		return nil, nil, err
+	}
+
+	existing := make(map[string]bsoncore.Document, len(specs))
+	for _, spec := range specs {
+		if name, ok := spec.Lookup("name").StringValueOK(); ok {
+			existing[name] = bsoncore.Document(spec)
+		}
+	}
+
+	remaining := toCreate[:0]
+	var skipped []string
+	for _, index := range toCreate {
+		spec, ok := existing[index.name]
+		if !ok || !indexMatchesSpecification(index, spec) {
+			// Let the server create the index or report the conflict.
+			remaining = append(remaining, index)
+			continue
+		}
+
+		skipped = append(skipped, index.name)
+	}
+
+	return remaining, skipped, nil
+}
+
+// indexMatchesSpecification returns true if index has the same keys and options as the existing index described by
+// the listIndexes document spec. Every option in either document is compared, so an index is only reported as a match
+// if the server would treat the createIndexes command as a no-op. Options that the server fills in with defaults,
+// such as the weights of a text index or the fields of a collation, make the documents differ; those indexes are sent
+// to the server, which either ignores them or reports the conflict.
+func indexMatchesSpecification(index indexToCreate, spec bsoncore.Document) bool {
+	keys, ok := spec.Lookup("key").DocumentOK()
+	if !ok || !indexKeysEqual(index.keys, keys) {
+		return false
+	}
+
+	want, err := indexOptionElements(bsoncore.BuildDocument(nil, index.opts))
+	if err != nil {
+		return false
+	}
+	got, err := indexOptionElements(spec)
+	if err != nil {
+		return false
+	}
+	// The server reports the index version even when it was not requested.
+	if _, ok := want["v"]; !ok {
+		delete(got, "v")
+	}
+	if len(want) != len(got) {
+		return false
+	}
+
+	for key, v1 := range want {
+		v2, ok := got[key]
+		if !ok || !numericAwareEqual(v1, v2) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// indexOptionElements returns the options in the index document doc, keyed by name. The name, key, and ns fields are
+// not options and are excluded. Boolean options set to false, such as unique or sparse, are excluded because they are
+// equivalent to not setting the option.
+func indexOptionElements(doc bsoncore.Document) (map[string]bsoncore.Value, error) {
+	elems, err := doc.Elements()
 	if err != nil {
 		return nil, err
 	}
 
-	return names, nil
+	opts := make(map[string]bsoncore.Value, len(elems))
+	for _, elem := range elems {
+		switch elem.Key() {
+		case "name", "key", "ns":
+			continue
+		}
+
+		val := elem.Value()
+		if b, ok := val.BooleanOK(); ok && !b {
+			continue
+		}
+		opts[elem.Key()] = val
+	}
+
+	return opts, nil
+}
+
+// indexKeysEqual returns true if the key pattern documents k1 and k2 have the same fields in the same order with equal
+// values. Numeric values are compared by value so that, for example, an int32 1 is equal to an int64 1.
+func indexKeysEqual(k1, k2 bsoncore.Document) bool {
+	elems1, err := k1.Elements()
+	if err != nil {
+		return false
+	}
+	elems2, err := k2.Elements()
+	if err != nil {
+		return false
+	}
+	if len(elems1) != len(elems2) {
+		return false
+	}
+
+	for i := range elems1 {
+		if elems1[i].Key() != elems2[i].Key() {
+			return false
+		}
+
+		if !numericAwareEqual(elems1[i].Value(), elems2[i].Value()) {
+			return false
+		}
+	}
+
+	return true
 }
 
 func (iv IndexView) createOptionsDoc(opts options.Lister[options.IndexOptions]) (bsoncore.Document, error) {
@@ -383,7 +582,7 @@ func (iv IndexView) drop(ctx context.Context, index any, _ ...options.Lister[opt
 	}
 
 	sess := sessionFromContext(ctx)
-	if sess == nil && iv.coll.client.sessionPool != nil {
+	if sess == nil && iv.coll.client.sessionPool != nil && !implicitSessionDisabled(ctx) {
 		sess = session.NewImplicitClientSession(iv.coll.client.sessionPool, iv.coll.client.id)
 		defer sess.EndSession()
 	}
@@ -407,9 +606,10 @@ func (iv IndexView) drop(ctx context.Context, index any, _ ...options.Lister[opt
 		ServerSelector(selector).ClusterClock(iv.coll.client.clock).
 		Database(iv.coll.db.name).Collection(iv.coll.name).
 		Deployment(iv.coll.client.deployment).ServerAPI(iv.coll.client.serverAPI).
-		Timeout(iv.coll.client.timeout).Crypt(iv.coll.client.cryptFLE).Authenticator(iv.coll.client.authenticator)
+		Timeout(iv.coll.client.timeout).Crypt(iv.coll.client.cryptFLE).Logger(iv.coll.client.logger).
+		Authenticator(iv.coll.client.authenticator)
 
-	err = op.Execute(ctx)
+	err = op.Execute(iv.coll.withConcernSources(ctx))
 	if err != nil {
 		return replaceErrors(err)
 	}
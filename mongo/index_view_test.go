@@ -0,0 +1,200 @@
+// Copyright (C) MongoDB, Inc. 2026-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+func TestIndexView_CreateManyIgnoreConflicts(t *testing.T) {
+	mc := newMockClient(t, nil)
+
+	iv := mc.Database(testDbName).Collection("coll").Indexes()
+	ns := testDbName + ".coll"
+
+	listResponse := func(specs ...bson.D) bson.D {
+		batch := bson.A{}
+		for _, spec := range specs {
+			batch = append(batch, spec)
+		}
+		return bson.D{{"ok", 1}, {"cursor", bson.D{{"id", int64(0)}, {"ns", ns}, {"firstBatch", batch}}}}
+	}
+	idSpec := bson.D{{"v", 2}, {"key", bson.D{{"_id", 1}}}, {"name", "_id_"}}
+	fooSpec := bson.D{{"v", 2}, {"key", bson.D{{"foo", 1}}}, {"name", "foo_1"}}
+	barSpec := bson.D{{"v", 2}, {"key", bson.D{{"bar", int64(-1)}}}, {"name", "bar_-1"}, {"unique", true}}
+
+	fooModel := IndexModel{Keys: bson.D{{"foo", 1}}}
+	barModel := IndexModel{Keys: bson.D{{"bar", -1}}, Options: options.Index().SetUnique(true)}
+
+	// createdIndexNames returns the names of the indexes sent in the createIndexes command, if any.
+	createdIndexNames := func(t *testing.T) []string {
+		t.Helper()
+
+		for _, evt := range mc.started {
+			if evt.CommandName != "createIndexes" {
+				continue
+			}
+
+			var cmd struct {
+				Indexes []struct {
+					Name string `bson:"name"`
+				} `bson:"indexes"`
+			}
+			require.NoError(t, bson.Unmarshal(evt.Command, &cmd), "Unmarshal error")
+
+			names := make([]string, 0, len(cmd.Indexes))
+			for _, index := range cmd.Indexes {
+				names = append(names, index.Name)
+			}
+			return names
+		}
+		return nil
+	}
+
+	testCases := []struct {
+		name        string
+		existing    []bson.D
+		models      []IndexModel
+		opts        *options.CreateIndexesOptionsBuilder
+		wantSent    []string
+		wantSkipped []string
+	}{
+		{
+			name:     "all new",
+			existing: []bson.D{idSpec},
+			models:   []IndexModel{fooModel, barModel},
+			opts:     options.CreateIndexes().SetIgnoreConflicts(true),
+			wantSent: []string{"foo_1", "bar_-1"},
+		},
+		{
+			name:        "all existing and identical",
+			existing:    []bson.D{idSpec, fooSpec, barSpec},
+			models:      []IndexModel{fooModel, barModel},
+			opts:        options.CreateIndexes().SetIgnoreConflicts(true),
+			wantSkipped: []string{"foo_1", "bar_-1"},
+		},
+		{
+			name:        "mixed",
+			existing:    []bson.D{idSpec, fooSpec},
+			models:      []IndexModel{fooModel, barModel},
+			opts:        options.CreateIndexes().SetIgnoreConflicts(true),
+			wantSent:    []string{"bar_-1"},
+			wantSkipped: []string{"foo_1"},
+		},
+		{
+			name:     "same name with different keys",
+			existing: []bson.D{idSpec, {{"v", 2}, {"key", bson.D{{"baz", 1}}}, {"name", "foo_1"}}},
+			models:   []IndexModel{fooModel},
+			opts:     options.CreateIndexes().SetIgnoreConflicts(true),
+			wantSent: []string{"foo_1"},
+		},
+		{
+			name:     "same name with different options",
+			existing: []bson.D{idSpec, {{"v", 2}, {"key", bson.D{{"bar", -1}}}, {"name", "bar_-1"}}},
+			models:   []IndexModel{barModel},
+			opts:     options.CreateIndexes().SetIgnoreConflicts(true),
+			wantSent: []string{"bar_-1"},
+		},
+		{
+			name: "same name with different partial filter",
+			existing: []bson.D{idSpec, {
+				{"v", 2}, {"key", bson.D{{"foo", 1}}}, {"name", "foo_1"},
+				{"partialFilterExpression", bson.D{{"x", bson.D{{"$gt", 1}}}}},
+			}},
+			models: []IndexModel{{
+				Keys:    bson.D{{"foo", 1}},
+				Options: options.Index().SetPartialFilterExpression(bson.D{{"x", bson.D{{"$gt", 2}}}}),
+			}},
+			opts:     options.CreateIndexes().SetIgnoreConflicts(true),
+			wantSent: []string{"foo_1"},
+		},
+		{
+			name: "existing index has an option that was not requested",
+			existing: []bson.D{idSpec, {
+				{"v", 2}, {"key", bson.D{{"foo", 1}}}, {"name", "foo_1"},
+				{"collation", bson.D{{"locale", "fr"}}},
+			}},
+			models:   []IndexModel{fooModel},
+			opts:     options.CreateIndexes().SetIgnoreConflicts(true),
+			wantSent: []string{"foo_1"},
+		},
+		{
+			name:     "same name with different hidden",
+			existing: []bson.D{idSpec, fooSpec},
+			models:   []IndexModel{{Keys: bson.D{{"foo", 1}}, Options: options.Index().SetHidden(true)}},
+			opts:     options.CreateIndexes().SetIgnoreConflicts(true),
+			wantSent: []string{"foo_1"},
+		},
+		{
+			name: "identical options",
+			existing: []bson.D{idSpec, {
+				{"v", 2}, {"key", bson.D{{"foo", 1}}}, {"name", "foo_1"},
+				{"expireAfterSeconds", int64(60)},
+				{"partialFilterExpression", bson.D{{"x", bson.D{{"$gt", 1}}}}},
+			}},
+			models: []IndexModel{{
+				Keys: bson.D{{"foo", 1}},
+				Options: options.Index().
+					SetExpireAfterSeconds(60).
+					SetUnique(false).
+					SetPartialFilterExpression(bson.D{{"x", bson.D{{"$gt", 1}}}}),
+			}},
+			opts:        options.CreateIndexes().SetIgnoreConflicts(true),
+			wantSkipped: []string{"foo_1"},
+		},
+		{
+			name:     "error on conflict takes precedence",
+			models:   []IndexModel{fooModel},
+			opts:     options.CreateIndexes().SetIgnoreConflicts(true).SetErrorOnConflict(true),
+			wantSent: []string{"foo_1"},
+		},
+		{
+			name:        "error on conflict false",
+			existing:    []bson.D{idSpec, fooSpec},
+			models:      []IndexModel{fooModel},
+			opts:        options.CreateIndexes().SetErrorOnConflict(false),
+			wantSkipped: []string{"foo_1"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mc.started = nil
+			mc.md.ClearResponses()
+			if tc.existing != nil {
+				mc.md.AddResponses(listResponse(tc.existing...))
+			}
+			if len(tc.wantSent) > 0 {
+				mc.md.AddResponses(bson.D{{"ok", 1}})
+			}
+
+			res, err := iv.CreateManyWithResult(context.Background(), tc.models, tc.opts)
+			require.NoError(t, err, "CreateManyWithResult error")
+
+			assert.Equal(t, tc.wantSent, res.Created, "expected created indexes %v, got %v", tc.wantSent, res.Created)
+			assert.Equal(t, tc.wantSkipped, res.Skipped, "expected skipped indexes %v, got %v",
+				tc.wantSkipped, res.Skipped)
+			sent := createdIndexNames(t)
+			assert.Equal(t, tc.wantSent, sent, "expected indexes %v to be sent, got %v", tc.wantSent, sent)
+		})
+	}
+
+	t.Run("CreateMany returns all names", func(t *testing.T) {
+		mc.md.ClearResponses()
+		mc.md.AddResponses(listResponse(idSpec, fooSpec), bson.D{{"ok", 1}})
+
+		names, err := iv.CreateMany(context.Background(), []IndexModel{fooModel, barModel},
+			options.CreateIndexes().SetIgnoreConflicts(true))
+		require.NoError(t, err, "CreateMany error")
+		assert.Equal(t, []string{"foo_1", "bar_-1"}, names, "expected names of all indexes")
+	})
+}
@@ -0,0 +1,85 @@
+// Copyright (C) MongoDB, Inc. 2026-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/event"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/drivertest"
+)
+
+// mockClient is a Client connected to a mock deployment that records the CommandStartedEvent of every command it
+// sends.
+type mockClient struct {
+	*Client
+	md *drivertest.MockDeployment
+
+	mu      sync.Mutex
+	started []*event.CommandStartedEvent
+
+	disconnectOnce sync.Once
+}
+
+// newMockClient connects a Client configured with clientOpts, which may be nil, to a new mock deployment. Any command
+// monitor set in clientOpts is still called. The Client is disconnected when the test finishes.
+func newMockClient(tb testing.TB, clientOpts *options.ClientOptions) *mockClient {
+	tb.Helper()
+
+	if clientOpts == nil {
+		clientOpts = options.Client()
+	}
+
+	mc := &mockClient{md: drivertest.NewMockDeployment()}
+	monitor := &event.CommandMonitor{}
+	if clientOpts.Monitor != nil {
+		*monitor = *clientOpts.Monitor
+	}
+	started := monitor.Started
+	monitor.Started = func(ctx context.Context, evt *event.CommandStartedEvent) {
+		mc.mu.Lock()
+		mc.started = append(mc.started, evt)
+		mc.mu.Unlock()
+
+		if started != nil {
+			started(ctx, evt)
+		}
+	}
+	clientOpts.SetMonitor(monitor)
+	clientOpts.Deployment = mc.md
+
+	client, err := Connect(clientOpts)
+	require.NoError(tb, err, "Connect error")
+	mc.Client = client
+	tb.Cleanup(func() { _ = mc.Disconnect(context.Background()) })
+
+	return mc
+}
+
+// Disconnect disconnects the Client. Unlike Client.Disconnect, it may be called more than once, so tests can
+// disconnect before the cleanup registered by newMockClient runs.
+func (mc *mockClient) Disconnect(ctx context.Context) error {
+	var err error
+	mc.disconnectOnce.Do(func() { err = mc.Client.Disconnect(ctx) })
+	return err
+}
+
+// commandNames returns the names of the commands the client has started, in order.
+func (mc *mockClient) commandNames() []string {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+
+	names := make([]string, 0, len(mc.started))
+	for _, evt := range mc.started {
+		names = append(names, evt.CommandName)
+	}
+	return names
+}
@@ -12,13 +12,20 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
+	"math/big"
 	"net"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 
 	"go.mongodb.org/mongo-driver/v2/internal/codecutil"
+	"go.mongodb.org/mongo-driver/v2/internal/driverutil"
+	"go.mongodb.org/mongo-driver/v2/internal/logger"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.mongodb.org/mongo-driver/v2/mongo/readconcern"
+	"go.mongodb.org/mongo-driver/v2/mongo/writeconcern"
 	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
 
 	"go.mongodb.org/mongo-driver/v2/bson"
@@ -43,6 +50,11 @@ func (me MarshalError) Error() string {
 	return fmt.Sprintf("cannot marshal type %s to a BSON Document: %v", reflect.TypeOf(me.Value), me.Err)
 }
 
+// Unwrap returns the underlying error.
+func (me MarshalError) Unwrap() error {
+	return me.Err
+}
+
 // Pipeline is a type that makes creating aggregation pipelines easier. It is a
 // helper and is intended for serializing to BSON.
 //
@@ -74,6 +86,15 @@ func getEncoder(
 		if opts.NilByteSliceAsEmpty {
 			enc.NilByteSliceAsEmpty()
 		}
+		if opts.IPAsString {
+			enc.IPAsString()
+		}
+		if opts.DurationUnit > 0 {
+			enc.DurationUnit(opts.DurationUnit)
+		}
+		if opts.DurationAsString {
+			enc.DurationAsString()
+		}
 		if opts.NilMapAsEmpty {
 			enc.NilMapAsEmpty()
 		}
@@ -89,6 +110,9 @@ func getEncoder(
 		if opts.StringifyMapKeysWithFmt {
 			enc.StringifyMapKeysWithFmt()
 		}
+		if opts.SquashConflict != bson.SquashConflictError {
+			enc.SquashConflict(opts.SquashConflict)
+		}
 		if opts.UseJSONStructTags {
 			enc.UseJSONStructTags()
 		}
@@ -118,6 +142,17 @@ func marshal(
 	val interface{},
 	bsonOpts *options.BSONOptions,
 	registry *bson.Registry,
+) (bsoncore.Document, error) {
+	return marshalWithContext(context.Background(), val, bsonOpts, registry)
+}
+
+// marshalWithContext is like marshal, but periodically checks ctx while encoding and aborts with an
+// error wrapping ctx.Err() once ctx is done. It should be used for user documents that may be large.
+func marshalWithContext(
+	ctx context.Context,
+	val interface{},
+	bsonOpts *options.BSONOptions,
+	registry *bson.Registry,
 ) (bsoncore.Document, error) {
 	if registry == nil {
 		registry = defaultRegistry
@@ -132,6 +167,7 @@ func marshal(
 
 	buf := new(bytes.Buffer)
 	enc := getEncoder(buf, bsonOpts, registry)
+	enc.SetContext(ctx)
 	err := enc.Encode(val)
 	if err != nil {
 		return nil, MarshalError{Value: val, Err: err}
@@ -142,14 +178,16 @@ func marshal(
 
 // ensureID inserts the given ObjectID as an element named "_id" at the
 // beginning of the given BSON document if there is not an "_id" already.
-// If the given ObjectID is bson.NilObjectID, a new object ID will be
-// generated with time.Now().
+// If the given ObjectID is bson.NilObjectID, the "_id" value is obtained from
+// idGen if it is non-nil. Otherwise, a new object ID will be generated with
+// time.Now().
 //
 // If there is already an element named "_id", the document is not modified. It
 // returns the resulting document and the decoded Go value of the "_id" element.
 func ensureID(
 	doc bsoncore.Document,
 	oid bson.ObjectID,
+	idGen func() interface{},
 	bsonOpts *options.BSONOptions,
 	reg *bson.Registry,
 ) (bsoncore.Document, interface{}, error) {
@@ -174,7 +212,10 @@ func ensureID(
 	}
 
 	// We couldn't find an "_id" element, so add one with the value of the
-	// provided ObjectID.
+	// provided ObjectID or the generated ID.
+	if oid.IsZero() && idGen != nil {
+		return prependGeneratedID(doc, idGen(), bsonOpts, reg)
+	}
 
 	olddoc := doc
 
@@ -196,6 +237,35 @@ func ensureID(
 	return doc, oid, nil
 }
 
+// prependGeneratedID marshals id as an element named "_id" and inserts it at
+// the beginning of the given BSON document. The document must not already
+// contain an "_id" element.
+func prependGeneratedID(
+	doc bsoncore.Document,
+	id interface{},
+	bsonOpts *options.BSONOptions,
+	reg *bson.Registry,
+) (bsoncore.Document, interface{}, error) {
+	idDoc, err := marshal(bson.D{{Key: "_id", Value: id}}, bsonOpts, reg)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error marshaling generated _id: %w", err)
+	}
+
+	// Strip the length header and the trailing null byte from the marshaled
+	// "_id" document to get the raw "_id" element.
+	const int32Len = 4
+	idElem := idDoc[int32Len : len(idDoc)-1]
+
+	olddoc := doc
+	doc = make(bsoncore.Document, 0, len(olddoc)+len(idElem))
+	_, doc = bsoncore.ReserveLength(doc)
+	doc = append(doc, idElem...)
+	doc = append(doc, olddoc[int32Len:]...)
+	doc = bsoncore.UpdateLength(doc, 0, int32(len(doc)))
+
+	return doc, id, nil
+}
+
 func ensureDollarKey(doc bsoncore.Document) error {
 	firstElem, err := doc.IndexErr(0)
 	if err != nil {
@@ -203,19 +273,261 @@ func ensureDollarKey(doc bsoncore.Document) error {
 	}
 
 	if !strings.HasPrefix(firstElem.Key(), "$") {
-		return errors.New("update document must contain key beginning with '$'")
+		return errors.New("update document must contain key beginning with '$'; use an update operator " +
+			"such as $set or an update pipeline (e.g. mongo.Pipeline)")
+	}
+	return nil
+}
+
+// errEmptyUpdatePipeline is returned when an update pipeline has no stages.
+var errEmptyUpdatePipeline = errors.New("update pipeline must contain at least one stage")
+
+// updatePipelineStages contains the aggregation stages that are allowed in an update pipeline.
+var updatePipelineStages = map[string]bool{
+	"$addFields":   true,
+	"$set":         true,
+	"$project":     true,
+	"$unset":       true,
+	"$replaceRoot": true,
+	"$replaceWith": true,
+}
+
+func ensureUpdatePipelineStage(stage bsoncore.Document) error {
+	elems, err := stage.Elements()
+	if err != nil {
+		return err
+	}
+	if len(elems) == 0 {
+		return errors.New("update pipeline stage must have at least one element")
+	}
+
+	key := elems[0].Key()
+	if !updatePipelineStages[key] {
+		return fmt.Errorf("update pipeline stage %q is not supported; update pipelines can only contain "+
+			"$addFields, $set, $project, $unset, $replaceRoot, and $replaceWith stages", key)
+	}
+	if len(elems) > 1 {
+		return fmt.Errorf("update pipeline stage %q must be the only field in its document, but found %d fields",
+			key, len(elems))
 	}
 	return nil
 }
 
+// IsPipelineUpdate reports whether update is an update pipeline, such as a
+// mongo.Pipeline or []bson.D, rather than a single update document. Types that
+// represent a single document are never pipelines, even if they are implemented
+// as slices (e.g. bson.D, bson.Raw, or []byte). A bson.ValueMarshaler is a
+// pipeline if it marshals to a BSON array.
+func IsPipelineUpdate(update interface{}) bool {
+	switch t := update.(type) {
+	case nil, bson.D, bson.Raw, bsoncore.Document, []byte, bson.Marshaler:
+		return false
+	case bson.ValueMarshaler:
+		typ, _, err := t.MarshalBSONValue()
+		return err == nil && bson.Type(typ) == bson.TypeArray
+	}
+
+	kind := reflect.ValueOf(update).Kind()
+	return kind == reflect.Slice || kind == reflect.Array
+}
+
+// withConcernSources returns ctx annotated with where the read and write concerns of an operation were configured so
+// they can be included in command started log messages. ctx is returned unchanged if debug logging is not enabled for
+// the command component. A nil ctx is treated as context.Background.
+func withConcernSources(ctx context.Context, l *logger.Logger, sources driverutil.ConcernSources) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if l == nil || !l.LevelComponentEnabled(logger.LevelDebug, logger.ComponentCommand) {
+		return ctx
+	}
+	return driverutil.WithConcernSources(ctx, sources)
+}
+
+// shorthandUpdate builds an update document from the shorthand update fields of an update operation. The operators
+// are added in the order $set, $unset, $push, $pull, $inc, and the keys of each operator are sorted so the resulting
+// document is deterministic. Empty fields are ignored. It returns nil if no shorthand fields are set.
+func shorthandUpdate(
+	set map[string]interface{},
+	unset []string,
+	push map[string]interface{},
+	pull map[string]interface{},
+	inc map[string]int64,
+) bson.D {
+	fieldsDoc := func(m map[string]interface{}) bson.D {
+		keys := make([]string, 0, len(m))
+		for k := range m {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		doc := make(bson.D, 0, len(keys))
+		for _, k := range keys {
+			doc = append(doc, bson.E{Key: k, Value: m[k]})
+		}
+		return doc
+	}
+
+	var update bson.D
+	if len(set) > 0 {
+		update = append(update, bson.E{Key: "$set", Value: fieldsDoc(set)})
+	}
+	if len(unset) > 0 {
+		doc := make(bson.D, 0, len(unset))
+		for _, k := range unset {
+			doc = append(doc, bson.E{Key: k, Value: ""})
+		}
+		update = append(update, bson.E{Key: "$unset", Value: doc})
+	}
+	if len(push) > 0 {
+		update = append(update, bson.E{Key: "$push", Value: fieldsDoc(push)})
+	}
+	if len(pull) > 0 {
+		update = append(update, bson.E{Key: "$pull", Value: fieldsDoc(pull)})
+	}
+	if len(inc) > 0 {
+		m := make(map[string]interface{}, len(inc))
+		for k, v := range inc {
+			m[k] = v
+		}
+		update = append(update, bson.E{Key: "$inc", Value: fieldsDoc(m)})
+	}
+	return update
+}
+
+func copyReadConcern(rc *readconcern.ReadConcern) *readconcern.ReadConcern {
+	if rc == nil {
+		return nil
+	}
+	rcCopy := *rc
+	return &rcCopy
+}
+
+func copyWriteConcern(wc *writeconcern.WriteConcern) *writeconcern.WriteConcern {
+	if wc == nil {
+		return nil
+	}
+	wcCopy := *wc
+	return &wcCopy
+}
+
+// ensureNoDollarKey returns an ErrReplacementContainsOperators if any top-level key in doc begins with '$'. Keys of
+// nested documents are not checked because they may legitimately begin with '$'.
 func ensureNoDollarKey(doc bsoncore.Document) error {
-	if elem, err := doc.IndexErr(0); err == nil && strings.HasPrefix(elem.Key(), "$") {
-		return errors.New("replacement document cannot contain keys beginning with '$'")
+	elems, err := doc.Elements()
+	if err != nil {
+		return err
+	}
+
+	var keys []string
+	for _, elem := range elems {
+		if key := elem.Key(); strings.HasPrefix(key, "$") {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) > 0 {
+		return ErrReplacementContainsOperators{Keys: keys}
 	}
 
 	return nil
 }
 
+// ValidateReplacement returns an ErrReplacementContainsOperators if doc is not a valid replacement document for
+// ReplaceOne, FindOneAndReplace, or a ReplaceOneModel, such as an update document with operators like $set. doc is
+// marshaled using the default registry. Only top-level keys are checked; nested documents may contain keys beginning
+// with '$'.
+func ValidateReplacement(doc interface{}) error {
+	r, err := marshal(doc, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return ensureNoDollarKey(r)
+}
+
+// ensureReplacementIDMatchesFilter returns an error if the replacement document has an _id that differs from the
+// _id in the filter. The check is skipped if either document has no _id or if the filter's _id is a query operator
+// expression such as {$in: [...]}.
+func ensureReplacementIDMatchesFilter(filter, replacement bsoncore.Document) error {
+	replacementID, err := replacement.LookupErr("_id")
+	if err != nil {
+		return nil
+	}
+	filterID, err := filter.LookupErr("_id")
+	if err != nil {
+		return nil
+	}
+	if doc, ok := filterID.DocumentOK(); ok {
+		if elem, err := doc.IndexErr(0); err == nil && strings.HasPrefix(elem.Key(), "$") {
+			return nil
+		}
+	}
+
+	if numericAwareEqual(filterID, replacementID) {
+		return nil
+	}
+
+	return fmt.Errorf("replacement document _id %v does not match filter _id %v", replacementID, filterID)
+}
+
+// numericAwareEqual returns true if v1 and v2 are equal. Numeric values are compared by value the way the server
+// compares them so that, for example, an int32 1 is equal to an int64 1 or a double 1.0.
+func numericAwareEqual(v1, v2 bsoncore.Value) bool {
+	if v1.Equal(v2) {
+		return true
+	}
+
+	n1, ok1 := numericValue(v1)
+	n2, ok2 := numericValue(v2)
+	return ok1 && ok2 && n1.Cmp(n2) == 0
+}
+
+// numericValue returns the exact value of an int32, int64, double, or decimal128 BSON value so
+// that numbers of different BSON types can be compared the way the server compares them. It
+// returns false for other types and for NaN and infinite values.
+func numericValue(v bsoncore.Value) (*big.Rat, bool) {
+	switch v.Type {
+	case bsoncore.TypeInt32, bsoncore.TypeInt64:
+		return new(big.Rat).SetInt64(v.AsInt64()), true
+	case bsoncore.TypeDouble:
+		f := v.Double()
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, false
+		}
+		return new(big.Rat).SetFloat64(f), true
+	case bsoncore.TypeDecimal128:
+		high, low := v.Decimal128()
+		coefficient, exp, err := bson.NewDecimal128(high, low).BigInt()
+		if err != nil {
+			return nil, false
+		}
+		if exp < 0 {
+			scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(-exp)), nil)
+			return new(big.Rat).SetFrac(coefficient, scale), true
+		}
+		scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exp)), nil)
+		return new(big.Rat).SetInt(coefficient.Mul(coefficient, scale)), true
+	default:
+		return nil, false
+	}
+}
+
+// appendLimitStage returns a copy of the pipeline array with a {$limit: limit} stage appended after all
+// existing stages.
+func appendLimitStage(pipeline bsoncore.Document, limit int64) bsoncore.Document {
+	values, _ := pipeline.Values()
+
+	aidx, arr := bsoncore.AppendArrayStart(nil)
+	for i, val := range values {
+		arr = bsoncore.AppendValueElement(arr, strconv.Itoa(i), val)
+	}
+	didx, arr := bsoncore.AppendDocumentElementStart(arr, strconv.Itoa(len(values)))
+	arr = bsoncore.AppendInt64Element(arr, "$limit", limit)
+	arr, _ = bsoncore.AppendDocumentEnd(arr, didx)
+	arr, _ = bsoncore.AppendArrayEnd(arr, aidx)
+	return arr
+}
+
 func marshalAggregatePipeline(
 	pipeline interface{},
 	bsonOpts *options.BSONOptions,
@@ -314,7 +626,23 @@ func marshalUpdateValue(
 	if !dollarKeysAllowed {
 		documentCheckerFunc = ensureNoDollarKey
 	}
+	return marshalUpdateValueWithChecker(update, bsonOpts, registry, dollarKeysAllowed, documentCheckerFunc)
+}
 
+// skipDocumentCheck is a document checker for marshalUpdateValueWithChecker that accepts every document.
+func skipDocumentCheck(bsoncore.Document) error {
+	return nil
+}
+
+// marshalUpdateValueWithChecker is like marshalUpdateValue, but validates update documents with
+// documentCheckerFunc instead of the checker selected by dollarKeysAllowed.
+func marshalUpdateValueWithChecker(
+	update interface{},
+	bsonOpts *options.BSONOptions,
+	registry *bson.Registry,
+	dollarKeysAllowed bool,
+	documentCheckerFunc func(bsoncore.Document) error,
+) (bsoncore.Value, error) {
 	var u bsoncore.Value
 	var err error
 	switch t := update.(type) {
@@ -358,13 +686,31 @@ func marshalUpdateValue(
 		if u.Type != bsoncore.TypeArray && u.Type != bsoncore.TypeEmbeddedDocument {
 			return u, fmt.Errorf("ValueMarshaler returned a %v, but was expecting %v or %v", u.Type, bsoncore.TypeArray, bsoncore.TypeEmbeddedDocument)
 		}
+		if u.Type == bsoncore.TypeArray && dollarKeysAllowed {
+			values, err := bsoncore.Array(u.Data).Values()
+			if err != nil {
+				return u, err
+			}
+			if len(values) == 0 {
+				return u, errEmptyUpdatePipeline
+			}
+			for idx, v := range values {
+				stage, ok := v.DocumentOK()
+				if !ok {
+					return u, fmt.Errorf("update pipeline stage %d must be a document, but got a %v", idx, v.Type)
+				}
+				if err := ensureUpdatePipelineStage(stage); err != nil {
+					return u, err
+				}
+			}
+		}
 		return u, err
 	default:
 		val := reflect.ValueOf(t)
 		if !val.IsValid() {
 			return u, fmt.Errorf("can only marshal slices and arrays into update pipelines, but got %v", val.Kind())
 		}
-		if val.Kind() != reflect.Slice && val.Kind() != reflect.Array {
+		if !IsPipelineUpdate(update) {
 			u.Type = bsoncore.TypeEmbeddedDocument
 			u.Data, err = marshal(update, bsonOpts, registry)
 			if err != nil {
@@ -377,13 +723,35 @@ func marshalUpdateValue(
 		u.Type = bsoncore.TypeArray
 		aidx, arr := bsoncore.AppendArrayStart(nil)
 		valLen := val.Len()
+		if valLen == 0 && dollarKeysAllowed {
+			return u, errEmptyUpdatePipeline
+		}
 		for idx := 0; idx < valLen; idx++ {
-			doc, err := marshal(val.Index(idx).Interface(), bsonOpts, registry)
+			stage := val.Index(idx).Interface()
+			if stage == nil {
+				return u, fmt.Errorf("update pipeline stage %d must be a document: %w", idx, ErrNilDocument)
+			}
+			if bs, ok := stage.([]byte); ok {
+				stage = bson.Raw(bs)
+			}
+
+			stageVal, err := marshalValue(stage, bsonOpts, registry)
 			if err != nil {
-				return u, err
+				return u, fmt.Errorf("error marshaling update pipeline stage %d: %w", idx, err)
+			}
+			doc, ok := stageVal.DocumentOK()
+			if !ok {
+				return u, fmt.Errorf("update pipeline stage %d must be a document, but got a %v", idx, stageVal.Type)
 			}
 
-			if err := documentCheckerFunc(doc); err != nil {
+			// Each element of an update pipeline is an aggregation stage rather than an update document, so
+			// validate it as a stage instead of requiring a leading update operator.
+			if dollarKeysAllowed {
+				err = ensureUpdatePipelineStage(doc)
+			} else {
+				err = documentCheckerFunc(doc)
+			}
+			if err != nil {
 				return u, err
 			}
 
@@ -7,10 +7,14 @@
 package mongo
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"math"
+	"net"
 	"reflect"
 	"testing"
+	"time"
 
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/internal/assert"
@@ -116,7 +120,7 @@ func TestEnsureID(t *testing.T) {
 		t.Run(tc.description, func(t *testing.T) {
 			t.Parallel()
 
-			got, gotID, err := ensureID(tc.doc, oid, nil, nil)
+			got, gotID, err := ensureID(tc.doc, oid, nil, nil, nil)
 			require.NoError(t, err, "ensureID error")
 
 			assert.Equal(t, tc.want, got, "expected and actual documents are different")
@@ -139,7 +143,7 @@ func TestEnsureID_NilObjectID(t *testing.T) {
 		AppendString("foo", "bar").
 		Build()
 
-	got, gotIDI, err := ensureID(doc, bson.NilObjectID, nil, nil)
+	got, gotIDI, err := ensureID(doc, bson.NilObjectID, nil, nil, nil)
 	assert.NoError(t, err)
 
 	gotID, ok := gotIDI.(bson.ObjectID)
@@ -155,6 +159,47 @@ func TestEnsureID_NilObjectID(t *testing.T) {
 	assert.Equal(t, want, got)
 }
 
+func TestEnsureID_IDGenerator(t *testing.T) {
+	t.Parallel()
+
+	doc := bsoncore.NewDocumentBuilder().
+		AppendString("foo", "bar").
+		Build()
+
+	idGen := func() interface{} { return "custom-id" }
+
+	got, gotID, err := ensureID(doc, bson.NilObjectID, idGen, nil, nil)
+	require.NoError(t, err, "ensureID error")
+
+	want := bsoncore.NewDocumentBuilder().
+		AppendString("_id", "custom-id").
+		AppendString("foo", "bar").
+		Build()
+
+	assert.Equal(t, want, got, "expected and actual documents are different")
+	assert.Equal(t, "custom-id", gotID, "expected and actual IDs are different")
+
+	t.Run("existing _id is not replaced", func(t *testing.T) {
+		t.Parallel()
+
+		doc := bsoncore.NewDocumentBuilder().
+			AppendInt32("_id", 1).
+			Build()
+
+		got, gotID, err := ensureID(doc, bson.NilObjectID, idGen, nil, nil)
+		require.NoError(t, err, "ensureID error")
+
+		assert.Equal(t, doc, got, "expected and actual documents are different")
+		assert.Equal(t, int32(1), gotID, "expected and actual IDs are different")
+	})
+	t.Run("unmarshalable generated _id", func(t *testing.T) {
+		t.Parallel()
+
+		_, _, err := ensureID(doc, bson.NilObjectID, func() interface{} { return make(chan int) }, nil, nil)
+		assert.Error(t, err, "expected error for unmarshalable _id, got nil")
+	})
+}
+
 func TestMarshalAggregatePipeline(t *testing.T) {
 	// []byte of [{{"$limit", 12345}}]
 	index, arr := bsoncore.AppendArrayStart(nil)
@@ -565,19 +610,28 @@ func TestMarshalValue(t *testing.T) {
 				ZeroStruct  struct{ X int } `bson:"_,omitempty"`
 				StringerMap map[*bson.RawValue]bool
 				BSONField   string `json:"jsonField"`
+				IP          net.IP
+				Timeout     time.Duration
+				Extra       bson.D `bson:",squash"`
 			}{
 				Int:         1,
 				NilBytes:    nil,
 				NilMap:      nil,
 				NilStrings:  nil,
 				StringerMap: map[*bson.RawValue]bool{{}: true},
+				IP:          net.IPv4(127, 0, 0, 1),
+				Timeout:     3 * time.Second,
+				Extra:       bson.D{{"int", 2}, {"extra", true}},
 			},
 			bsonOpts: &options.BSONOptions{
+				DurationUnit:            time.Millisecond,
+				IPAsString:              true,
 				IntMinSize:              true,
 				NilByteSliceAsEmpty:     true,
 				NilMapAsEmpty:           true,
 				NilSliceAsEmpty:         true,
 				OmitZeroStruct:          true,
+				SquashConflict:          bson.SquashConflictStructFieldWins,
 				StringifyMapKeysWithFmt: true,
 				UseJSONStructTags:       true,
 			},
@@ -592,6 +646,9 @@ func TestMarshalValue(t *testing.T) {
 						AppendBoolean("", true).
 						Build()).
 					AppendString("jsonField", "").
+					AppendString("ip", "127.0.0.1").
+					AppendInt32("timeout", 3000).
+					AppendBoolean("extra", true).
 					Build(),
 			},
 		},
@@ -609,6 +666,251 @@ func TestMarshalValue(t *testing.T) {
 	}
 }
 
+func TestIsPipelineUpdate(t *testing.T) {
+	t.Parallel()
+
+	arr := bsoncore.NewArrayBuilder().
+		AppendDocument(bsoncore.NewDocumentBuilder().AppendInt32("x", 1).Build()).
+		Build()
+
+	testCases := []struct {
+		name   string
+		update interface{}
+		want   bool
+	}{
+		{"nil", nil, false},
+		{"bson.D", bson.D{{"$set", bson.D{{"x", 1}}}}, false},
+		{"bson.M", bson.M{"$set": bson.M{"x": 1}}, false},
+		{"bson.Raw", bson.Raw(bsoncore.NewDocumentBuilder().Build()), false},
+		{"bsoncore.Document", bsoncore.NewDocumentBuilder().Build(), false},
+		{"[]byte", []byte(bsoncore.NewDocumentBuilder().Build()), false},
+		{"Pipeline", Pipeline{{{"$set", bson.D{{"x", 1}}}}}, true},
+		{"[]bson.D", []bson.D{{{"$set", bson.D{{"x", 1}}}}}, true},
+		{"bson.A", bson.A{bson.D{{"$set", bson.D{{"x", 1}}}}}, true},
+		{"array ValueMarshaler", bvMarsh{t: bson.TypeArray, data: arr}, true},
+		{"document ValueMarshaler", bvMarsh{t: bson.TypeEmbeddedDocument, data: bsoncore.NewDocumentBuilder().Build()}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // Capture range variable.
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := IsPipelineUpdate(tc.update)
+			assert.Equal(t, tc.want, got, "expected IsPipelineUpdate to return %v, got %v", tc.want, got)
+		})
+	}
+}
+
+func TestEnsureReplacementIDMatchesFilter(t *testing.T) {
+	t.Parallel()
+
+	oid := bson.NewObjectID()
+	dec := func(s string) bson.Decimal128 {
+		d, err := bson.ParseDecimal128(s)
+		require.NoError(t, err, "ParseDecimal128 error")
+		return d
+	}
+
+	testCases := []struct {
+		name        string
+		filter      bson.D
+		replacement bson.D
+		wantErr     bool
+	}{
+		{"matching ObjectID", bson.D{{"_id", oid}}, bson.D{{"_id", oid}, {"x", 1}}, false},
+		{"matching string", bson.D{{"_id", "a"}, {"y", 2}}, bson.D{{"x", 1}, {"_id", "a"}}, false},
+		{"matching int32 and int64", bson.D{{"_id", int32(1)}}, bson.D{{"_id", int64(1)}}, false},
+		{"matching document", bson.D{{"_id", bson.D{{"a", 1}}}}, bson.D{{"_id", bson.D{{"a", 1}}}}, false},
+		{"mismatched ObjectID", bson.D{{"_id", oid}}, bson.D{{"_id", bson.NewObjectID()}}, true},
+		{"mismatched int", bson.D{{"_id", int32(1)}}, bson.D{{"_id", int64(2)}}, true},
+		{"mismatched type", bson.D{{"_id", "1"}}, bson.D{{"_id", int32(1)}}, true},
+		{"matching int32 and double", bson.D{{"_id", int32(1)}}, bson.D{{"_id", 1.0}}, false},
+		{"matching int64 and decimal128", bson.D{{"_id", int64(100)}}, bson.D{{"_id", dec("1.00E+2")}}, false},
+		{"matching double and decimal128", bson.D{{"_id", 1.5}}, bson.D{{"_id", dec("1.50")}}, false},
+		{"matching positive and negative zero", bson.D{{"_id", 0.0}}, bson.D{{"_id", math.Copysign(0, -1)}}, false},
+		{"mismatched double", bson.D{{"_id", 1.5}}, bson.D{{"_id", int32(1)}}, true},
+		{"mismatched decimal128", bson.D{{"_id", dec("1.1")}}, bson.D{{"_id", int64(1)}}, true},
+		{"mismatched large int64 and double", bson.D{{"_id", int64(1<<53 + 1)}}, bson.D{{"_id", float64(1 << 53)}}, true},
+		{"NaN", bson.D{{"_id", math.NaN()}}, bson.D{{"_id", math.NaN()}}, false},
+		{"replacement without _id", bson.D{{"_id", oid}}, bson.D{{"x", 1}}, false},
+		{"filter without _id", bson.D{{"x", 1}}, bson.D{{"_id", oid}}, false},
+		{"filter _id operator", bson.D{{"_id", bson.D{{"$in", bson.A{1, 2}}}}}, bson.D{{"_id", 3}}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // Capture range variable.
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			filter, err := bson.Marshal(tc.filter)
+			require.NoError(t, err, "Marshal error")
+			replacement, err := bson.Marshal(tc.replacement)
+			require.NoError(t, err, "Marshal error")
+
+			err = ensureReplacementIDMatchesFilter(filter, replacement)
+			if tc.wantErr {
+				assert.Error(t, err, "expected an error")
+				return
+			}
+			assert.NoError(t, err, "ensureReplacementIDMatchesFilter error")
+		})
+	}
+}
+
+func TestValidateReplacement(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		replacement interface{}
+		wantKeys    []string
+	}{
+		{"valid replacement", bson.D{{"_id", 1}, {"x", 1}, {"y", "foo"}}, nil},
+		{"dollar key at position 3", bson.D{{"x", 1}, {"y", 2}, {"$set", bson.D{{"z", 3}}}}, []string{"$set"}},
+		{"nested dollar key", bson.D{{"x", bson.D{{"$set", 1}}}, {"y", bson.A{bson.D{{"$inc", 1}}}}}, nil},
+		{"all dollar keys", bson.D{{"$set", bson.D{{"x", 1}}}, {"$inc", bson.D{{"y", 1}}}}, []string{"$set", "$inc"}},
+		{"raw document", bson.Raw(bsoncore.NewDocumentBuilder().AppendInt32("$x", 1).Build()), []string{"$x"}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // Capture range variable.
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateReplacement(tc.replacement)
+			if tc.wantKeys == nil {
+				assert.NoError(t, err, "ValidateReplacement error")
+				return
+			}
+
+			var opErr ErrReplacementContainsOperators
+			require.True(t, errors.As(err, &opErr), "expected ErrReplacementContainsOperators, got %v", err)
+			assert.Equal(t, tc.wantKeys, opErr.Keys, "expected keys %v, got %v", tc.wantKeys, opErr.Keys)
+		})
+	}
+
+	t.Run("nil document", func(t *testing.T) {
+		t.Parallel()
+
+		err := ValidateReplacement(nil)
+		assert.ErrorIs(t, err, ErrNilDocument, "expected error %v, got %v", ErrNilDocument, err)
+	})
+}
+
+func TestMarshalUpdateValue(t *testing.T) {
+	t.Parallel()
+
+	t.Run("document without dollar key", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := marshalUpdateValue(bson.D{{"x", 1}}, nil, nil, true)
+		require.Error(t, err, "expected error for update document without dollar key")
+		assert.Contains(t, err.Error(), "mongo.Pipeline")
+	})
+	t.Run("supported pipeline stages", func(t *testing.T) {
+		t.Parallel()
+
+		for stage := range updatePipelineStages {
+			update := Pipeline{{{stage, bson.D{{"x", 1}}}}}
+			_, err := marshalUpdateValue(update, nil, nil, true)
+			assert.Nil(t, err, "marshalUpdateValue error for stage %q: %v", stage, err)
+		}
+	})
+	t.Run("unsupported pipeline stage", func(t *testing.T) {
+		t.Parallel()
+
+		update := Pipeline{
+			{{"$set", bson.D{{"x", 1}}}},
+			{{"$match", bson.D{{"x", 1}}}},
+		}
+		_, err := marshalUpdateValue(update, nil, nil, true)
+		require.Error(t, err, "expected error for unsupported pipeline stage")
+		assert.Contains(t, err.Error(), `"$match"`)
+	})
+	t.Run("valid pipeline", func(t *testing.T) {
+		t.Parallel()
+
+		update := bson.A{
+			bson.D{{"$set", bson.D{{"x", 1}}}},
+			bson.M{"$unset": "y"},
+		}
+		got, err := marshalUpdateValue(update, nil, nil, true)
+		require.NoError(t, err, "marshalUpdateValue error")
+		assert.Equal(t, bsoncore.TypeArray, got.Type, "expected type %v, got %v", bsoncore.TypeArray, got.Type)
+
+		stages, err := bsoncore.Array(got.Data).Values()
+		require.NoError(t, err, "Values error")
+		assert.Len(t, stages, 2, "expected 2 stages, got %d", len(stages))
+	})
+	t.Run("invalid mixed pipeline", func(t *testing.T) {
+		t.Parallel()
+
+		testCases := []struct {
+			name    string
+			update  interface{}
+			wantErr string
+		}{
+			{
+				name:    "empty pipeline",
+				update:  Pipeline{},
+				wantErr: "update pipeline must contain at least one stage",
+			},
+			{
+				name:    "empty array",
+				update:  bson.A{},
+				wantErr: "update pipeline must contain at least one stage",
+			},
+			{
+				name:    "non-document stage",
+				update:  bson.A{bson.D{{"$set", bson.D{{"x", 1}}}}, "$unset"},
+				wantErr: "update pipeline stage 1 must be a document",
+			},
+			{
+				name:    "nil stage",
+				update:  bson.A{bson.D{{"$set", bson.D{{"x", 1}}}}, nil},
+				wantErr: "update pipeline stage 1 must be a document",
+			},
+			{
+				name:    "update document as stage",
+				update:  bson.A{bson.D{{"$set", bson.D{{"x", 1}}}}, bson.D{{"x", 1}}},
+				wantErr: `update pipeline stage "x" is not supported`,
+			},
+			{
+				name:    "stage with extra field",
+				update:  bson.A{bson.D{{"$set", bson.D{{"x", 1}}}, {"y", 1}}},
+				wantErr: "must be the only field",
+			},
+		}
+		for _, tc := range testCases {
+			tc := tc // Capture range variable.
+
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				_, err := marshalUpdateValue(tc.update, nil, nil, true)
+				require.Error(t, err, "expected marshalUpdateValue error")
+				assert.Contains(t, err.Error(), tc.wantErr)
+			})
+		}
+	})
+	t.Run("stage marshal error", func(t *testing.T) {
+		t.Parallel()
+
+		update := bson.A{bson.D{{"$set", bson.D{{"x", make(chan int)}}}}}
+		_, err := marshalUpdateValue(update, nil, nil, true)
+		require.Error(t, err, "expected marshalUpdateValue error")
+		assert.Contains(t, err.Error(), "error marshaling update pipeline stage 0")
+		assert.NotContains(t, err.Error(), "must be a document")
+
+		var me codecutil.MarshalError
+		assert.True(t, errors.As(err, &me), "expected error to wrap a MarshalError, got %v", err)
+	})
+}
+
 func TestGetEncoder(t *testing.T) {
 	t.Parallel()
 
@@ -641,6 +943,38 @@ func TestGetEncoder(t *testing.T) {
 	}
 }
 
+func TestMarshalWithContext(t *testing.T) {
+	t.Parallel()
+
+	doc := bson.D{{"values", make([]int64, 1<<20)}}
+
+	t.Run("canceled", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := marshalWithContext(ctx, doc, nil, nil)
+		assert.ErrorIs(t, err, context.Canceled, "expected error %v, got %v", context.Canceled, err)
+
+		var me MarshalError
+		assert.True(t, errors.As(err, &me), "expected error of type %T, got %T", me, err)
+	})
+	t.Run("not canceled", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		got, err := marshalWithContext(ctx, doc, nil, nil)
+		require.NoError(t, err, "marshalWithContext error")
+
+		want, err := bson.Marshal(doc)
+		require.NoError(t, err, "Marshal error")
+		assert.Equal(t, bsoncore.Document(want), got, "expected marshalled documents to be equal")
+	})
+}
+
 var _ bson.ValueMarshaler = bvMarsh{}
 
 type bvMarsh struct {
@@ -25,6 +25,11 @@ type AggregateOptions struct {
 	Comment                  interface{}
 	Hint                     interface{}
 	Let                      interface{}
+	MaxResults               *int64
+	Explain                  *bool
+	CacheKey                 *string
+	CacheTTL                 *time.Duration
+	ForceRefresh             *bool
 	Custom                   bson.M
 }
 
@@ -153,6 +158,78 @@ func (ao *AggregateOptionsBuilder) SetLet(let interface{}) *AggregateOptionsBuil
 	return ao
 }
 
+// SetMaxResults sets the value for the MaxResults field. If set, the driver appends a {$limit: <n>}
+// stage after all user-provided stages before sending the pipeline to the server, so at most n
+// documents are returned. This is a client-side transformation of the pipeline; the server sees an
+// ordinary $limit stage. The value must be positive, and this option cannot be used with pipelines
+// that end in an $out or $merge stage. The default value is nil, which means the pipeline is sent
+// unmodified.
+func (ao *AggregateOptionsBuilder) SetMaxResults(n int64) *AggregateOptionsBuilder {
+	ao.Opts = append(ao.Opts, func(opts *AggregateOptions) error {
+		opts.MaxResults = &n
+
+		return nil
+	})
+
+	return ao
+}
+
+// SetExplain sets the value for the Explain field. If true, the aggregate command is wrapped in an explain
+// command with "queryPlanner" verbosity and the pipeline is not executed. The returned cursor yields a single
+// document containing the explain output. Use Collection.ExplainAggregate to choose a different verbosity. The
+// default value is false.
+func (ao *AggregateOptionsBuilder) SetExplain(b bool) *AggregateOptionsBuilder {
+	ao.Opts = append(ao.Opts, func(opts *AggregateOptions) error {
+		opts.Explain = &b
+
+		return nil
+	})
+
+	return ao
+}
+
+// SetCacheKey sets the value for the CacheKey field. CacheKey identifies the results of the aggregation in the
+// AggregateCache configured with Collection.WithAggregateCache. The results are only read from and written to the
+// cache if both CacheKey and CacheTTL are set and the Collection has a cache. The key must identify the pipeline and
+// all options that affect the results. The cache is not invalidated when the collection is modified, so cached
+// results may be stale for up to CacheTTL. The cache is bypassed for aggregations run with an explicit session,
+// including in transactions, and results are only cached when a cursor that has not been iterated is read with
+// Cursor.All. The default value is nil, which means the cache is not used.
+func (ao *AggregateOptionsBuilder) SetCacheKey(key string) *AggregateOptionsBuilder {
+	ao.Opts = append(ao.Opts, func(opts *AggregateOptions) error {
+		opts.CacheKey = &key
+
+		return nil
+	})
+
+	return ao
+}
+
+// SetCacheTTL sets the value for the CacheTTL field. CacheTTL specifies how long results stored under CacheKey stay
+// in the cache. The value must be positive for the cache to be used. See SetCacheKey for more information.
+func (ao *AggregateOptionsBuilder) SetCacheTTL(d time.Duration) *AggregateOptionsBuilder {
+	ao.Opts = append(ao.Opts, func(opts *AggregateOptions) error {
+		opts.CacheTTL = &d
+
+		return nil
+	})
+
+	return ao
+}
+
+// SetForceRefresh sets the value for the ForceRefresh field. If true, cached results stored under CacheKey are
+// ignored and the aggregation is always run. The new results replace the cached ones once they are read with
+// Cursor.All. The default value is false.
+func (ao *AggregateOptionsBuilder) SetForceRefresh(b bool) *AggregateOptionsBuilder {
+	ao.Opts = append(ao.Opts, func(opts *AggregateOptions) error {
+		opts.ForceRefresh = &b
+
+		return nil
+	})
+
+	return ao
+}
+
 // SetCustom sets the value for the Custom field. Key-value pairs of the BSON map should correlate
 // with desired option names and values. Values must be Marshalable. Custom options may conflict
 // with non-custom options, and custom options bypass client-side validation. Prefer using non-custom
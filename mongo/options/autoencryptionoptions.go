@@ -31,17 +31,19 @@ import (
 //
 // See corresponding setter methods for documentation.
 type AutoEncryptionOptions struct {
-	KeyVaultClientOptions *ClientOptions
-	KeyVaultNamespace     string
-	KmsProviders          map[string]map[string]interface{}
-	SchemaMap             map[string]interface{}
-	BypassAutoEncryption  *bool
-	ExtraOptions          map[string]interface{}
-	TLSConfig             map[string]*tls.Config
-	HTTPClient            *http.Client
-	EncryptedFieldsMap    map[string]interface{}
-	BypassQueryAnalysis   *bool
-	KeyExpiration         *time.Duration
+	KeyVaultClientOptions  *ClientOptions
+	KeyVaultNamespace      string
+	KmsProviders           map[string]map[string]interface{}
+	SchemaMap              map[string]interface{}
+	BypassAutoEncryption   *bool
+	ExtraOptions           map[string]interface{}
+	TLSConfig              map[string]*tls.Config
+	HTTPClient             *http.Client
+	EncryptedFieldsMap     map[string]interface{}
+	BypassQueryAnalysis    *bool
+	KeyExpiration          *time.Duration
+	CryptSharedLibPath     *string
+	CryptSharedLibRequired *bool
 }
 
 // AutoEncryption creates a new AutoEncryptionOptions configured with default values.
@@ -136,6 +138,10 @@ func (a *AutoEncryptionOptions) SetBypassAutoEncryption(bypass bool) *AutoEncryp
 // absolute path to the directory containing the linked libmongocrypt library. Setting an override
 // path disables the default system library search path. If an override path is specified but the
 // crypt_shared library cannot be loaded, Client creation will return an error. Must be a string.
+//
+// The "cryptSharedLibRequired" and "cryptSharedLibPath" extra options are superseded by
+// SetCryptSharedLibRequired and SetCryptSharedLibPath. If both are set, the values set through the
+// setters take precedence.
 func (a *AutoEncryptionOptions) SetExtraOptions(extraOpts map[string]interface{}) *AutoEncryptionOptions {
 	a.ExtraOptions = extraOpts
 
@@ -174,3 +180,29 @@ func (a *AutoEncryptionOptions) SetKeyExpiration(expiration time.Duration) *Auto
 
 	return a
 }
+
+// SetCryptSharedLibPath specifies the path to the crypt_shared dynamic library file (for example, a
+// .so, .dll, or .dylib file), not the directory that contains it. If the path is relative, it is
+// resolved relative to the working directory of the process. If the first path component is the
+// literal string "$ORIGIN", it is replaced by the absolute path to the directory containing the
+// linked libmongocrypt library. Setting a path disables the default system library search path. If
+// a path is set but the crypt_shared library cannot be loaded from it, Client creation will return
+// an error that includes the path.
+//
+// If the crypt_shared library is loaded, the Client does not spawn or connect to mongocryptd. Use
+// Client.CryptSharedLibVersion to check which version of the library was loaded.
+func (a *AutoEncryptionOptions) SetCryptSharedLibPath(path string) *AutoEncryptionOptions {
+	a.CryptSharedLibPath = &path
+
+	return a
+}
+
+// SetCryptSharedLibRequired specifies whether Client creation should return an error if the
+// crypt_shared library cannot be loaded. If false, the Client falls back to spawning and connecting
+// to mongocryptd when the library is not loaded. This option cannot be set to true if
+// BypassAutoEncryption is true. The default is false.
+func (a *AutoEncryptionOptions) SetCryptSharedLibRequired(required bool) *AutoEncryptionOptions {
+	a.CryptSharedLibRequired = &required
+
+	return a
+}
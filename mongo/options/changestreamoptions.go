@@ -7,6 +7,7 @@
 package options
 
 import (
+	"context"
 	"time"
 
 	"go.mongodb.org/mongo-driver/v2/bson"
@@ -28,6 +29,20 @@ type ChangeStreamOptions struct {
 	StartAfter               interface{}
 	Custom                   bson.M
 	CustomPipeline           bson.M
+	CheckpointStore          CheckpointStore
+	CheckpointSync           *bool
+	ResumeTokenCallback      func(bson.Raw)
+}
+
+// CheckpointStore persists change stream resume tokens so that a change stream can continue from where it left off
+// after the application restarts. The go.mongodb.org/mongo-driver/v2/mongo/checkpoint package provides
+// implementations.
+type CheckpointStore interface {
+	// Save persists the given resume token, replacing any previously saved token.
+	Save(ctx context.Context, token bson.Raw) error
+
+	// Load returns the last saved resume token, or nil if no token has been saved.
+	Load(ctx context.Context) (bson.Raw, error)
 }
 
 // ChangeStreamOptionsBuilder contains options to configure change stream
@@ -59,8 +74,10 @@ func (cso *ChangeStreamOptionsBuilder) SetBatchSize(i int32) *ChangeStreamOption
 
 // SetCollation sets the value for the Collation field. Specifies a collation to use for string comparisons
 // during the operation. This option is only valid for MongoDB versions >= 3.4. For previous server versions,
-// the driver will return an error if this option is used. The default value is nil, which means the default
-// collation of the collection will be used.
+// the driver will return an error if this option is used. The collation is sent with the initial aggregate
+// command and with every aggregate used to resume the change stream, so it applies to any stages in the
+// pipeline, such as $match. The default value is nil, which means the default collation of the collection
+// will be used.
 func (cso *ChangeStreamOptionsBuilder) SetCollation(c Collation) *ChangeStreamOptionsBuilder {
 	cso.Opts = append(cso.Opts, func(opts *ChangeStreamOptions) error {
 		opts.Collation = &c
@@ -182,3 +199,46 @@ func (cso *ChangeStreamOptionsBuilder) SetCustomPipeline(cp bson.M) *ChangeStrea
 	})
 	return cso
 }
+
+// SetCheckpointStore sets the value for the CheckpointStore field. If set, the resume token is loaded
+// from the store when the change stream is opened and, if one was saved, the change stream resumes after
+// it. The resume token of each event is saved once the event has been processed, which is signaled by
+// calling Next or TryNext again or by closing the change stream. Tokens are saved at most once per event
+// and never for events that were not returned. Because the last event returned before the application
+// stops without closing the change stream may not have been saved, events may be delivered more than
+// once. The store is not consulted if ResumeAfter, StartAfter, or StartAtOperationTime is set. The
+// default value is nil, which means that resume tokens will not be persisted.
+func (cso *ChangeStreamOptionsBuilder) SetCheckpointStore(store CheckpointStore) *ChangeStreamOptionsBuilder {
+	cso.Opts = append(cso.Opts, func(opts *ChangeStreamOptions) error {
+		opts.CheckpointStore = store
+		return nil
+	})
+	return cso
+}
+
+// SetCheckpointSync sets the value for the CheckpointSync field. If true, each resume token is saved to
+// the CheckpointStore synchronously, so every call to Next or TryNext after an event waits for the store
+// write, and a failure to save a token is reported by the change stream's Err method. If false, resume
+// tokens are saved in the background so that saving does not slow down iteration and errors from the
+// store are ignored. If the store falls behind, only the newest pending token is saved. Pending saves are
+// completed when the change stream is closed. The default value is false.
+func (cso *ChangeStreamOptionsBuilder) SetCheckpointSync(b bool) *ChangeStreamOptionsBuilder {
+	cso.Opts = append(cso.Opts, func(opts *ChangeStreamOptions) error {
+		opts.CheckpointSync = &b
+		return nil
+	})
+	return cso
+}
+
+// SetResumeTokenCallback sets the value for the ResumeTokenCallback field. If set, the callback is called
+// with the change stream's resume token each time Next, TryNext, or AllBuffered returns an event, so that
+// the token can be persisted without polling ResumeToken. The token passed to the callback is a copy and
+// may be retained. The callback is called synchronously on the goroutine iterating the change stream. The
+// default value is nil.
+func (cso *ChangeStreamOptionsBuilder) SetResumeTokenCallback(fn func(bson.Raw)) *ChangeStreamOptionsBuilder {
+	cso.Opts = append(cso.Opts, func(opts *ChangeStreamOptions) error {
+		opts.ResumeTokenCallback = fn
+		return nil
+	})
+	return cso
+}
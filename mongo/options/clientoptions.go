@@ -26,6 +26,7 @@ import (
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/event"
 	"go.mongodb.org/mongo-driver/v2/internal/httputil"
+	"go.mongodb.org/mongo-driver/v2/internal/stringutil"
 	"go.mongodb.org/mongo-driver/v2/mongo/readconcern"
 	"go.mongodb.org/mongo-driver/v2/mongo/readpref"
 	"go.mongodb.org/mongo-driver/v2/mongo/writeconcern"
@@ -33,6 +34,7 @@ import (
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/auth"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/connstring"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/dns"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/wiremessage"
 )
 
@@ -180,6 +182,21 @@ type BSONOptions struct {
 	// empty BSON binary values instead of BSON null.
 	NilByteSliceAsEmpty bool
 
+	// IPAsString causes the driver to marshal net.IP values as BSON strings
+	// and net.IPNet values as BSON strings in CIDR notation instead of as BSON
+	// binary values and {ip, mask} documents.
+	IPAsString bool
+
+	// DurationUnit causes the driver to marshal time.Duration values as BSON
+	// integers counting the given unit (e.g. time.Millisecond) and to
+	// interpret BSON numbers unmarshaled into time.Duration values in the
+	// same unit. The default, zero, means nanoseconds.
+	DurationUnit time.Duration
+
+	// DurationAsString causes the driver to marshal time.Duration values as
+	// BSON strings in the format produced by time.Duration.String.
+	DurationAsString bool
+
 	// OmitZeroStruct causes the driver to consider the zero value for a struct
 	// (e.g. MyStruct{}) as empty and omit it from the marshaled BSON when the
 	// "omitempty" struct tag option or the "OmitEmpty" field is set.
@@ -193,6 +210,12 @@ type BSONOptions struct {
 	// string conversion logic.
 	StringifyMapKeysWithFmt bool
 
+	// SquashConflict determines how the driver marshals keys of a map or
+	// bson.D field with the "inline" or "squash" struct tag option that
+	// collide with the names of other struct fields. The default,
+	// bson.SquashConflictError, returns an error.
+	SquashConflict bson.SquashConflict
+
 	// AllowTruncatingDoubles causes the driver to truncate the fractional part
 	// of BSON "double" values when attempting to unmarshal them into a Go
 	// integer (int, int8, int16, int32, or int64) struct field. The truncation
@@ -225,6 +248,11 @@ type BSONOptions struct {
 	// structs in the destination value before unmarshaling BSON documents into
 	// them.
 	ZeroStructs bool
+
+	// RequireAllFields causes the driver to return an error when unmarshaling
+	// into a Go struct if a struct field that is neither a pointer nor marked
+	// "omitempty" has no corresponding BSON element.
+	RequireAllFields bool
 }
 
 // DriverInfo appends the client metadata generated by the driver when
@@ -238,49 +266,70 @@ type DriverInfo struct {
 	Platform string // Platform information for the wrapping driver.
 }
 
+// CompressorOptions configures a single compressor used when communicating with a server.
+type CompressorOptions struct {
+	// Name is the name of the compressor. Valid values are "snappy", "zlib", and "zstd".
+	Name string
+
+	// Level is the compression level. It is ignored for the snappy compressor. Supported values are -1 through 9,
+	// inclusive, for zlib and 1 through 22, inclusive, for zstd. If nil, the level set through SetZlibLevel or
+	// SetZstdLevel is used.
+	Level *int
+}
+
+// Resolver resolves the SRV and TXT records of "mongodb+srv" connection strings. Its method signatures
+// match those of *net.Resolver, so a *net.Resolver configured with a custom Dial function can be used
+// directly.
+type Resolver = dns.ContextResolver
+
 // ClientOptions contains arguments to configure a Client instance. Arguments
 // can be set through the ClientOptions setter functions. See each function for
 // documentation.
 type ClientOptions struct {
-	AppName                  *string
-	Auth                     *Credential
-	AutoEncryptionOptions    *AutoEncryptionOptions
-	ConnectTimeout           *time.Duration
-	Compressors              []string
-	Dialer                   ContextDialer
-	Direct                   *bool
-	DisableOCSPEndpointCheck *bool
-	DriverInfo               *DriverInfo
-	HeartbeatInterval        *time.Duration
-	Hosts                    []string
-	HTTPClient               *http.Client
-	LoadBalanced             *bool
-	LocalThreshold           *time.Duration
-	LoggerOptions            *LoggerOptions
-	MaxConnIdleTime          *time.Duration
-	MaxPoolSize              *uint64
-	MinPoolSize              *uint64
-	MaxConnecting            *uint64
-	PoolMonitor              *event.PoolMonitor
-	Monitor                  *event.CommandMonitor
-	ServerMonitor            *event.ServerMonitor
-	ReadConcern              *readconcern.ReadConcern
-	ReadPreference           *readpref.ReadPref
-	BSONOptions              *BSONOptions
-	Registry                 *bson.Registry
-	ReplicaSet               *string
-	RetryReads               *bool
-	RetryWrites              *bool
-	ServerAPIOptions         *ServerAPIOptions
-	ServerMonitoringMode     *string
-	ServerSelectionTimeout   *time.Duration
-	SRVMaxHosts              *int
-	SRVServiceName           *string
-	Timeout                  *time.Duration
-	TLSConfig                *tls.Config
-	WriteConcern             *writeconcern.WriteConcern
-	ZlibLevel                *int
-	ZstdLevel                *int
+	AppName                   *string
+	Auth                      *Credential
+	AutoEncryptionOptions     *AutoEncryptionOptions
+	ConnectTimeout            *time.Duration
+	Compressors               []string
+	CompressorOptions         []CompressorOptions
+	DefaultTransactionOptions *TransactionOptionsBuilder
+	Dialer                    ContextDialer
+	Direct                    *bool
+	DisableOCSPEndpointCheck  *bool
+	DriverInfo                *DriverInfo
+	HeartbeatInterval         *time.Duration
+	Hosts                     []string
+	HTTPClient                *http.Client
+	IDGenerator               func() interface{}
+	LoadBalanced              *bool
+	LocalThreshold            *time.Duration
+	LoggerOptions             *LoggerOptions
+	MaxConnIdleTime           *time.Duration
+	MaxPoolSize               *uint64
+	MinPoolSize               *uint64
+	MaxConnecting             *uint64
+	PoolMonitor               *event.PoolMonitor
+	Monitor                   *event.CommandMonitor
+	ServerMonitor             *event.ServerMonitor
+	ReadConcern               *readconcern.ReadConcern
+	ReadPreference            *readpref.ReadPref
+	BSONOptions               *BSONOptions
+	Registry                  *bson.Registry
+	ReplicaSet                *string
+	Resolver                  Resolver
+	RetainRawResponses        *bool
+	RetryReads                *bool
+	RetryWrites               *bool
+	ServerAPIOptions          *ServerAPIOptions
+	ServerMonitoringMode      *string
+	ServerSelectionTimeout    *time.Duration
+	SRVMaxHosts               *int
+	SRVServiceName            *string
+	Timeout                   *time.Duration
+	TLSConfig                 *tls.Config
+	WriteConcern              *writeconcern.WriteConcern
+	ZlibLevel                 *int
+	ZstdLevel                 *int
 
 	// Crypt specifies a custom driver.Crypt to be used to encrypt and decrypt documents. The default is no
 	// encryption.
@@ -308,7 +357,7 @@ func Client() *ClientOptions {
 }
 
 func setURIOpts(uri string, opts *ClientOptions) error {
-	connString, err := connstring.ParseAndValidate(uri)
+	connString, err := connstring.ParseAndValidateWithResolver(uri, dns.NewResolver(opts.Resolver))
 	if err != nil {
 		return err
 	}
@@ -347,11 +396,11 @@ func setURIOpts(uri string, opts *ClientOptions) error {
 
 	if len(connString.Compressors) > 0 {
 		opts.Compressors = connString.Compressors
-		if stringSliceContains(opts.Compressors, "zlib") {
+		if stringutil.SliceContains(opts.Compressors, "zlib") {
 			defaultLevel := wiremessage.DefaultZlibLevel
 			opts.ZlibLevel = &defaultLevel
 		}
-		if stringSliceContains(opts.Compressors, "zstd") {
+		if stringutil.SliceContains(opts.Compressors, "zstd") {
 			defaultLevel := wiremessage.DefaultZstdLevel
 			opts.ZstdLevel = &defaultLevel
 		}
@@ -587,6 +636,16 @@ func (c *ClientOptions) Validate() error {
 		return fmt.Errorf(`invalid value %q for "Timeout": value must be positive`, *to)
 	}
 
+	if err := c.validateCompression(); err != nil {
+		return err
+	}
+
+	if c.Auth != nil {
+		if err := validateCredential(c.Auth); err != nil {
+			return err
+		}
+	}
+
 	// OIDC Validation
 	if c.Auth != nil && c.Auth.AuthMechanism == auth.MongoDBOIDC {
 		if c.Auth.Password != "" {
@@ -642,6 +701,46 @@ func (c *ClientOptions) Validate() error {
 	return nil
 }
 
+// validateCredential checks the mechanism-specific requirements for cred so that invalid combinations are reported
+// when the Client is created rather than as authentication failures from the server. MONGODB-OIDC is validated
+// separately by Validate.
+func validateCredential(cred *Credential) error {
+	switch mech := cred.AuthMechanism; mech {
+	case auth.MongoDBX509:
+		if cred.Password != "" {
+			return fmt.Errorf("password must not be set for the %s auth mechanism", mech)
+		}
+		if cred.AuthSource != "" && cred.AuthSource != "$external" {
+			return fmt.Errorf(`AuthSource must be empty or "$external" for the %s auth mechanism, got %q`,
+				mech, cred.AuthSource)
+		}
+	case auth.PLAIN, auth.SCRAMSHA1, auth.SCRAMSHA256:
+		if cred.Username == "" {
+			return fmt.Errorf("username must be set for the %s auth mechanism", mech)
+		}
+		if cred.Password == "" {
+			return fmt.Errorf("password must be set for the %s auth mechanism", mech)
+		}
+	case auth.GSSAPI:
+		if cred.AuthSource != "" && cred.AuthSource != "$external" {
+			return fmt.Errorf(`AuthSource must be empty or "$external" for the %s auth mechanism, got %q`,
+				mech, cred.AuthSource)
+		}
+	case auth.MongoDBAWS:
+		if cred.AuthSource != "" && cred.AuthSource != "$external" {
+			return fmt.Errorf(`AuthSource must be empty or "$external" for the %s auth mechanism, got %q`,
+				mech, cred.AuthSource)
+		}
+		if cred.Username != "" && cred.Password == "" {
+			return fmt.Errorf("password must be set with username for the %s auth mechanism", mech)
+		}
+		if cred.Username == "" && cred.Password != "" {
+			return fmt.Errorf("username must be set with password for the %s auth mechanism", mech)
+		}
+	}
+	return nil
+}
+
 // ApplyURI parses the given URI and sets options accordingly. The URI can contain host names, IPv4/IPv6 literals, or
 // an SRV record that will be resolved when the Client is created. When using an SRV record, TLS support is
 // implicitly enabled. Specify the "tls=false" URI option to override this.
@@ -706,6 +805,25 @@ func (c *ClientOptions) SetCompressors(comps []string) *ClientOptions {
 	return c
 }
 
+// SetCompressorOptions sets the compressors that can be used when communicating with a server along with the
+// configuration for each of them. The compressors are negotiated in the same way as the ones set through
+// SetCompressors. If both are used, the compressors set through SetCompressorOptions are added to the list set
+// through SetCompressors, and the level in a CompressorOptions takes precedence over SetZlibLevel and SetZstdLevel for
+// the compressor with the same name.
+//
+// For example, to prefer zstd at level 3 and fall back to zlib at the default level:
+//
+//	level := 3
+//	opts.SetCompressorOptions([]options.CompressorOptions{
+//		{Name: "zstd", Level: &level},
+//		{Name: "zlib"},
+//	})
+func (c *ClientOptions) SetCompressorOptions(opts []CompressorOptions) *ClientOptions {
+	c.CompressorOptions = opts
+
+	return c
+}
+
 // SetConnectTimeout specifies a timeout that is used for creating connections to the server. This can be set through
 // ApplyURI with the "connectTimeoutMS" (e.g "connectTimeoutMS=30") option. If set to 0, no timeout will be used. The
 // default is 30 seconds.
@@ -745,6 +863,18 @@ func (c *ClientOptions) SetDirect(b bool) *ClientOptions {
 	return c
 }
 
+// SetDefaultTransactionOptions specifies the default transaction options for all sessions created by the Client.
+// The read concern, write concern, read preference, and max commit retry time set on opts are used for transactions
+// started in any of the Client's sessions. Options set through SessionOptionsBuilder.SetDefaultTransactionOptions
+// take precedence over these defaults, and options passed to Session.StartTransaction or Session.WithTransaction
+// take precedence over both. The default is nil, meaning transactions use the Client's read concern, write concern,
+// and read preference.
+func (c *ClientOptions) SetDefaultTransactionOptions(opts *TransactionOptionsBuilder) *ClientOptions {
+	c.DefaultTransactionOptions = opts
+
+	return c
+}
+
 // SetHeartbeatInterval specifies the amount of time to wait between periodic background server checks. This can also be
 // set through the "heartbeatFrequencyMS" URI option (e.g. "heartbeatFrequencyMS=10000"). The default is 10 seconds.
 // The minimum is 500ms.
@@ -765,6 +895,19 @@ func (c *ClientOptions) SetHosts(s []string) *ClientOptions {
 	return c
 }
 
+// SetIDGenerator specifies a function used to generate the "_id" value for documents inserted without one. The value
+// returned by the function must be marshalable to BSON using the Client's registry. The default is nil, meaning a new
+// bson.ObjectID is generated for each document. Tests that need reproducible ObjectIDs can use a
+// bson.ObjectIDGenerator:
+//
+//	gen := bson.NewObjectIDGenerator(clock, processUnique, 0)
+//	opts.SetIDGenerator(func() interface{} { return gen.NewObjectID() })
+func (c *ClientOptions) SetIDGenerator(gen func() interface{}) *ClientOptions {
+	c.IDGenerator = gen
+
+	return c
+}
+
 // SetLoadBalanced specifies whether or not the MongoDB deployment is hosted behind a load balancer. This can also be
 // set through the "loadBalanced" URI option. The driver will error during Client configuration if this option is set
 // to true and one of the following conditions are met:
@@ -912,6 +1055,28 @@ func (c *ClientOptions) SetReplicaSet(s string) *ClientOptions {
 	return c
 }
 
+// SetResolver specifies the Resolver used to look up the SRV and TXT records of "mongodb+srv" connection
+// strings, both when the URI is parsed and when SRV records are polled for changes. The records returned by
+// the Resolver are validated the same way as records from the default resolver. To use the Resolver for the
+// initial lookup, this function must be called before ApplyURI. The default is nil, meaning the default
+// resolver from the net package is used.
+func (c *ClientOptions) SetResolver(r Resolver) *ClientOptions {
+	c.Resolver = r
+
+	return c
+}
+
+// SetRetainRawResponses specifies whether cursors and single results keep the raw server response for the most
+// recent command they ran. The retained responses can be retrieved with Cursor.LastResponse,
+// ChangeStream.LastResponse, and SingleResult.DiagnosticRaw to diagnose decoding errors or responses with an
+// unexpected shape. Retaining responses keeps their buffers alive for the lifetime of the cursor or result. The
+// default is false.
+func (c *ClientOptions) SetRetainRawResponses(b bool) *ClientOptions {
+	c.RetainRawResponses = &b
+
+	return c
+}
+
 // SetRetryWrites specifies whether supported write operations should be retried once on certain errors, such as network
 // errors.
 //
@@ -961,8 +1126,9 @@ func (c *ClientOptions) SetServerSelectionTimeout(d time.Duration) *ClientOption
 // inherit a timeout from the Client.
 //
 // If any Timeout is set (even 0) on the Client, the values of MaxTime on
-// operation options, TransactionOptions.MaxCommitTime and
-// SessionOptions.DefaultMaxCommitTime will be ignored.
+// operation options, DefaultMaxTime on database and collection options,
+// TransactionOptions.MaxCommitTime and SessionOptions.DefaultMaxCommitTime will
+// be ignored.
 func (c *ClientOptions) SetTimeout(d time.Duration) *ClientOptions {
 	c.Timeout = &d
 
@@ -1039,7 +1205,7 @@ func (c *ClientOptions) SetZlibLevel(level int) *ClientOptions {
 }
 
 // SetZstdLevel sets the level for the zstd compressor. This option is ignored if zstd is not specified as a compressor
-// through ApplyURI or SetCompressors. Supported values are 1 through 20, inclusive. 1 means best speed and 20 means
+// through ApplyURI or SetCompressors. Supported values are 1 through 22, inclusive. 1 means best speed and 22 means
 // best compression. This can also be set through the "zstdCompressionLevel" URI option. Defaults to 6.
 func (c *ClientOptions) SetZstdLevel(level int) *ClientOptions {
 	c.ZstdLevel = &level
@@ -1263,13 +1429,50 @@ func addClientCertFromBytes(cfg *tls.Config, data []byte, keyPasswd string) (str
 	return crt.Subject.String(), nil
 }
 
-func stringSliceContains(source []string, target string) bool {
-	for _, str := range source {
-		if str == target {
-			return true
+// validateCompression returns an error if a compressor name or compression level is not supported.
+func (c *ClientOptions) validateCompression() error {
+	if c.ZlibLevel != nil {
+		if err := validateCompressionLevel("zlib", *c.ZlibLevel); err != nil {
+			return err
 		}
 	}
-	return false
+	if c.ZstdLevel != nil {
+		if err := validateCompressionLevel("zstd", *c.ZstdLevel); err != nil {
+			return err
+		}
+	}
+
+	for _, comp := range c.CompressorOptions {
+		switch comp.Name {
+		case "snappy", "zlib", "zstd":
+		default:
+			return fmt.Errorf("unsupported compressor: %q", comp.Name)
+		}
+		if comp.Level == nil {
+			continue
+		}
+		if err := validateCompressionLevel(comp.Name, *comp.Level); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateCompressionLevel(name string, level int) error {
+	const maxZstdLevel = 22
+
+	switch name {
+	case "zlib":
+		if level < -1 || level > 9 {
+			return fmt.Errorf("invalid zlib compression level %d: value must be between -1 and 9, inclusive", level)
+		}
+	case "zstd":
+		if level < 1 || level > maxZstdLevel {
+			return fmt.Errorf("invalid zstd compression level %d: value must be between 1 and %d, inclusive",
+				level, maxZstdLevel)
+		}
+	}
+	return nil
 }
 
 // create a username for x509 authentication from an x509 certificate subject.
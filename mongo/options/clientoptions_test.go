@@ -80,6 +80,8 @@ func TestClientOptions(t *testing.T) {
 			{"TLSConfig", (*ClientOptions).SetTLSConfig, &tls.Config{}, "TLSConfig", false},
 			{"WriteConcern", (*ClientOptions).SetWriteConcern, writeconcern.Majority(), "WriteConcern", false},
 			{"ZlibLevel", (*ClientOptions).SetZlibLevel, 6, "ZlibLevel", true},
+			{"ZstdLevel", (*ClientOptions).SetZstdLevel, 3, "ZstdLevel", true},
+			{"CompressorOptions", (*ClientOptions).SetCompressorOptions, []CompressorOptions{{Name: "zstd"}}, "CompressorOptions", true},
 			{"DisableOCSPEndpointCheck", (*ClientOptions).SetDisableOCSPEndpointCheck, true, "DisableOCSPEndpointCheck", true},
 			{"LoadBalanced", (*ClientOptions).SetLoadBalanced, true, "LoadBalanced", true},
 		}
@@ -438,6 +440,69 @@ func TestClientOptions(t *testing.T) {
 			})
 		}
 	})
+	t.Run("compression validation", func(t *testing.T) {
+		t.Parallel()
+
+		intPtr := func(i int) *int { return &i }
+
+		testCases := []struct {
+			name string
+			opts *ClientOptions
+			err  error
+		}{
+			{
+				name: "zstd level in range",
+				opts: Client().SetZstdLevel(22),
+				err:  nil,
+			},
+			{
+				name: "zstd level too high",
+				opts: Client().SetZstdLevel(23),
+				err:  errors.New("invalid zstd compression level 23: value must be between 1 and 22, inclusive"),
+			},
+			{
+				name: "zstd level too low",
+				opts: Client().SetZstdLevel(0),
+				err:  errors.New("invalid zstd compression level 0: value must be between 1 and 22, inclusive"),
+			},
+			{
+				name: "zlib level out of range",
+				opts: Client().SetZlibLevel(10),
+				err:  errors.New("invalid zlib compression level 10: value must be between -1 and 9, inclusive"),
+			},
+			{
+				name: "compressor options in range",
+				opts: Client().SetCompressorOptions([]CompressorOptions{
+					{Name: "zstd", Level: intPtr(3)},
+					{Name: "zlib", Level: intPtr(-1)},
+					{Name: "snappy"},
+				}),
+				err: nil,
+			},
+			{
+				name: "compressor options zstd level out of range",
+				opts: Client().SetCompressorOptions([]CompressorOptions{{Name: "zstd", Level: intPtr(30)}}),
+				err:  errors.New("invalid zstd compression level 30: value must be between 1 and 22, inclusive"),
+			},
+			{
+				name: "compressor options unknown compressor",
+				opts: Client().SetCompressorOptions([]CompressorOptions{{Name: "lz4"}}),
+				err:  errors.New(`unsupported compressor: "lz4"`),
+			},
+		}
+
+		for _, tc := range testCases {
+			tc := tc // Capture the range variable
+
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				err := tc.opts.Validate()
+				assert.Equal(t, tc.err, err, "expected error %v, got %v", tc.err, err)
+			})
+		}
+	})
+
 	t.Run("OIDC auth configuration validation", func(t *testing.T) {
 		t.Parallel()
 
@@ -523,6 +588,101 @@ func TestClientOptions(t *testing.T) {
 			})
 		}
 	})
+	t.Run("auth mechanism validation", func(t *testing.T) {
+		t.Parallel()
+
+		testCases := []struct {
+			name string
+			cred Credential
+			err  error
+		}{
+			{
+				name: "MONGODB-X509 valid",
+				cred: Credential{AuthMechanism: "MONGODB-X509", AuthSource: "$external", Username: "CN=client"},
+			},
+			{
+				name: "MONGODB-X509 password must not be set",
+				cred: Credential{AuthMechanism: "MONGODB-X509", Username: "CN=client", Password: "pencil"},
+				err:  errors.New("password must not be set for the MONGODB-X509 auth mechanism"),
+			},
+			{
+				name: "MONGODB-X509 AuthSource must be $external",
+				cred: Credential{AuthMechanism: "MONGODB-X509", AuthSource: "admin"},
+				err:  errors.New(`AuthSource must be empty or "$external" for the MONGODB-X509 auth mechanism, got "admin"`),
+			},
+			{
+				name: "PLAIN valid",
+				cred: Credential{AuthMechanism: "PLAIN", Username: "user", Password: "pencil"},
+			},
+			{
+				name: "PLAIN username must be set",
+				cred: Credential{AuthMechanism: "PLAIN", Password: "pencil"},
+				err:  errors.New("username must be set for the PLAIN auth mechanism"),
+			},
+			{
+				name: "PLAIN password must be set",
+				cred: Credential{AuthMechanism: "PLAIN", Username: "user"},
+				err:  errors.New("password must be set for the PLAIN auth mechanism"),
+			},
+			{
+				name: "SCRAM-SHA-1 valid",
+				cred: Credential{AuthMechanism: "SCRAM-SHA-1", Username: "user", Password: "pencil"},
+			},
+			{
+				name: "SCRAM-SHA-1 password must be set",
+				cred: Credential{AuthMechanism: "SCRAM-SHA-1", Username: "user"},
+				err:  errors.New("password must be set for the SCRAM-SHA-1 auth mechanism"),
+			},
+			{
+				name: "SCRAM-SHA-256 username must be set",
+				cred: Credential{AuthMechanism: "SCRAM-SHA-256", Password: "pencil"},
+				err:  errors.New("username must be set for the SCRAM-SHA-256 auth mechanism"),
+			},
+			{
+				name: "SCRAM-SHA-256 password must be set",
+				cred: Credential{AuthMechanism: "SCRAM-SHA-256", Username: "user"},
+				err:  errors.New("password must be set for the SCRAM-SHA-256 auth mechanism"),
+			},
+			{
+				name: "GSSAPI valid with password",
+				cred: Credential{AuthMechanism: "GSSAPI", Username: "user@REALM", Password: "pencil"},
+			},
+			{
+				name: "GSSAPI AuthSource must be $external",
+				cred: Credential{AuthMechanism: "GSSAPI", Username: "user@REALM", AuthSource: "admin"},
+				err:  errors.New(`AuthSource must be empty or "$external" for the GSSAPI auth mechanism, got "admin"`),
+			},
+			{
+				name: "MONGODB-AWS valid without credentials",
+				cred: Credential{AuthMechanism: "MONGODB-AWS"},
+			},
+			{
+				name: "MONGODB-AWS password must be set with username",
+				cred: Credential{AuthMechanism: "MONGODB-AWS", Username: "key"},
+				err:  errors.New("password must be set with username for the MONGODB-AWS auth mechanism"),
+			},
+			{
+				name: "MONGODB-AWS username must be set with password",
+				cred: Credential{AuthMechanism: "MONGODB-AWS", Password: "secret"},
+				err:  errors.New("username must be set with password for the MONGODB-AWS auth mechanism"),
+			},
+			{
+				name: "MONGODB-AWS AuthSource must be $external",
+				cred: Credential{AuthMechanism: "MONGODB-AWS", AuthSource: "admin"},
+				err:  errors.New(`AuthSource must be empty or "$external" for the MONGODB-AWS auth mechanism, got "admin"`),
+			},
+		}
+		for _, tc := range testCases {
+			tc := tc // Capture range variable.
+
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				err := Client().SetAuth(tc.cred).Validate()
+				assert.Equal(t, tc.err, err, "want error %v, got error %v", tc.err, err)
+			})
+		}
+	})
 }
 
 func createCertPool(t *testing.T, paths ...string) *x509.CertPool {
@@ -1262,3 +1422,87 @@ func TestApplyURI(t *testing.T) {
 		})
 	}
 }
+
+type fakeResolver struct {
+	srv []*net.SRV
+	txt []string
+}
+
+func (fr *fakeResolver) LookupSRV(context.Context, string, string, string) (string, []*net.SRV, error) {
+	return "", fr.srv, nil
+}
+
+func (fr *fakeResolver) LookupTXT(context.Context, string) ([]string, error) {
+	return fr.txt, nil
+}
+
+func TestSetResolver(t *testing.T) {
+	t.Parallel()
+
+	const uri = "mongodb+srv://test1.example.com/"
+
+	testCases := []struct {
+		name      string
+		resolver  *fakeResolver
+		wantHosts []string
+		wantAuth  string
+		wantErr   string
+	}{
+		{
+			name: "SRV records",
+			resolver: &fakeResolver{
+				srv: []*net.SRV{
+					{Target: "host1.example.com.", Port: 27017},
+					{Target: "host2.example.com.", Port: 27018},
+				},
+			},
+			wantHosts: []string{"host1.example.com:27017", "host2.example.com:27018"},
+		},
+		{
+			name: "TXT record with allowed options",
+			resolver: &fakeResolver{
+				srv: []*net.SRV{{Target: "host1.example.com.", Port: 27017}},
+				txt: []string{"authSource=thisDB"},
+			},
+			wantHosts: []string{"host1.example.com:27017"},
+			wantAuth:  "thisDB",
+		},
+		{
+			name: "TXT record with disallowed options",
+			resolver: &fakeResolver{
+				srv: []*net.SRV{{Target: "host1.example.com.", Port: 27017}},
+				txt: []string{"ssl=false"},
+			},
+			wantErr: "Cannot specify option 'ssl' in TXT record",
+		},
+		{
+			name: "SRV record with mismatched domain",
+			resolver: &fakeResolver{
+				srv: []*net.SRV{{Target: "host1.evil.com.", Port: 27017}},
+			},
+			wantErr: "Domain suffix from SRV record not matched input domain",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // Capture range variable.
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			opts := Client().SetResolver(tc.resolver).ApplyURI(uri)
+			err := opts.Validate()
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+
+			assert.NoError(t, err, "Validate error")
+			assert.Equal(t, tc.wantHosts, opts.Hosts, "expected hosts %v, got %v", tc.wantHosts, opts.Hosts)
+			if tc.wantAuth != "" {
+				assert.Equal(t, tc.wantAuth, opts.connString.AuthSource,
+					"expected auth source %q, got %q", tc.wantAuth, opts.connString.AuthSource)
+			}
+		})
+	}
+}
@@ -7,6 +7,8 @@
 package options
 
 import (
+	"time"
+
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo/readconcern"
 	"go.mongodb.org/mongo-driver/v2/mongo/readpref"
@@ -22,6 +24,7 @@ type CollectionOptions struct {
 	ReadPreference *readpref.ReadPref
 	BSONOptions    *BSONOptions
 	Registry       *bson.Registry
+	DefaultMaxTime *time.Duration
 }
 
 // CollectionOptionsBuilder contains options to configure a Collection instance.
@@ -103,3 +106,16 @@ func (c *CollectionOptionsBuilder) SetRegistry(r *bson.Registry) *CollectionOpti
 	})
 	return c
 }
+
+// SetDefaultMaxTime sets the value for the DefaultMaxTime field. DefaultMaxTime is the server-side
+// time limit (maxTimeMS) applied to count, distinct, FindOne, and findAndModify operations executed
+// on the Collection when the operation's context does not have a deadline. Find and Aggregate return
+// a user-managed cursor and never send it. This value is ignored if Timeout is set on the Client. The default value is nil, which means that the default
+// max time of the Database used to configure the Collection will be used.
+func (c *CollectionOptionsBuilder) SetDefaultMaxTime(d time.Duration) *CollectionOptionsBuilder {
+	c.Opts = append(c.Opts, func(opts *CollectionOptions) error {
+		opts.DefaultMaxTime = &d
+		return nil
+	})
+	return c
+}
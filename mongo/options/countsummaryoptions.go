@@ -0,0 +1,88 @@
+// Copyright (C) MongoDB, Inc. 2026-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package options
+
+// CountSummaryOptions represents arguments that can be used to configure a
+// CountSummary operation.
+//
+// See corresponding setter methods for documentation.
+type CountSummaryOptions struct {
+	Collation       *Collation
+	Comment         interface{}
+	Hint            interface{}
+	IncludeEstimate *bool
+}
+
+// CountSummaryOptionsBuilder contains options to configure count summary
+// operations. Each option can be set through setter functions. See
+// documentation for each setter function for an explanation of the option.
+type CountSummaryOptionsBuilder struct {
+	Opts []func(*CountSummaryOptions) error
+}
+
+// CountSummary creates a new CountSummaryOptions instance.
+func CountSummary() *CountSummaryOptionsBuilder {
+	return &CountSummaryOptionsBuilder{}
+}
+
+// List returns a list of CountSummaryOptions setter functions.
+func (cso *CountSummaryOptionsBuilder) List() []func(*CountSummaryOptions) error {
+	return cso.Opts
+}
+
+// SetCollation sets the value for the Collation field. Specifies a collation to use for string comparisons
+// when computing the exact count. The default value is nil, which means the default collation of the
+// collection will be used.
+func (cso *CountSummaryOptionsBuilder) SetCollation(c *Collation) *CountSummaryOptionsBuilder {
+	cso.Opts = append(cso.Opts, func(opts *CountSummaryOptions) error {
+		opts.Collation = c
+
+		return nil
+	})
+
+	return cso
+}
+
+// SetComment sets the value for the Comment field. Specifies a string or document that will be included in
+// server logs, profiling logs, and currentOp queries to help trace the operations. The default is nil,
+// which means that no comment will be included in the logs.
+func (cso *CountSummaryOptionsBuilder) SetComment(comment interface{}) *CountSummaryOptionsBuilder {
+	cso.Opts = append(cso.Opts, func(opts *CountSummaryOptions) error {
+		opts.Comment = comment
+
+		return nil
+	})
+
+	return cso
+}
+
+// SetHint sets the value for the Hint field. Specifies the index to use when computing the exact count.
+// This should either be the index name as a string or the index specification as a document. The driver
+// will return an error if the hint parameter is a multi-key map. The default value is nil, which means
+// that no hint will be sent.
+func (cso *CountSummaryOptionsBuilder) SetHint(h interface{}) *CountSummaryOptionsBuilder {
+	cso.Opts = append(cso.Opts, func(opts *CountSummaryOptions) error {
+		opts.Hint = h
+
+		return nil
+	})
+
+	return cso
+}
+
+// SetIncludeEstimate sets the value for the IncludeEstimate field. If true, an additional count command is
+// run to compute an estimate of the total number of documents in the collection from collection metadata.
+// The estimate ignores the filter. The default value is false.
+func (cso *CountSummaryOptionsBuilder) SetIncludeEstimate(b bool) *CountSummaryOptionsBuilder {
+	cso.Opts = append(cso.Opts, func(opts *CountSummaryOptions) error {
+		opts.IncludeEstimate = &b
+
+		return nil
+	})
+
+	return cso
+}
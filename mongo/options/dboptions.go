@@ -7,6 +7,8 @@
 package options
 
 import (
+	"time"
+
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo/readconcern"
 	"go.mongodb.org/mongo-driver/v2/mongo/readpref"
@@ -23,6 +25,7 @@ type DatabaseOptions struct {
 	ReadPreference *readpref.ReadPref
 	BSONOptions    *BSONOptions
 	Registry       *bson.Registry
+	DefaultMaxTime *time.Duration
 }
 
 // DatabaseOptionsBuilder contains options to configure a database object. Each
@@ -104,3 +107,18 @@ func (d *DatabaseOptionsBuilder) SetRegistry(r *bson.Registry) *DatabaseOptionsB
 	})
 	return d
 }
+
+// SetDefaultMaxTime sets the value for the DefaultMaxTime field. DefaultMaxTime is the server-side
+// time limit (maxTimeMS) applied to count, distinct, FindOne, and findAndModify operations executed
+// on Collections created from the Database when the operation's context does not have a deadline.
+// Find and Aggregate return a user-managed cursor and never send it. A Collection can override this value with CollectionOptions.SetDefaultMaxTime. This
+// value is ignored if Timeout is set on the Client. The default value is nil, which means that no
+// time limit is sent.
+func (d *DatabaseOptionsBuilder) SetDefaultMaxTime(dur time.Duration) *DatabaseOptionsBuilder {
+	d.Opts = append(d.Opts, func(opts *DatabaseOptions) error {
+		opts.DefaultMaxTime = &dur
+
+		return nil
+	})
+	return d
+}
@@ -11,7 +11,8 @@ package options
 //
 // See corresponding setter methods for documentation.
 type EstimatedDocumentCountOptions struct {
-	Comment interface{}
+	Comment      interface{}
+	UseCollStats *bool
 }
 
 // EstimatedDocumentCountOptionsBuilder contains options to estimate document
@@ -44,3 +45,16 @@ func (eco *EstimatedDocumentCountOptionsBuilder) SetComment(comment interface{})
 
 	return eco
 }
+
+// SetUseCollStats sets the value for the UseCollStats field. If true, the estimate is computed with an aggregation
+// that uses the $collStats stage instead of the count command. The counts of all shards of a sharded collection are
+// summed. The default value is false.
+func (eco *EstimatedDocumentCountOptionsBuilder) SetUseCollStats(b bool) *EstimatedDocumentCountOptionsBuilder {
+	eco.Opts = append(eco.Opts, func(opts *EstimatedDocumentCountOptions) error {
+		opts.UseCollStats = &b
+
+		return nil
+	})
+
+	return eco
+}
@@ -274,6 +274,7 @@ func (f *FindOptionsBuilder) SetSort(sort interface{}) *FindOptionsBuilder {
 // See corresponding setter methods for documentation.
 type FindOneOptions struct {
 	AllowPartialResults *bool
+	AssertSingleMatch   *bool
 	Collation           *Collation
 	Comment             interface{}
 	Hint                interface{}
@@ -314,6 +315,18 @@ func (f *FindOneOptionsBuilder) SetAllowPartialResults(b bool) *FindOneOptionsBu
 	return f
 }
 
+// SetAssertSingleMatch sets the value for the AssertSingleMatch field. If true, the operation will
+// request up to two documents and the returned SingleResult will report ErrMultipleDocuments if
+// more than one document matches the filter. This can be used to detect ambiguous queries. The
+// default value is false.
+func (f *FindOneOptionsBuilder) SetAssertSingleMatch(b bool) *FindOneOptionsBuilder {
+	f.Opts = append(f.Opts, func(opts *FindOneOptions) error {
+		opts.AssertSingleMatch = &b
+		return nil
+	})
+	return f
+}
+
 // SetCollation sets the value for the Collation field. Specifies a collation to use for string
 // comparisons during the operation. This option is only valid for MongoDB versions >= 3.4. For
 // previous server versions, the driver will return an error if this option is used. The
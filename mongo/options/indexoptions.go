@@ -11,7 +11,9 @@ package options
 //
 // See corresponding setter methods for documentation.
 type CreateIndexesOptions struct {
-	CommitQuorum interface{}
+	CommitQuorum    interface{}
+	IgnoreConflicts *bool
+	ErrorOnConflict *bool
 }
 
 // CreateIndexesOptionsBuilder contains options to create indexes. Each option
@@ -119,6 +121,39 @@ func (c *CreateIndexesOptionsBuilder) SetCommitQuorumVotingMembers() *CreateInde
 	return c
 }
 
+// SetIgnoreConflicts sets the value for the IgnoreConflicts field. If true, the
+// existing indexes on the collection are listed before the createIndexes
+// command is sent, and indexes that already exist with the same name, keys,
+// and options are removed from the request instead of being sent to the
+// server. Indexes that differ from an existing index with the same name (e.g.
+// in their keys or partialFilterExpression) are still sent and result in a
+// server error. The default value is false.
+func (c *CreateIndexesOptionsBuilder) SetIgnoreConflicts(b bool) *CreateIndexesOptionsBuilder {
+	c.Opts = append(c.Opts, func(opts *CreateIndexesOptions) error {
+		opts.IgnoreConflicts = &b
+
+		return nil
+	})
+
+	return c
+}
+
+// SetErrorOnConflict sets the value for the ErrorOnConflict field. If true,
+// every index is sent to the server regardless of the IgnoreConflicts option,
+// which restores the strict behavior of failing when an index already exists.
+// Setting it to false has the same effect as setting IgnoreConflicts to true.
+// If set, ErrorOnConflict takes precedence over IgnoreConflicts. The default
+// value is true unless IgnoreConflicts is set.
+func (c *CreateIndexesOptionsBuilder) SetErrorOnConflict(b bool) *CreateIndexesOptionsBuilder {
+	c.Opts = append(c.Opts, func(opts *CreateIndexesOptions) error {
+		opts.ErrorOnConflict = &b
+
+		return nil
+	})
+
+	return c
+}
+
 // DropIndexesOptions represents arguments that can be used to configure
 // IndexView.DropOne and IndexView.DropAll operations.
 type DropIndexesOptions struct{}
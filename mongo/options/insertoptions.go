@@ -62,6 +62,7 @@ type InsertManyOptions struct {
 	BypassDocumentValidation *bool
 	Comment                  interface{}
 	Ordered                  *bool
+	Progress                 func(insertedCount int)
 }
 
 // InsertManyOptionsBuilder contains options to configure insert operations.
@@ -123,3 +124,17 @@ func (imo *InsertManyOptionsBuilder) SetOrdered(b bool) *InsertManyOptionsBuilde
 
 	return imo
 }
+
+// SetProgress sets the value for the Progress field. Specifies a function that is called by
+// Collection.InsertManyFromFunc after each batch of documents is sent to the server, with the total
+// number of documents inserted so far. This option is ignored by Collection.InsertMany. The default
+// value is nil, which means that progress will not be reported.
+func (imo *InsertManyOptionsBuilder) SetProgress(fn func(insertedCount int)) *InsertManyOptionsBuilder {
+	imo.Opts = append(imo.Opts, func(opts *InsertManyOptions) error {
+		opts.Progress = fn
+
+		return nil
+	})
+
+	return imo
+}
@@ -68,3 +68,15 @@ const (
 	// if the post-image for this event is available.
 	WhenAvailable FullDocument = "whenAvailable"
 )
+
+// CommentString returns a comment option value consisting of the string s. String comments are supported by all
+// server versions that accept a comment for the operation.
+func CommentString(s string) interface{} {
+	return s
+}
+
+// CommentDocument returns a comment option value consisting of the document d. Comments of any BSON type, including
+// documents, require MongoDB 4.4 or later. The document is marshalled with the Collection's registry and BSON options.
+func CommentDocument(d bson.D) interface{} {
+	return d
+}
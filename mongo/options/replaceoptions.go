@@ -18,6 +18,7 @@ type ReplaceOptions struct {
 	Upsert                   *bool
 	Let                      interface{}
 	Sort                     interface{}
+	ValidateID               *bool
 }
 
 // ReplaceOptionsBuilder contains options to configure replace operations. Each
@@ -138,3 +139,19 @@ func (ro *ReplaceOptionsBuilder) SetSort(s interface{}) *ReplaceOptionsBuilder {
 
 	return ro
 }
+
+// SetValidateID sets the value for the ValidateID field. If true and the replacement document
+// contains an _id, the driver compares it to the _id in the filter and returns a client-side
+// error if they differ, instead of sending a replacement that the server would reject for
+// modifying the immutable _id field. The check is skipped if the filter has no _id or if its
+// _id is a query operator expression. Numeric values of different BSON types are compared by
+// value, so int32 1, double 1.0, and decimal128 1.00 are equal. The default value is false.
+func (ro *ReplaceOptionsBuilder) SetValidateID(b bool) *ReplaceOptionsBuilder {
+	ro.Opts = append(ro.Opts, func(opts *ReplaceOptions) error {
+		opts.ValidateID = &b
+
+		return nil
+	})
+
+	return ro
+}
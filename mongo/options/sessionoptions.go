@@ -48,8 +48,9 @@ func (s *SessionOptionsBuilder) SetCausalConsistency(b bool) *SessionOptionsBuil
 
 // SetDefaultTransactionOptions sets the value for the DefaultTransactionOptions field.
 // Specifies the default options for transactions started in the session. If this object
-// or any value on the object is nil, the client-level read concern, write concern,
-// and/or read preference will be used to start the session.
+// or any value on the object is nil, the client's default transaction options, or the
+// client-level read concern, write concern, and/or read preference will be used to start
+// the session.
 func (s *SessionOptionsBuilder) SetDefaultTransactionOptions(dt *TransactionOptionsBuilder) *SessionOptionsBuilder {
 	s.Opts = append(s.Opts, func(opts *SessionOptions) error {
 		opts.DefaultTransactionOptions = dt
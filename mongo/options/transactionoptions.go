@@ -7,6 +7,8 @@
 package options
 
 import (
+	"time"
+
 	"go.mongodb.org/mongo-driver/v2/mongo/readconcern"
 	"go.mongodb.org/mongo-driver/v2/mongo/readpref"
 	"go.mongodb.org/mongo-driver/v2/mongo/writeconcern"
@@ -17,9 +19,10 @@ import (
 //
 // See corresponding setter methods for documentation.
 type TransactionOptions struct {
-	ReadConcern    *readconcern.ReadConcern
-	ReadPreference *readpref.ReadPref
-	WriteConcern   *writeconcern.WriteConcern
+	ReadConcern        *readconcern.ReadConcern
+	ReadPreference     *readpref.ReadPref
+	WriteConcern       *writeconcern.WriteConcern
+	MaxCommitRetryTime *time.Duration
 }
 
 // TransactionOptionsBuilder contains arguments to configure count operations.
@@ -77,3 +80,19 @@ func (t *TransactionOptionsBuilder) SetWriteConcern(wc *writeconcern.WriteConcer
 
 	return t
 }
+
+// SetMaxCommitRetryTime sets the value for the MaxCommitRetryTime field. Specifies the maximum amount
+// of time that Session.WithTransaction will spend retrying the callback and the commit after errors
+// with the TransientTransactionError or UnknownTransactionCommitResult labels. When passed to
+// Session.StartTransaction, it instead bounds the commit retries of the next Session.CommitWithRetry
+// call. When the limit is reached, the last error is returned. The default value is nil, which
+// means that retries are attempted for up to 120 seconds.
+func (t *TransactionOptionsBuilder) SetMaxCommitRetryTime(d time.Duration) *TransactionOptionsBuilder {
+	t.Opts = append(t.Opts, func(opts *TransactionOptions) error {
+		opts.MaxCommitRetryTime = &d
+
+		return nil
+	})
+
+	return t
+}
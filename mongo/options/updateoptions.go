@@ -19,6 +19,12 @@ type UpdateOneOptions struct {
 	Upsert                   *bool
 	Let                      interface{}
 	Sort                     interface{}
+	SkipDollarKeyValidation  *bool
+	SetFields                map[string]interface{}
+	UnsetFields              []string
+	PushFields               map[string]interface{}
+	PullFields               map[string]interface{}
+	IncFields                map[string]int64
 }
 
 // UpdateOneOptionsBuilder contains options to configure UpdateOne operations.
@@ -165,6 +171,12 @@ type UpdateManyOptions struct {
 	Hint                     interface{}
 	Upsert                   *bool
 	Let                      interface{}
+	SkipDollarKeyValidation  *bool
+	SetFields                map[string]interface{}
+	UnsetFields              []string
+	PushFields               map[string]interface{}
+	PullFields               map[string]interface{}
+	IncFields                map[string]int64
 }
 
 // UpdateManyOptionsBuilder contains options to configure UpdateMany operations.
@@ -283,3 +295,171 @@ func (uo *UpdateManyOptionsBuilder) SetLet(l interface{}) *UpdateManyOptionsBuil
 
 	return uo
 }
+
+// SetSetFields sets the value for the SetFields field. SetFields is a shorthand for a $set update
+// operator: the driver builds {$set: <SetFields>} and combines it with the other shorthand fields
+// into the update document. If any shorthand field is set, the update argument passed to UpdateOne
+// must be nil, otherwise UpdateOne returns mongo.ErrConflictingUpdateSpec. Keys are sent in sorted
+// order. The default value is nil.
+func (uo *UpdateOneOptionsBuilder) SetSetFields(fields map[string]interface{}) *UpdateOneOptionsBuilder {
+	uo.Opts = append(uo.Opts, func(opts *UpdateOneOptions) error {
+		opts.SetFields = fields
+
+		return nil
+	})
+
+	return uo
+}
+
+// SetUnsetFields sets the value for the UnsetFields field. UnsetFields is a shorthand for an
+// $unset update operator that removes the given fields. See SetSetFields for how shorthand fields
+// are combined. The default value is nil.
+func (uo *UpdateOneOptionsBuilder) SetUnsetFields(fields []string) *UpdateOneOptionsBuilder {
+	uo.Opts = append(uo.Opts, func(opts *UpdateOneOptions) error {
+		opts.UnsetFields = fields
+
+		return nil
+	})
+
+	return uo
+}
+
+// SetPushFields sets the value for the PushFields field. PushFields is a shorthand for a $push
+// update operator. See SetSetFields for how shorthand fields are combined. The default value is
+// nil.
+func (uo *UpdateOneOptionsBuilder) SetPushFields(fields map[string]interface{}) *UpdateOneOptionsBuilder {
+	uo.Opts = append(uo.Opts, func(opts *UpdateOneOptions) error {
+		opts.PushFields = fields
+
+		return nil
+	})
+
+	return uo
+}
+
+// SetPullFields sets the value for the PullFields field. PullFields is a shorthand for a $pull
+// update operator. See SetSetFields for how shorthand fields are combined. The default value is
+// nil.
+func (uo *UpdateOneOptionsBuilder) SetPullFields(fields map[string]interface{}) *UpdateOneOptionsBuilder {
+	uo.Opts = append(uo.Opts, func(opts *UpdateOneOptions) error {
+		opts.PullFields = fields
+
+		return nil
+	})
+
+	return uo
+}
+
+// SetIncFields sets the value for the IncFields field. IncFields is a shorthand for an $inc update
+// operator. See SetSetFields for how shorthand fields are combined. The default value is nil.
+func (uo *UpdateOneOptionsBuilder) SetIncFields(fields map[string]int64) *UpdateOneOptionsBuilder {
+	uo.Opts = append(uo.Opts, func(opts *UpdateOneOptions) error {
+		opts.IncFields = fields
+
+		return nil
+	})
+
+	return uo
+}
+
+// SetSetFields sets the value for the SetFields field. SetFields is a shorthand for a $set update
+// operator: the driver builds {$set: <SetFields>} and combines it with the other shorthand fields
+// into the update document. If any shorthand field is set, the update argument passed to UpdateMany
+// must be nil, otherwise UpdateMany returns mongo.ErrConflictingUpdateSpec. Keys are sent in sorted
+// order. The default value is nil.
+func (uo *UpdateManyOptionsBuilder) SetSetFields(fields map[string]interface{}) *UpdateManyOptionsBuilder {
+	uo.Opts = append(uo.Opts, func(opts *UpdateManyOptions) error {
+		opts.SetFields = fields
+
+		return nil
+	})
+
+	return uo
+}
+
+// SetUnsetFields sets the value for the UnsetFields field. UnsetFields is a shorthand for an
+// $unset update operator that removes the given fields. See SetSetFields for how shorthand fields
+// are combined. The default value is nil.
+func (uo *UpdateManyOptionsBuilder) SetUnsetFields(fields []string) *UpdateManyOptionsBuilder {
+	uo.Opts = append(uo.Opts, func(opts *UpdateManyOptions) error {
+		opts.UnsetFields = fields
+
+		return nil
+	})
+
+	return uo
+}
+
+// SetPushFields sets the value for the PushFields field. PushFields is a shorthand for a $push
+// update operator. See SetSetFields for how shorthand fields are combined. The default value is
+// nil.
+func (uo *UpdateManyOptionsBuilder) SetPushFields(fields map[string]interface{}) *UpdateManyOptionsBuilder {
+	uo.Opts = append(uo.Opts, func(opts *UpdateManyOptions) error {
+		opts.PushFields = fields
+
+		return nil
+	})
+
+	return uo
+}
+
+// SetPullFields sets the value for the PullFields field. PullFields is a shorthand for a $pull
+// update operator. See SetSetFields for how shorthand fields are combined. The default value is
+// nil.
+func (uo *UpdateManyOptionsBuilder) SetPullFields(fields map[string]interface{}) *UpdateManyOptionsBuilder {
+	uo.Opts = append(uo.Opts, func(opts *UpdateManyOptions) error {
+		opts.PullFields = fields
+
+		return nil
+	})
+
+	return uo
+}
+
+// SetIncFields sets the value for the IncFields field. IncFields is a shorthand for an $inc update
+// operator. See SetSetFields for how shorthand fields are combined. The default value is nil.
+func (uo *UpdateManyOptionsBuilder) SetIncFields(fields map[string]int64) *UpdateManyOptionsBuilder {
+	uo.Opts = append(uo.Opts, func(opts *UpdateManyOptions) error {
+		opts.IncFields = fields
+
+		return nil
+	})
+
+	return uo
+}
+
+// SetSkipDollarKeyValidation sets the value for the SkipDollarKeyValidation field. If true, the
+// driver will not check that an update document starts with an update operator (e.g. $set) before
+// sending it to the server. This is intended for callers that build update documents they have
+// already validated. Update pipelines are still validated. The default value is false.
+//
+// The server treats an update document without update operators as a replacement document.
+// If this is true and such a document is passed to UpdateOne, the matched document is replaced in
+// full rather than updated.
+func (uo *UpdateOneOptionsBuilder) SetSkipDollarKeyValidation(b bool) *UpdateOneOptionsBuilder {
+	uo.Opts = append(uo.Opts, func(opts *UpdateOneOptions) error {
+		opts.SkipDollarKeyValidation = &b
+
+		return nil
+	})
+
+	return uo
+}
+
+// SetSkipDollarKeyValidation sets the value for the SkipDollarKeyValidation field. If true, the
+// driver will not check that an update document starts with an update operator (e.g. $set) before
+// sending it to the server. This is intended for callers that build update documents they have
+// already validated. Update pipelines are still validated. The default value is false.
+//
+// The server treats an update document without update operators as a replacement document.
+// If this is true and such a document is passed to UpdateMany, the server rejects it because
+// replacement documents cannot be applied to multiple documents.
+func (uo *UpdateManyOptionsBuilder) SetSkipDollarKeyValidation(b bool) *UpdateManyOptionsBuilder {
+	uo.Opts = append(uo.Opts, func(opts *UpdateManyOptions) error {
+		opts.SkipDollarKeyValidation = &b
+
+		return nil
+	})
+
+	return uo
+}
@@ -0,0 +1,249 @@
+// Copyright (C) MongoDB, Inc. 2026-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"errors"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
+)
+
+// Lookup returns a $lookup stage that performs an equality match between localField in the input documents and
+// foreignField in the documents of the from collection. The matching documents are added to each input document as an
+// array field named as.
+//
+// Example usage:
+//
+//	mongo.Pipeline{
+//		{{"$match", bson.D{{"status", "A"}}}},
+//		mongo.Lookup("inventory", "item", "sku", "inventory_docs"),
+//	}
+func Lookup(from, localField, foreignField, as string) bson.D {
+	return bson.D{{"$lookup", bson.D{
+		{"from", from},
+		{"localField", localField},
+		{"foreignField", foreignField},
+		{"as", as},
+	}}}
+}
+
+// LookupWithPipeline returns a $lookup stage that runs pipeline on the documents of the from collection and adds the
+// results to each input document as an array field named as. The let document defines variables that the pipeline
+// can access using the "$$" prefix. If let is nil, it is omitted from the stage.
+//
+// Example usage:
+//
+//	mongo.LookupWithPipeline(
+//		"warehouses",
+//		bson.D{{"order_item", "$item"}},
+//		mongo.Pipeline{
+//			{{"$match", bson.D{{"$expr", bson.D{{"$eq", bson.A{"$stock_item", "$$order_item"}}}}}}},
+//		},
+//		"stockdata",
+//	)
+func LookupWithPipeline(from string, let interface{}, pipeline Pipeline, as string) bson.D {
+	if pipeline == nil {
+		// The server requires pipeline to be an array, so make sure a nil pipeline is not encoded as null.
+		pipeline = Pipeline{}
+	}
+
+	stage := bson.D{{"from", from}}
+	if let != nil {
+		stage = append(stage, bson.E{"let", let})
+	}
+	stage = append(stage, bson.E{"pipeline", pipeline}, bson.E{"as", as})
+
+	return bson.D{{"$lookup", stage}}
+}
+
+// OutSpec identifies the output collection of an $out or $merge stage. If Database is empty, the collection is in the
+// database of the aggregation and OutSpec marshals as the collection name. Otherwise, it marshals as a
+// {db: <Database>, coll: <Collection>} document, which requires MongoDB 4.4 or later for $out. Collection is required.
+type OutSpec struct {
+	Database   string
+	Collection string
+}
+
+var _ bson.ValueMarshaler = OutSpec{}
+
+// MarshalBSONValue implements the bson.ValueMarshaler interface.
+func (o OutSpec) MarshalBSONValue() (byte, []byte, error) {
+	if o.Collection == "" {
+		return 0, nil, errors.New("output collection must not be empty")
+	}
+	if o.Database == "" {
+		return byte(bson.TypeString), bsoncore.AppendString(nil, o.Collection), nil
+	}
+	doc := bsoncore.NewDocumentBuilder().
+		AppendString("db", o.Database).
+		AppendString("coll", o.Collection).
+		Build()
+	return byte(bson.TypeEmbeddedDocument), doc, nil
+}
+
+// OutStage is the value of an $out stage. It marshals as the collection name if only Collection is set and as a
+// document otherwise. Collection is required. Writing to another database requires MongoDB 4.4 or later and writing to
+// a time-series collection requires MongoDB 7.0 or later. TimeseriesOptions can only be used if Database is set.
+//
+// Example usage:
+//
+//	mongo.Pipeline{
+//		{{"$match", bson.D{{"status", "A"}}}},
+//		mongo.Out(mongo.OutStage{Database: "reporting", Collection: "orders"}),
+//	}
+type OutStage struct {
+	Database          string
+	Collection        string
+	TimeseriesOptions *options.TimeSeriesOptions
+}
+
+var _ bson.ValueMarshaler = OutStage{}
+
+// MarshalBSONValue implements the bson.ValueMarshaler interface.
+func (o OutStage) MarshalBSONValue() (byte, []byte, error) {
+	if o.Collection == "" {
+		return 0, nil, errors.New("$out stage must specify an output collection")
+	}
+
+	spec := OutSpec{Database: o.Database, Collection: o.Collection}
+	if o.TimeseriesOptions == nil {
+		return spec.MarshalBSONValue()
+	}
+	if o.Database == "" {
+		return 0, nil, errors.New("$out stage with time-series options must specify a database")
+	}
+
+	ts, err := marshalTimeSeriesOptions(o.TimeseriesOptions)
+	if err != nil {
+		return 0, nil, err
+	}
+	doc := bsoncore.NewDocumentBuilder().
+		AppendString("db", o.Database).
+		AppendString("coll", o.Collection).
+		AppendDocument("timeseries", ts).
+		Build()
+	return byte(bson.TypeEmbeddedDocument), doc, nil
+}
+
+// Out returns an $out stage that writes the results of the aggregation to the collection described by stage.
+func Out(stage OutStage) bson.D {
+	return bson.D{{"$out", stage}}
+}
+
+// MergeWhenMatched is the behavior of a $merge stage when a result document matches an existing document in the output
+// collection.
+type MergeWhenMatched string
+
+// These constants specify the valid MergeWhenMatched values.
+const (
+	// MergeWhenMatchedReplace replaces the existing document with the result document.
+	MergeWhenMatchedReplace MergeWhenMatched = "replace"
+
+	// MergeWhenMatchedKeepExisting keeps the existing document.
+	MergeWhenMatchedKeepExisting MergeWhenMatched = "keepExisting"
+
+	// MergeWhenMatchedMerge merges the result document into the existing document.
+	MergeWhenMatchedMerge MergeWhenMatched = "merge"
+
+	// MergeWhenMatchedFail stops the aggregation with an error.
+	MergeWhenMatchedFail MergeWhenMatched = "fail"
+)
+
+// MergeWhenNotMatched is the behavior of a $merge stage when a result document does not match an existing document in
+// the output collection.
+type MergeWhenNotMatched string
+
+// These constants specify the valid MergeWhenNotMatched values.
+const (
+	// MergeWhenNotMatchedInsert inserts the result document into the output collection.
+	MergeWhenNotMatchedInsert MergeWhenNotMatched = "insert"
+
+	// MergeWhenNotMatchedDiscard discards the result document.
+	MergeWhenNotMatchedDiscard MergeWhenNotMatched = "discard"
+
+	// MergeWhenNotMatchedFail stops the aggregation with an error.
+	MergeWhenNotMatchedFail MergeWhenNotMatched = "fail"
+)
+
+// MergeStage is the value of a $merge stage. Into is required. On, Let, WhenMatched, and WhenNotMatched are omitted
+// if they are unset, in which case the server defaults apply. WhenMatchedPipeline specifies an update pipeline to
+// apply to matched documents and takes precedence over WhenMatched.
+//
+// Example usage:
+//
+//	mongo.Pipeline{
+//		{{"$group", bson.D{{"_id", "$item"}, {"total", bson.D{{"$sum", "$qty"}}}}}},
+//		mongo.Merge(mongo.MergeStage{
+//			Into:           mongo.OutSpec{Database: "reporting", Collection: "totals"},
+//			WhenMatched:    mongo.MergeWhenMatchedReplace,
+//			WhenNotMatched: mongo.MergeWhenNotMatchedInsert,
+//		}),
+//	}
+type MergeStage struct {
+	Into                OutSpec
+	On                  interface{}
+	Let                 interface{}
+	WhenMatched         MergeWhenMatched
+	WhenMatchedPipeline Pipeline
+	WhenNotMatched      MergeWhenNotMatched
+}
+
+var _ bson.ValueMarshaler = MergeStage{}
+
+// MarshalBSONValue implements the bson.ValueMarshaler interface.
+func (m MergeStage) MarshalBSONValue() (byte, []byte, error) {
+	if m.Into.Collection == "" {
+		return 0, nil, errors.New("$merge stage must specify an output collection")
+	}
+
+	typ, into, err := m.Into.MarshalBSONValue()
+	if err != nil {
+		return 0, nil, err
+	}
+	idx, doc := bsoncore.AppendDocumentStart(nil)
+	doc = bsoncore.AppendValueElement(doc, "into", bsoncore.Value{Type: bsoncore.Type(typ), Data: into})
+
+	if m.On != nil {
+		on, err := marshalValue(m.On, nil, nil)
+		if err != nil {
+			return 0, nil, err
+		}
+		doc = bsoncore.AppendValueElement(doc, "on", on)
+	}
+	if m.Let != nil {
+		let, err := marshalValue(m.Let, nil, nil)
+		if err != nil {
+			return 0, nil, err
+		}
+		doc = bsoncore.AppendValueElement(doc, "let", let)
+	}
+	if m.WhenMatchedPipeline != nil {
+		pipeline, err := marshalValue(m.WhenMatchedPipeline, nil, nil)
+		if err != nil {
+			return 0, nil, err
+		}
+		doc = bsoncore.AppendValueElement(doc, "whenMatched", pipeline)
+	} else if m.WhenMatched != "" {
+		doc = bsoncore.AppendStringElement(doc, "whenMatched", string(m.WhenMatched))
+	}
+	if m.WhenNotMatched != "" {
+		doc = bsoncore.AppendStringElement(doc, "whenNotMatched", string(m.WhenNotMatched))
+	}
+
+	doc, err = bsoncore.AppendDocumentEnd(doc, idx)
+	if err != nil {
+		return 0, nil, err
+	}
+	return byte(bson.TypeEmbeddedDocument), doc, nil
+}
+
+// Merge returns a $merge stage that writes the results of the aggregation to the collection described by stage.
+func Merge(stage MergeStage) bson.D {
+	return bson.D{{"$merge", stage}}
+}
@@ -0,0 +1,244 @@
+// Copyright (C) MongoDB, Inc. 2026-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+func TestLookup(t *testing.T) {
+	t.Parallel()
+
+	got := Lookup("inventory", "item", "sku", "inventory_docs")
+	want := bson.D{{"$lookup", bson.D{
+		{"from", "inventory"},
+		{"localField", "item"},
+		{"foreignField", "sku"},
+		{"as", "inventory_docs"},
+	}}}
+	assertStageBytesEqual(t, want, got)
+}
+
+func TestLookupWithPipeline(t *testing.T) {
+	t.Parallel()
+
+	match := bson.D{{"$match", bson.D{{"$expr", bson.D{{"$eq", bson.A{"$stock_item", "$$order_item"}}}}}}}
+
+	testCases := []struct {
+		name     string
+		let      interface{}
+		pipeline Pipeline
+		want     bson.D
+	}{
+		{
+			name:     "with let",
+			let:      bson.D{{"order_item", "$item"}},
+			pipeline: Pipeline{match},
+			want: bson.D{{"$lookup", bson.D{
+				{"from", "warehouses"},
+				{"let", bson.D{{"order_item", "$item"}}},
+				{"pipeline", bson.A{match}},
+				{"as", "stockdata"},
+			}}},
+		},
+		{
+			name:     "without let",
+			pipeline: Pipeline{match},
+			want: bson.D{{"$lookup", bson.D{
+				{"from", "warehouses"},
+				{"pipeline", bson.A{match}},
+				{"as", "stockdata"},
+			}}},
+		},
+		{
+			name: "nil pipeline",
+			want: bson.D{{"$lookup", bson.D{
+				{"from", "warehouses"},
+				{"pipeline", bson.A{}},
+				{"as", "stockdata"},
+			}}},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := LookupWithPipeline("warehouses", tc.let, tc.pipeline, "stockdata")
+			assertStageBytesEqual(t, tc.want, got)
+		})
+	}
+}
+
+func TestOut(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		stage   OutStage
+		want    bson.D
+		wantErr string
+	}{
+		{
+			name:  "collection only",
+			stage: OutStage{Collection: "orders"},
+			want:  bson.D{{"$out", "orders"}},
+		},
+		{
+			name:  "other database",
+			stage: OutStage{Database: "reporting", Collection: "orders"},
+			want:  bson.D{{"$out", bson.D{{"db", "reporting"}, {"coll", "orders"}}}},
+		},
+		{
+			name: "time-series",
+			stage: OutStage{
+				Database:          "reporting",
+				Collection:        "readings",
+				TimeseriesOptions: &options.TimeSeriesOptions{TimeField: "ts"},
+			},
+			want: bson.D{{"$out", bson.D{
+				{"db", "reporting"},
+				{"coll", "readings"},
+				{"timeseries", bson.D{{"timeField", "ts"}}},
+			}}},
+		},
+		{
+			name: "time-series without database",
+			stage: OutStage{
+				Collection:        "readings",
+				TimeseriesOptions: &options.TimeSeriesOptions{TimeField: "ts"},
+			},
+			wantErr: "$out stage with time-series options must specify a database",
+		},
+		{
+			name:    "missing collection",
+			stage:   OutStage{Database: "reporting"},
+			wantErr: "$out stage must specify an output collection",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := Out(tc.stage)
+			if tc.wantErr != "" {
+				_, err := bson.Marshal(got)
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			assertStageBytesEqual(t, tc.want, got)
+		})
+	}
+}
+
+func TestOutSpec(t *testing.T) {
+	t.Parallel()
+
+	_, err := bson.Marshal(bson.D{{"into", OutSpec{Database: "reporting"}}})
+	assert.ErrorContains(t, err, "output collection must not be empty")
+}
+
+func TestMerge(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		stage   MergeStage
+		want    bson.D
+		wantErr string
+	}{
+		{
+			name:  "into only",
+			stage: MergeStage{Into: OutSpec{Collection: "totals"}},
+			want:  bson.D{{"$merge", bson.D{{"into", "totals"}}}},
+		},
+		{
+			name: "all options",
+			stage: MergeStage{
+				Into:           OutSpec{Database: "reporting", Collection: "totals"},
+				On:             bson.A{"item", "date"},
+				Let:            bson.D{{"now", "$$NOW"}},
+				WhenMatched:    MergeWhenMatchedReplace,
+				WhenNotMatched: MergeWhenNotMatchedDiscard,
+			},
+			want: bson.D{{"$merge", bson.D{
+				{"into", bson.D{{"db", "reporting"}, {"coll", "totals"}}},
+				{"on", bson.A{"item", "date"}},
+				{"let", bson.D{{"now", "$$NOW"}}},
+				{"whenMatched", "replace"},
+				{"whenNotMatched", "discard"},
+			}}},
+		},
+		{
+			name: "whenMatched pipeline",
+			stage: MergeStage{
+				Into:                OutSpec{Collection: "totals"},
+				WhenMatched:         MergeWhenMatchedFail,
+				WhenMatchedPipeline: Pipeline{{{"$set", bson.D{{"total", "$$new.total"}}}}},
+			},
+			want: bson.D{{"$merge", bson.D{
+				{"into", "totals"},
+				{"whenMatched", bson.A{bson.D{{"$set", bson.D{{"total", "$$new.total"}}}}}},
+			}}},
+		},
+		{
+			name:    "missing collection",
+			stage:   MergeStage{Into: OutSpec{Database: "reporting"}},
+			wantErr: "$merge stage must specify an output collection",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := Merge(tc.stage)
+			if tc.wantErr != "" {
+				_, err := bson.Marshal(got)
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			assertStageBytesEqual(t, tc.want, got)
+		})
+	}
+}
+
+func TestOutputStageDetected(t *testing.T) {
+	t.Parallel()
+
+	pipelines := map[string]Pipeline{
+		"$out":   {{{"$match", bson.D{}}}, Out(OutStage{Database: "reporting", Collection: "orders"})},
+		"$merge": {{{"$match", bson.D{}}}, Merge(MergeStage{Into: OutSpec{Collection: "totals"}})},
+	}
+	for name, pipeline := range pipelines {
+		_, hasOutputStage, err := marshalAggregatePipeline(pipeline, nil, nil)
+		require.NoError(t, err, "marshalAggregatePipeline error")
+		assert.True(t, hasOutputStage, "expected %s to be detected as an output stage", name)
+	}
+}
+
+// assertStageBytesEqual compares the BSON encoding of two stages so that equivalent Go representations (e.g.
+// Pipeline and bson.A) are considered equal.
+func assertStageBytesEqual(t *testing.T, want, got bson.D) {
+	t.Helper()
+
+	wantBytes, err := bson.Marshal(want)
+	require.NoError(t, err, "Marshal error")
+	gotBytes, err := bson.Marshal(got)
+	require.NoError(t, err, "Marshal error")
+
+	assert.Equal(t, bson.Raw(wantBytes), bson.Raw(gotBytes), "expected stage %v, got %v", want, got)
+}
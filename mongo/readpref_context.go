@@ -0,0 +1,38 @@
+// Copyright (C) MongoDB, Inc. 2026-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/v2/mongo/readpref"
+)
+
+type readPrefKey struct{}
+
+// WithReadPreference returns a Context that holds the given read preference.
+// Read operations on a Collection (Find, FindOne, Aggregate, CountDocuments,
+// EstimatedDocumentCount, and Distinct) run with the returned Context use rp
+// instead of the Collection's read preference. If rp is nil, the Collection's
+// read preference is used.
+//
+// The read preference of a running transaction always takes precedence over
+// the read preference stored in the Context.
+func WithReadPreference(parent context.Context, rp *readpref.ReadPref) context.Context {
+	return context.WithValue(parent, readPrefKey{}, rp)
+}
+
+// readPrefFromContext returns the read preference stored in ctx using
+// WithReadPreference, or nil if there is none.
+func readPrefFromContext(ctx context.Context) *readpref.ReadPref {
+	if ctx == nil {
+		return nil
+	}
+
+	rp, _ := ctx.Value(readPrefKey{}).(*readpref.ReadPref)
+	return rp
+}
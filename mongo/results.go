@@ -7,6 +7,9 @@
 package mongo
 
 import (
+	"fmt"
+	"time"
+
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
@@ -85,6 +88,10 @@ type BulkWriteResult struct {
 	// A map of operation index to the _id of each upserted document.
 	UpsertedIDs map[int64]interface{}
 
+	// A map of operation index to the _id of each document inserted by an InsertOneModel. A value generated by the
+	// driver will be of type bson.ObjectID. Documents that were not inserted because of a write error are omitted.
+	InsertedIDs map[int64]interface{}
+
 	// Operation performed with an acknowledged write. Values for other fields may
 	// not be deterministic if the write operation was unacknowledged.
 	Acknowledged bool
@@ -103,13 +110,35 @@ type InsertOneResult struct {
 // InsertManyResult is a result type returned by an InsertMany operation.
 type InsertManyResult struct {
 	// The _id values of the inserted documents. Values generated by the driver will be of type bson.ObjectID.
+	// Values provided by the application are decoded using the collection's registry, so they keep their BSON
+	// types (e.g. an int64 _id is returned as an int64, not an int32).
 	InsertedIDs []interface{}
 
+	// The _id values of the inserted documents as raw BSON values. The entries correspond to the entries in
+	// InsertedIDs and can be used to inspect the exact BSON type of each _id.
+	InsertedIDValues []bson.RawValue
+
 	// Operation performed with an acknowledged write. Values for other fields may
 	// not be deterministic if the write operation was unacknowledged.
 	Acknowledged bool
 }
 
+// InsertedIDAt returns the _id value of the i-th inserted document and its BSON type. An error is returned if i is out
+// of range.
+func (imr *InsertManyResult) InsertedIDAt(i int) (interface{}, bson.Type, error) {
+	if i < 0 || i >= len(imr.InsertedIDs) || i >= len(imr.InsertedIDValues) {
+		return nil, 0, fmt.Errorf("inserted ID index %d out of range [0, %d)", i, len(imr.InsertedIDs))
+	}
+	return imr.InsertedIDs[i], imr.InsertedIDValues[i].Type, nil
+}
+
+func (imr *InsertManyResult) appendInsertedIDs(ids []insertedID) {
+	for _, id := range ids {
+		imr.InsertedIDs = append(imr.InsertedIDs, id.value)
+		imr.InsertedIDValues = append(imr.InsertedIDValues, id.raw)
+	}
+}
+
 // TODO(GODRIVER-2367): Remove the BSON struct tags on DeleteResult.
 
 // DeleteResult is the result type returned by DeleteOne and DeleteMany operations.
@@ -121,6 +150,19 @@ type DeleteResult struct {
 	Acknowledged bool
 }
 
+// CountSummary is the result type returned by a CountSummary operation.
+type CountSummary struct {
+	// Exact is the number of documents that match the filter.
+	Exact int64
+
+	// Estimated is an estimate of the total number of documents in the collection, computed from collection
+	// metadata without applying the filter. It is only set if the IncludeEstimate option is true.
+	Estimated int64
+
+	// StoredAt is the server time at which the exact count was computed.
+	StoredAt time.Time
+}
+
 // RewrapManyDataKeyResult is the result of the bulk write operation used to update the key vault collection with
 // rewrapped data keys.
 type RewrapManyDataKeyResult struct {
@@ -200,6 +242,16 @@ type IndexSpecification struct {
 	Clustered *bool
 }
 
+// CreateManyResult is the result type returned by IndexView.CreateManyWithResult.
+type CreateManyResult struct {
+	// The names of the indexes that were created.
+	Created []string
+
+	// The names of the indexes that were not created because an identical index already existed. Indexes are only
+	// skipped if the IgnoreConflicts option is used.
+	Skipped []string
+}
+
 type indexListSpecificationResponse struct {
 	Name               string   `bson:"name"`
 	Namespace          string   `bson:"ns"`
@@ -153,7 +153,7 @@ func (siv SearchIndexView) CreateMany(
 
 	sess := sessionFromContext(ctx)
 
-	if sess == nil && siv.coll.client.sessionPool != nil {
+	if sess == nil && siv.coll.client.sessionPool != nil && !implicitSessionDisabled(ctx) {
 		sess = session.NewImplicitClientSession(siv.coll.client.sessionPool, siv.coll.client.id)
 		defer sess.EndSession()
 	}
@@ -210,7 +210,7 @@ func (siv SearchIndexView) DropOne(
 	}
 
 	sess := sessionFromContext(ctx)
-	if sess == nil && siv.coll.client.sessionPool != nil {
+	if sess == nil && siv.coll.client.sessionPool != nil && !implicitSessionDisabled(ctx) {
 		sess = session.NewImplicitClientSession(siv.coll.client.sessionPool, siv.coll.client.id)
 		defer sess.EndSession()
 	}
@@ -267,7 +267,7 @@ func (siv SearchIndexView) UpdateOne(
 	}
 
 	sess := sessionFromContext(ctx)
-	if sess == nil && siv.coll.client.sessionPool != nil {
+	if sess == nil && siv.coll.client.sessionPool != nil && !implicitSessionDisabled(ctx) {
 		sess = session.NewImplicitClientSession(siv.coll.client.sessionPool, siv.coll.client.id)
 		defer sess.EndSession()
 	}
@@ -8,6 +8,7 @@ package mongo
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"time"
@@ -45,6 +46,54 @@ type Session struct {
 	client              *Client
 	deployment          driver.Deployment
 	didCommitAfterStart bool // true if commit was called after start with no other operations
+
+	// maxCommitRetryTime is the default MaxCommitRetryTime for WithTransaction, taken from the client's or
+	// session's default transaction options.
+	maxCommitRetryTime *time.Duration
+
+	// txnMaxCommitRetryTime is the MaxCommitRetryTime passed to the last StartTransaction call, if any.
+	txnMaxCommitRetryTime *time.Duration
+}
+
+// TransactionState indicates the state of the transaction associated with a Session.
+type TransactionState uint8
+
+// These constants are the possible states of a Session's transaction.
+const (
+	// TransactionNone indicates that no transaction has been started on the Session, or that an
+	// operation has been run outside of a transaction after the previous one finished.
+	TransactionNone TransactionState = iota
+
+	// TransactionStarting indicates that StartTransaction has been called but no operations have
+	// been run in the transaction yet.
+	TransactionStarting
+
+	// TransactionInProgress indicates that at least one operation has been run in the transaction.
+	TransactionInProgress
+
+	// TransactionCommitted indicates that the most recent transaction was committed.
+	TransactionCommitted
+
+	// TransactionAborted indicates that the most recent transaction was aborted.
+	TransactionAborted
+)
+
+// String implements the fmt.Stringer interface.
+func (ts TransactionState) String() string {
+	switch ts {
+	case TransactionNone:
+		return "none"
+	case TransactionStarting:
+		return "starting"
+	case TransactionInProgress:
+		return "in progress"
+	case TransactionCommitted:
+		return "committed"
+	case TransactionAborted:
+		return "aborted"
+	default:
+		return "unknown"
+	}
 }
 
 type sessionKey struct{}
@@ -76,6 +125,29 @@ func SessionFromContext(ctx context.Context) *Session {
 	return sess
 }
 
+type noImplicitSessionKey struct{}
+
+// WithoutImplicitSession returns a Context that disables implicit session
+// creation for operations run with it. By default, the driver starts an
+// implicit session for each operation that is not run in an explicit Session.
+// Operations run with the returned Context are sent without a logical session
+// ID (lsid) instead, which can be useful for deployments that do not support
+// sessions. Because retryable writes require a session, writes run with the
+// returned Context are not retried.
+//
+// An explicit Session stored in the Context, such as one added with
+// NewSessionContext, is still used.
+func WithoutImplicitSession(parent context.Context) context.Context {
+	return context.WithValue(parent, noImplicitSessionKey{}, true)
+}
+
+// implicitSessionDisabled reports whether implicit session creation has been
+// disabled for the given Context using WithoutImplicitSession.
+func implicitSessionDisabled(ctx context.Context) bool {
+	disabled, _ := ctx.Value(noImplicitSessionKey{}).(bool)
+	return disabled
+}
+
 // ClientSession returns the experimental client session.
 //
 // Deprecated: This method is for internal use only and should not be used (see
@@ -90,6 +162,23 @@ func (s *Session) ID() bson.Raw {
 	return bson.Raw(s.clientSession.SessionID)
 }
 
+// TransactionState returns the current state of the transaction associated
+// with the session.
+func (s *Session) TransactionState() TransactionState {
+	switch s.clientSession.TransactionState {
+	case session.Starting:
+		return TransactionStarting
+	case session.InProgress:
+		return TransactionInProgress
+	case session.Committed:
+		return TransactionCommitted
+	case session.Aborted:
+		return TransactionAborted
+	default:
+		return TransactionNone
+	}
+}
+
 // EndSession aborts any existing transactions and close the session.
 func (s *Session) EndSession(ctx context.Context) {
 	if s.clientSession.TransactionInProgress() {
@@ -101,7 +190,9 @@ func (s *Session) EndSession(ctx context.Context) {
 
 // WithTransaction starts a transaction on this session and runs the fn
 // callback. Errors with the TransientTransactionError and
-// UnknownTransactionCommitResult labels are retried for up to 120 seconds.
+// UnknownTransactionCommitResult labels are retried for up to 120 seconds, or
+// for the duration set by TransactionOptionsBuilder.SetMaxCommitRetryTime.
+// Retrying stops as soon as ctx is done, returning the last error.
 // Inside the callback, the SessionContext must be used as the Context parameter
 // for any operations that should be part of the transaction. If the ctx
 // parameter already has a Session attached to it, it will be replaced by this
@@ -123,15 +214,19 @@ func (s *Session) EndSession(ctx context.Context) {
 // driver will call AbortTransaction. Because this method must succeed to ensure
 // that server-side resources are properly cleaned up, context deadlines and
 // cancellations will not be respected during this call. For a usage example,
-// see the Client.StartSession method documentation.
+// see the Client.StartSessionWithContext method documentation.
 func (s *Session) WithTransaction(
 	ctx context.Context,
 	fn func(ctx context.Context) (interface{}, error),
 	opts ...options.Lister[options.TransactionOptions],
 ) (interface{}, error) {
-	timeout := time.NewTimer(withTransactionTimeout)
+	args, err := mongoutil.NewOptions[options.TransactionOptions](opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to construct options from builder: %w", err)
+	}
+
+	timeout := time.NewTimer(s.commitRetryTime(args.MaxCommitRetryTime))
 	defer timeout.Stop()
-	var err error
 	for {
 		err = s.StartTransaction(opts...)
 		if err != nil {
@@ -149,6 +244,8 @@ func (s *Session) WithTransaction(
 			select {
 			case <-timeout.C:
 				return nil, err
+			case <-ctx.Done():
+				return nil, err
 			default:
 			}
 
@@ -179,31 +276,81 @@ func (s *Session) WithTransaction(
 			return nil, ctx.Err()
 		}
 
-	CommitLoop:
-		for {
-			err = s.CommitTransaction(newBackgroundContext(ctx))
-			// End when error is nil, as transaction has been committed.
-			if err == nil {
-				return res, nil
-			}
+		retryTxn, err := s.commitWithRetry(ctx, timeout.C)
+		if err == nil {
+			return res, nil
+		}
+		if !retryTxn {
+			return res, err
+		}
+	}
+}
 
-			select {
-			case <-timeout.C:
-				return res, err
-			default:
-			}
+// CommitWithRetry commits the active transaction for this session, retrying the
+// commit on errors with the UnknownTransactionCommitResult label. Retrying stops
+// as soon as the commit succeeds, ctx is done, or the retry time has elapsed,
+// returning the last error. The retry time is the MaxCommitRetryTime passed to
+// StartTransaction, or the one set in the client's or session's default
+// transaction options, or 120 seconds if neither is set.
+//
+// Errors with the TransientTransactionError label are returned without retrying
+// the commit because the whole transaction must be run again. Callers can check
+// for the label using the HasErrorLabel method of the returned error and restart
+// the transaction with StartTransaction. WithTransaction does this automatically
+// for callback-based transactions.
+//
+// Like WithTransaction, CommitWithRetry runs each commit attempt to completion,
+// ignoring the deadline and cancellation of ctx.
+func (s *Session) CommitWithRetry(ctx context.Context) error {
+	timeout := time.NewTimer(s.commitRetryTime(s.txnMaxCommitRetryTime))
+	defer timeout.Stop()
 
-			var cerr CommandError
-			if errors.As(err, &cerr) {
-				if cerr.HasErrorLabel(driver.UnknownTransactionCommitResult) && !cerr.IsMaxTimeMSExpiredError() {
-					continue
-				}
-				if cerr.HasErrorLabel(driver.TransientTransactionError) {
-					break CommitLoop
-				}
+	_, err := s.commitWithRetry(ctx, timeout.C)
+	return err
+}
+
+// commitRetryTime returns how long to retry a transaction: txnTime if it is set, otherwise the
+// session's default MaxCommitRetryTime, otherwise 120 seconds.
+func (s *Session) commitRetryTime(txnTime *time.Duration) time.Duration {
+	if txnTime != nil {
+		return *txnTime
+	}
+	if s.maxCommitRetryTime != nil {
+		return *s.maxCommitRetryTime
+	}
+	return withTransactionTimeout
+}
+
+// commitWithRetry commits the active transaction, retrying on errors with the
+// UnknownTransactionCommitResult label until the commit succeeds, timeout fires,
+// or ctx is done. It reports whether the whole transaction should be retried
+// because the commit failed with the TransientTransactionError label.
+func (s *Session) commitWithRetry(ctx context.Context, timeout <-chan time.Time) (bool, error) {
+	for {
+		err := s.CommitTransaction(newBackgroundContext(ctx))
+		// End when error is nil, as transaction has been committed.
+		if err == nil {
+			return false, nil
+		}
+
+		select {
+		case <-timeout:
+			return false, err
+		case <-ctx.Done():
+			return false, err
+		default:
+		}
+
+		var cerr CommandError
+		if errors.As(err, &cerr) {
+			if cerr.HasErrorLabel(driver.UnknownTransactionCommitResult) && !cerr.IsMaxTimeMSExpiredError() {
+				continue
+			}
+			if cerr.HasErrorLabel(driver.TransientTransactionError) {
+				return true, err
 			}
-			return res, err
 		}
+		return false, err
 	}
 }
 
@@ -228,7 +375,13 @@ func (s *Session) StartTransaction(opts ...options.Lister[options.TransactionOpt
 		WriteConcern:   args.WriteConcern,
 	}
 
-	return s.clientSession.StartTransaction(coreOpts)
+	err = s.clientSession.StartTransaction(coreOpts)
+	if err != nil {
+		return err
+	}
+
+	s.txnMaxCommitRetryTime = args.MaxCommitRetryTime
+	return nil
 }
 
 // AbortTransaction aborts the active transaction for this session. This method
@@ -330,6 +483,125 @@ func (s *Session) AdvanceOperationTime(ts *bson.Timestamp) error {
 	return s.clientSession.AdvanceOperationTime(ts)
 }
 
+// CausalTimes contains the cluster time and operation time of a session. It can be used to continue a causally
+// consistent sequence of operations in a session that belongs to a different Client, including one in another
+// process:
+//
+//	text, err := sess1.CausalTimes().MarshalText()
+//	// ... pass text to the other process (e.g. in an HTTP header) ...
+//	var times mongo.CausalTimes
+//	if err := times.UnmarshalText(text); err != nil {
+//		return err
+//	}
+//	err = sess2.AdvanceCausalTimes(times)
+type CausalTimes struct {
+	// ClusterTime is the $clusterTime document as returned by Session.ClusterTime.
+	ClusterTime bson.Raw
+
+	// OperationTime is the operation time as returned by Session.OperationTime.
+	OperationTime *bson.Timestamp
+}
+
+// MarshalText encodes ct as unpadded, URL-safe base64 text. The output only contains characters that are safe to
+// use in HTTP headers and URL query parameters.
+func (ct CausalTimes) MarshalText() ([]byte, error) {
+	if err := ct.validate(); err != nil {
+		return nil, err
+	}
+
+	doc := bsoncore.NewDocumentBuilder()
+	if ct.ClusterTime != nil {
+		doc.AppendDocument("clusterTime", ct.ClusterTime)
+	}
+	if ct.OperationTime != nil {
+		doc.AppendTimestamp("operationTime", ct.OperationTime.T, ct.OperationTime.I)
+	}
+	raw := doc.Build()
+
+	text := make([]byte, base64.RawURLEncoding.EncodedLen(len(raw)))
+	base64.RawURLEncoding.Encode(text, raw)
+	return text, nil
+}
+
+// UnmarshalText decodes text produced by MarshalText into ct. It returns an error if text is not valid.
+func (ct *CausalTimes) UnmarshalText(text []byte) error {
+	raw := make([]byte, base64.RawURLEncoding.DecodedLen(len(text)))
+	n, err := base64.RawURLEncoding.Decode(raw, text)
+	if err != nil {
+		return fmt.Errorf("error decoding causal times: %w", err)
+	}
+	doc := bson.Raw(raw[:n])
+	if err := doc.Validate(); err != nil {
+		return fmt.Errorf("error decoding causal times: %w", err)
+	}
+
+	var times CausalTimes
+	if val, err := doc.LookupErr("clusterTime"); err == nil {
+		clusterTime, ok := val.DocumentOK()
+		if !ok {
+			return fmt.Errorf("invalid causal times: clusterTime must be a document, got %v", val.Type)
+		}
+		times.ClusterTime = clusterTime
+	}
+	if val, err := doc.LookupErr("operationTime"); err == nil {
+		t, i, ok := val.TimestampOK()
+		if !ok {
+			return fmt.Errorf("invalid causal times: operationTime must be a timestamp, got %v", val.Type)
+		}
+		times.OperationTime = &bson.Timestamp{T: t, I: i}
+	}
+	if err := times.validate(); err != nil {
+		return err
+	}
+
+	*ct = times
+	return nil
+}
+
+// validate returns an error if ct.ClusterTime is set but is not a $clusterTime document.
+func (ct CausalTimes) validate() error {
+	if ct.ClusterTime == nil {
+		return nil
+	}
+
+	val, err := ct.ClusterTime.LookupErr("$clusterTime", "clusterTime")
+	if err != nil {
+		return fmt.Errorf("invalid causal times: cluster time has no $clusterTime.clusterTime field: %w", err)
+	}
+	if _, _, ok := val.TimestampOK(); !ok {
+		return fmt.Errorf("invalid causal times: $clusterTime.clusterTime must be a timestamp, got %v", val.Type)
+	}
+	return nil
+}
+
+// CausalTimes returns the current cluster time and operation time of the session.
+func (s *Session) CausalTimes() CausalTimes {
+	return CausalTimes{
+		ClusterTime:   s.ClusterTime(),
+		OperationTime: s.OperationTime(),
+	}
+}
+
+// AdvanceCausalTimes advances the cluster time and operation time of the session to the times in ct. Times that are
+// not set in ct are left unchanged. This method returns an error if ct is not valid or if the session has ended.
+func (s *Session) AdvanceCausalTimes(ct CausalTimes) error {
+	if err := ct.validate(); err != nil {
+		return err
+	}
+
+	if ct.ClusterTime != nil {
+		if err := s.AdvanceClusterTime(ct.ClusterTime); err != nil {
+			return err
+		}
+	}
+	if ct.OperationTime != nil {
+		if err := s.AdvanceOperationTime(ct.OperationTime); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Client is the Client associated with the session.
 func (s *Session) Client() *Client {
 	return s.client
@@ -0,0 +1,523 @@
+// Copyright (C) MongoDB, Inc. 2026-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package mongo
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/event"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.mongodb.org/mongo-driver/v2/mongo/readconcern"
+	"go.mongodb.org/mongo-driver/v2/mongo/writeconcern"
+	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver"
+)
+
+func newMockSession(t *testing.T) *Session {
+	t.Helper()
+
+	client := newMockClient(t, nil).Client
+
+	sess, err := client.StartSession()
+	require.NoError(t, err, "StartSession error")
+	t.Cleanup(func() { sess.EndSession(context.Background()) })
+
+	return sess
+}
+
+func TestSession_TransactionState(t *testing.T) {
+	sess := newMockSession(t)
+	assert.Equal(t, TransactionNone, sess.TransactionState(),
+		"expected state %v, got %v", TransactionNone, sess.TransactionState())
+
+	err := sess.StartTransaction()
+	require.NoError(t, err, "StartTransaction error")
+	assert.Equal(t, TransactionStarting, sess.TransactionState(),
+		"expected state %v, got %v", TransactionStarting, sess.TransactionState())
+
+	err = sess.AbortTransaction(context.Background())
+	require.NoError(t, err, "AbortTransaction error")
+	assert.Equal(t, TransactionAborted, sess.TransactionState(),
+		"expected state %v, got %v", TransactionAborted, sess.TransactionState())
+	assert.Equal(t, "aborted", sess.TransactionState().String(),
+		"expected state string %q, got %q", "aborted", sess.TransactionState().String())
+}
+
+func TestSession_WithTransactionRetryTimeout(t *testing.T) {
+	transientErr := CommandError{Name: "test Error", Labels: []string{driver.TransientTransactionError}}
+
+	t.Run("MaxCommitRetryTime bounds retries", func(t *testing.T) {
+		sess := newMockSession(t)
+
+		var count int
+		start := time.Now()
+		txnOpts := options.Transaction().SetMaxCommitRetryTime(100 * time.Millisecond)
+		_, err := sess.WithTransaction(context.Background(), func(context.Context) (interface{}, error) {
+			count++
+			time.Sleep(10 * time.Millisecond)
+			return nil, transientErr
+		}, txnOpts)
+		elapsed := time.Since(start)
+
+		var cmdErr CommandError
+		require.True(t, errors.As(err, &cmdErr), "expected error type %T, got %T", cmdErr, err)
+		assert.True(t, cmdErr.HasErrorLabel(driver.TransientTransactionError),
+			"expected error with label %v, got %v", driver.TransientTransactionError, cmdErr)
+		assert.Greater(t, count, 1, "expected WithTransaction callback to be retried at least once")
+		assert.Less(t, elapsed, 2*time.Second, "expected WithTransaction to stop retrying after 100ms, took %v", elapsed)
+	})
+	t.Run("canceled context stops retries", func(t *testing.T) {
+		sess := newMockSession(t)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var count int
+		_, err := sess.WithTransaction(ctx, func(context.Context) (interface{}, error) {
+			count++
+			cancel()
+			return nil, transientErr
+		})
+
+		var cmdErr CommandError
+		require.True(t, errors.As(err, &cmdErr), "expected error type %T, got %T", cmdErr, err)
+		assert.Equal(t, 1, count, "expected WithTransaction callback to run once, ran %d times", count)
+	})
+}
+
+func TestWithoutImplicitSession(t *testing.T) {
+	mc := newMockClient(t, nil)
+
+	coll := mc.Database(testDbName).Collection("coll")
+
+	testCases := []struct {
+		name     string
+		ctx      context.Context
+		wantLsid bool
+	}{
+		{"implicit session", context.Background(), true},
+		{"implicit session disabled", WithoutImplicitSession(context.Background()), false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mc.started = nil
+			mc.md.ClearResponses()
+			mc.md.AddResponses(bson.D{{"ok", 1}, {"n", 1}})
+
+			_, err := coll.InsertOne(tc.ctx, bson.D{{"x", 1}})
+			require.NoError(t, err, "InsertOne error")
+			require.Len(t, mc.started, 1, "expected 1 started event, got %d", len(mc.started))
+
+			_, err = mc.started[0].Command.LookupErr("lsid")
+			assert.Equal(t, tc.wantLsid, err == nil, "expected lsid present to be %v, got %v", tc.wantLsid, err == nil)
+		})
+	}
+
+	t.Run("explicit session is still used", func(t *testing.T) {
+		mc.started = nil
+		mc.md.ClearResponses()
+		mc.md.AddResponses(bson.D{{"ok", 1}, {"n", 1}})
+
+		sess, err := mc.StartSession()
+		require.NoError(t, err, "StartSession error")
+		defer sess.EndSession(context.Background())
+
+		ctx := NewSessionContext(WithoutImplicitSession(context.Background()), sess)
+		_, err = coll.InsertOne(ctx, bson.D{{"x", 1}})
+		require.NoError(t, err, "InsertOne error")
+		require.Len(t, mc.started, 1, "expected 1 started event, got %d", len(mc.started))
+
+		_, err = mc.started[0].Command.LookupErr("lsid")
+		assert.NoError(t, err, "expected lsid to be attached to the command")
+	})
+}
+
+func TestClient_StartSessionWithContext(t *testing.T) {
+	client := newMockClient(t, nil).Client
+
+	t.Run("cancelled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		sess, err := client.StartSessionWithContext(ctx)
+		assert.Nil(t, sess, "expected nil session, got %v", sess)
+		assert.Equal(t, context.Canceled, err, "expected error %v, got %v", context.Canceled, err)
+	})
+	t.Run("timed out context", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Nanosecond)
+		defer cancel()
+		<-ctx.Done()
+
+		sess, err := client.StartSessionWithContext(ctx)
+		assert.Nil(t, sess, "expected nil session, got %v", sess)
+		assert.True(t, errors.Is(err, context.DeadlineExceeded),
+			"expected error %v, got %v", context.DeadlineExceeded, err)
+	})
+	t.Run("valid context", func(t *testing.T) {
+		sess, err := client.StartSessionWithContext(context.Background(), options.Session().SetSnapshot(true))
+		require.NoError(t, err, "StartSessionWithContext error")
+		defer sess.EndSession(context.Background())
+
+		assert.True(t, sess.clientSession.Snapshot, "expected snapshot session")
+	})
+}
+
+func TestClient_DefaultTransactionOptions(t *testing.T) {
+	newClient := func(t *testing.T, txnOpts *options.TransactionOptionsBuilder) *Client {
+		t.Helper()
+
+		clientOpts := options.Client().
+			SetWriteConcern(writeconcern.W1()).
+			SetDefaultTransactionOptions(txnOpts)
+
+		client := newMockClient(t, clientOpts).Client
+		return client
+	}
+
+	testCases := []struct {
+		name     string
+		sessOpts *options.SessionOptionsBuilder
+		txnOpts  *options.TransactionOptionsBuilder
+		wantWc   *writeconcern.WriteConcern
+	}{
+		{
+			name:   "client default",
+			wantWc: writeconcern.Majority(),
+		},
+		{
+			name: "session default takes precedence",
+			sessOpts: options.Session().SetDefaultTransactionOptions(
+				options.Transaction().SetWriteConcern(writeconcern.Journaled())),
+			wantWc: writeconcern.Journaled(),
+		},
+		{
+			name:    "transaction option takes precedence",
+			txnOpts: options.Transaction().SetWriteConcern(&writeconcern.WriteConcern{W: 2}),
+			wantWc:  &writeconcern.WriteConcern{W: 2},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := newClient(t, options.Transaction().
+				SetReadConcern(readconcern.Snapshot()).
+				SetWriteConcern(writeconcern.Majority()))
+
+			var sessOpts []options.Lister[options.SessionOptions]
+			if tc.sessOpts != nil {
+				sessOpts = append(sessOpts, tc.sessOpts)
+			}
+			sess, err := client.StartSession(sessOpts...)
+			require.NoError(t, err, "StartSession error")
+			defer sess.EndSession(context.Background())
+
+			var txnOpts []options.Lister[options.TransactionOptions]
+			if tc.txnOpts != nil {
+				txnOpts = append(txnOpts, tc.txnOpts)
+			}
+			err = sess.StartTransaction(txnOpts...)
+			require.NoError(t, err, "StartTransaction error")
+
+			gotWc := sess.clientSession.CurrentWc
+			assert.Equal(t, tc.wantWc, gotWc, "expected write concern %v, got %v", tc.wantWc, gotWc)
+			gotRc := sess.clientSession.CurrentRc
+			assert.Equal(t, readconcern.Snapshot(), gotRc, "expected read concern %v, got %v",
+				readconcern.Snapshot(), gotRc)
+		})
+	}
+
+	t.Run("MaxCommitRetryTime bounds WithTransaction retries", func(t *testing.T) {
+		client := newClient(t, options.Transaction().SetMaxCommitRetryTime(100*time.Millisecond))
+
+		sess, err := client.StartSession()
+		require.NoError(t, err, "StartSession error")
+		defer sess.EndSession(context.Background())
+
+		transientErr := CommandError{Name: "test Error", Labels: []string{driver.TransientTransactionError}}
+		start := time.Now()
+		_, err = sess.WithTransaction(context.Background(), func(context.Context) (interface{}, error) {
+			time.Sleep(10 * time.Millisecond)
+			return nil, transientErr
+		})
+		elapsed := time.Since(start)
+
+		assert.Error(t, err, "expected WithTransaction error")
+		assert.Less(t, elapsed, 2*time.Second, "expected WithTransaction to stop retrying after 100ms, took %v", elapsed)
+	})
+}
+
+func TestSession_CommitWithRetry(t *testing.T) {
+	var commits int
+	var commitDelay time.Duration
+	clientOpts := options.Client().SetMonitor(&event.CommandMonitor{
+		Started: func(_ context.Context, evt *event.CommandStartedEvent) {
+			if evt.CommandName == "commitTransaction" {
+				commits++
+				time.Sleep(commitDelay)
+			}
+		},
+	})
+
+	mc := newMockClient(t, clientOpts)
+
+	coll := mc.Database(testDbName).Collection("coll")
+
+	errorResponse := func(label string) bson.D {
+		return bson.D{
+			{"ok", 0},
+			{"code", 1},
+			{"errmsg", "test error"},
+			{"errorLabels", bson.A{label}},
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		responses   []bson.D
+		wantCommits int
+		wantLabel   string
+	}{
+		{
+			name:        "success",
+			responses:   []bson.D{{{"ok", 1}}},
+			wantCommits: 1,
+		},
+		{
+			name: "retries UnknownTransactionCommitResult",
+			responses: []bson.D{
+				errorResponse(driver.UnknownTransactionCommitResult),
+				errorResponse(driver.UnknownTransactionCommitResult),
+				{{"ok", 1}},
+			},
+			wantCommits: 3,
+		},
+		{
+			name:        "returns TransientTransactionError",
+			responses:   []bson.D{errorResponse(driver.TransientTransactionError)},
+			wantCommits: 1,
+			wantLabel:   driver.TransientTransactionError,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			commits = 0
+			mc.md.ClearResponses()
+			mc.md.AddResponses(bson.D{{"ok", 1}, {"n", 1}})
+			mc.md.AddResponses(tc.responses...)
+
+			sess, err := mc.StartSession()
+			require.NoError(t, err, "StartSession error")
+			defer sess.EndSession(context.Background())
+
+			err = sess.StartTransaction()
+			require.NoError(t, err, "StartTransaction error")
+			_, err = coll.InsertOne(NewSessionContext(context.Background(), sess), bson.D{{"x", 1}})
+			require.NoError(t, err, "InsertOne error")
+
+			err = sess.CommitWithRetry(context.Background())
+			if tc.wantLabel == "" {
+				assert.NoError(t, err, "CommitWithRetry error")
+			} else {
+				var cerr CommandError
+				require.True(t, errors.As(err, &cerr), "expected error type %T, got %T", cerr, err)
+				assert.True(t, cerr.HasErrorLabel(tc.wantLabel), "expected error with label %v, got %v",
+					tc.wantLabel, cerr)
+			}
+			assert.Equal(t, tc.wantCommits, commits, "expected %d commit attempts, got %d", tc.wantCommits, commits)
+		})
+	}
+
+	t.Run("WithTransaction re-runs callback on TransientTransactionError", func(t *testing.T) {
+		commits = 0
+		mc.md.ClearResponses()
+		mc.md.AddResponses(
+			bson.D{{"ok", 1}, {"n", 1}},
+			errorResponse(driver.TransientTransactionError),
+			bson.D{{"ok", 1}, {"n", 1}},
+			bson.D{{"ok", 1}},
+		)
+
+		sess, err := mc.StartSession()
+		require.NoError(t, err, "StartSession error")
+		defer sess.EndSession(context.Background())
+
+		var runs int
+		_, err = sess.WithTransaction(context.Background(), func(ctx context.Context) (interface{}, error) {
+			runs++
+			return coll.InsertOne(ctx, bson.D{{"x", 1}})
+		})
+		require.NoError(t, err, "WithTransaction error")
+		assert.Equal(t, 2, runs, "expected callback to run 2 times, ran %d times", runs)
+		assert.Equal(t, 2, commits, "expected 2 commit attempts, got %d", commits)
+	})
+	t.Run("stops retrying when ctx is done", func(t *testing.T) {
+		commits = 0
+		mc.md.ClearResponses()
+		mc.md.AddResponses(bson.D{{"ok", 1}, {"n", 1}})
+		mc.md.AddResponses(errorResponse(driver.UnknownTransactionCommitResult))
+
+		sess, err := mc.StartSession()
+		require.NoError(t, err, "StartSession error")
+		defer sess.EndSession(context.Background())
+
+		err = sess.StartTransaction()
+		require.NoError(t, err, "StartTransaction error")
+		_, err = coll.InsertOne(NewSessionContext(context.Background(), sess), bson.D{{"x", 1}})
+		require.NoError(t, err, "InsertOne error")
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err = sess.CommitWithRetry(ctx)
+		var cerr CommandError
+		require.True(t, errors.As(err, &cerr), "expected error type %T, got %T", cerr, err)
+		assert.Equal(t, 1, commits, "expected 1 commit attempt, got %d", commits)
+	})
+	t.Run("StartTransaction MaxCommitRetryTime bounds retries", func(t *testing.T) {
+		commits = 0
+		commitDelay = 20 * time.Millisecond
+		defer func() { commitDelay = 0 }()
+
+		mc.md.ClearResponses()
+		mc.md.AddResponses(bson.D{{"ok", 1}, {"n", 1}})
+		mc.md.AddResponses(
+			errorResponse(driver.UnknownTransactionCommitResult),
+			errorResponse(driver.UnknownTransactionCommitResult),
+			bson.D{{"ok", 1}},
+		)
+
+		sess, err := mc.StartSession()
+		require.NoError(t, err, "StartSession error")
+		defer sess.EndSession(context.Background())
+
+		err = sess.StartTransaction(options.Transaction().SetMaxCommitRetryTime(time.Millisecond))
+		require.NoError(t, err, "StartTransaction error")
+		_, err = coll.InsertOne(NewSessionContext(context.Background(), sess), bson.D{{"x", 1}})
+		require.NoError(t, err, "InsertOne error")
+
+		err = sess.CommitWithRetry(context.Background())
+		var cerr CommandError
+		require.True(t, errors.As(err, &cerr), "expected error type %T, got %T", cerr, err)
+		assert.True(t, cerr.HasErrorLabel(driver.UnknownTransactionCommitResult),
+			"expected error with label %v, got %v", driver.UnknownTransactionCommitResult, cerr)
+		assert.Equal(t, 1, commits, "expected 1 commit attempt, got %d", commits)
+	})
+}
+
+func TestCausalTimes(t *testing.T) {
+	clusterTime := bson.Raw(bsoncore.NewDocumentBuilder().
+		AppendDocument("$clusterTime", bsoncore.NewDocumentBuilder().
+			AppendTimestamp("clusterTime", 10, 2).
+			AppendDocument("signature", bsoncore.NewDocumentBuilder().
+				AppendBinary("hash", 0, make([]byte, 20)).
+				AppendInt64("keyId", 0).
+				Build()).
+			Build()).
+		Build())
+	opTime := &bson.Timestamp{T: 10, I: 1}
+
+	t.Run("round trip", func(t *testing.T) {
+		want := CausalTimes{ClusterTime: clusterTime, OperationTime: opTime}
+
+		text, err := want.MarshalText()
+		require.NoError(t, err, "MarshalText error")
+		assert.NotContains(t, string(text), "=", "expected unpadded base64 text")
+
+		var got CausalTimes
+		err = got.UnmarshalText(text)
+		require.NoError(t, err, "UnmarshalText error")
+		assert.Equal(t, want, got, "expected causal times %v, got %v", want, got)
+	})
+	t.Run("round trip empty", func(t *testing.T) {
+		text, err := CausalTimes{}.MarshalText()
+		require.NoError(t, err, "MarshalText error")
+
+		var got CausalTimes
+		err = got.UnmarshalText(text)
+		require.NoError(t, err, "UnmarshalText error")
+		assert.Equal(t, CausalTimes{}, got, "expected empty causal times, got %v", got)
+	})
+	t.Run("invalid", func(t *testing.T) {
+		encode := func(doc bsoncore.Document) []byte {
+			return []byte(base64.RawURLEncoding.EncodeToString(doc))
+		}
+
+		testCases := []struct {
+			name string
+			text []byte
+			err  string
+		}{
+			{"not base64", []byte("not base64!"), "error decoding causal times"},
+			{"not BSON", encode([]byte{0x01, 0x02}), "error decoding causal times"},
+			{
+				"operationTime not a timestamp",
+				encode(bsoncore.NewDocumentBuilder().AppendInt32("operationTime", 1).Build()),
+				"operationTime must be a timestamp",
+			},
+			{
+				"clusterTime not a document",
+				encode(bsoncore.NewDocumentBuilder().AppendString("clusterTime", "foo").Build()),
+				"clusterTime must be a document",
+			},
+			{
+				"clusterTime missing $clusterTime",
+				encode(bsoncore.NewDocumentBuilder().
+					AppendDocument("clusterTime", bsoncore.NewDocumentBuilder().AppendInt32("x", 1).Build()).
+					Build()),
+				"cluster time has no $clusterTime.clusterTime field",
+			},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				var ct CausalTimes
+				err := ct.UnmarshalText(tc.text)
+				assert.ErrorContains(t, err, tc.err)
+				assert.Equal(t, CausalTimes{}, ct, "expected causal times to be unchanged, got %v", ct)
+			})
+		}
+	})
+	t.Run("advance between clients", func(t *testing.T) {
+		sess1 := newMockSession(t)
+		sess2 := newMockSession(t)
+		require.NotEqual(t, sess1.Client(), sess2.Client(), "expected sessions from different clients")
+
+		err := sess1.AdvanceClusterTime(clusterTime)
+		require.NoError(t, err, "AdvanceClusterTime error")
+		err = sess1.AdvanceOperationTime(opTime)
+		require.NoError(t, err, "AdvanceOperationTime error")
+
+		text, err := sess1.CausalTimes().MarshalText()
+		require.NoError(t, err, "MarshalText error")
+
+		var times CausalTimes
+		err = times.UnmarshalText(text)
+		require.NoError(t, err, "UnmarshalText error")
+
+		err = sess2.AdvanceCausalTimes(times)
+		require.NoError(t, err, "AdvanceCausalTimes error")
+		assert.Equal(t, clusterTime, sess2.ClusterTime(), "expected cluster time %v, got %v", clusterTime, sess2.ClusterTime())
+		assert.Equal(t, opTime, sess2.OperationTime(), "expected operation time %v, got %v", opTime, sess2.OperationTime())
+
+		// Older times do not move the session backwards.
+		err = sess2.AdvanceCausalTimes(CausalTimes{OperationTime: &bson.Timestamp{T: 1, I: 1}})
+		require.NoError(t, err, "AdvanceCausalTimes error")
+		assert.Equal(t, opTime, sess2.OperationTime(), "expected operation time %v, got %v", opTime, sess2.OperationTime())
+	})
+	t.Run("advance invalid", func(t *testing.T) {
+		sess := newMockSession(t)
+
+		err := sess.AdvanceCausalTimes(CausalTimes{ClusterTime: bson.Raw(bsoncore.NewDocumentBuilder().Build())})
+		assert.ErrorContains(t, err, "cluster time has no $clusterTime.clusterTime field")
+		assert.Nil(t, sess.ClusterTime(), "expected cluster time to be unchanged, got %v", sess.ClusterTime())
+	})
+}
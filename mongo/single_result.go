@@ -7,17 +7,24 @@
 package mongo
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"strings"
 
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
 )
 
 // ErrNoDocuments is returned by SingleResult methods when the operation that created the SingleResult did not return
 // any documents.
 var ErrNoDocuments = errors.New("mongo: no documents in result")
 
+// ErrMultipleDocuments is returned by SingleResult methods when the AssertSingleMatch option is set and the operation
+// that created the SingleResult matched more than one document.
+var ErrMultipleDocuments = errors.New("mongo: multiple documents in result")
+
 // SingleResult represents a single document returned from an operation. If the operation resulted in an error, all
 // SingleResult methods will return that error. If the operation did not return any documents, all SingleResult methods
 // will return ErrNoDocuments.
@@ -29,6 +36,10 @@ type SingleResult struct {
 	bsonOpts *options.BSONOptions
 	reg      *bson.Registry
 
+	// rawResponse is the server response for operations that do not use a
+	// cursor. It is only set if the Client retains raw responses.
+	rawResponse bson.Raw
+
 	// Operation performed with an acknowledged write. Values returned by
 	// SingleResult methods may not be deterministic if the write operation was
 	// unacknowledged and so should not be relied upon.
@@ -87,6 +98,45 @@ func (sr *SingleResult) Decode(v interface{}) error {
 	return dec.Decode(v)
 }
 
+// DecodeField will unmarshal the value at the provided dotted path (e.g. "a.b.c") in the document represented by this
+// SingleResult into out using the SingleResult's registry and BSON options. If there was an error from the operation that created this
+// SingleResult, that error will be returned. If the operation returned no documents, DecodeField will return
+// ErrNoDocuments. If the path does not exist in the document, the lookup error will be returned.
+//
+// To access the raw field value without decoding it, pass a *bson.RawValue as out.
+func (sr *SingleResult) DecodeField(path string, out interface{}) error {
+	if sr.err != nil {
+		return sr.err
+	}
+	if sr.reg == nil {
+		return bson.ErrNilRegistry
+	}
+
+	if sr.err = sr.setRdrContents(); sr.err != nil {
+		return sr.err
+	}
+
+	val, err := sr.rdr.LookupErr(strings.Split(path, ".")...)
+	if err != nil {
+		return err
+	}
+
+	// Wrap the value in a single-element document so it can be read with the same decoder configuration used by
+	// Decode.
+	doc := bsoncore.BuildDocumentFromElements(nil,
+		bsoncore.AppendValueElement(nil, "v", bsoncore.Value{Type: bsoncore.Type(val.Type), Data: val.Value}))
+	dr, err := bson.NewDocumentReader(bytes.NewReader(doc)).ReadDocument()
+	if err != nil {
+		return err
+	}
+	_, vr, err := dr.ReadElement()
+	if err != nil {
+		return err
+	}
+
+	return newDecoder(vr, sr.bsonOpts, sr.reg).Decode(out)
+}
+
 // Raw returns the document represented by this SingleResult as a bson.Raw. If
 // there was an error from the operation that created this SingleResult, both
 // the result and that error will be returned. If the operation returned no
@@ -103,6 +153,65 @@ func (sr *SingleResult) Raw() (bson.Raw, error) {
 	return sr.rdr, nil
 }
 
+// Bytes returns a copy of the bytes of the document represented by this SingleResult. If there was an error from the
+// operation that created this SingleResult, that error will be returned. If the operation returned no documents, Bytes
+// will return ErrNoDocuments.
+//
+// The returned slice is owned by the caller and can be retained or modified without affecting the SingleResult.
+func (sr *SingleResult) Bytes() ([]byte, error) {
+	if sr.err != nil {
+		return nil, sr.err
+	}
+
+	if sr.err = sr.setRdrContents(); sr.err != nil {
+		return nil, sr.err
+	}
+
+	b := make([]byte, len(sr.rdr))
+	copy(b, sr.rdr)
+	return b, nil
+}
+
+// DecodeWithRegistry behaves like Decode but uses the provided registry instead of the one configured for the
+// SingleResult.
+func (sr *SingleResult) DecodeWithRegistry(reg *bson.Registry, v interface{}) error {
+	if sr.err != nil {
+		return sr.err
+	}
+	if reg == nil {
+		return bson.ErrNilRegistry
+	}
+
+	if sr.err = sr.setRdrContents(); sr.err != nil {
+		return sr.err
+	}
+
+	dec := getDecoder(sr.rdr, sr.bsonOpts, reg)
+
+	return dec.Decode(v)
+}
+
+// Type returns the BSON type of the top-level field key in the document represented by this SingleResult without
+// decoding the document. If there was an error from the operation that created this SingleResult, that error will be
+// returned. If the operation returned no documents, Type will return ErrNoDocuments. If the field does not exist in the
+// document, the lookup error will be returned.
+func (sr *SingleResult) Type(key string) (bson.Type, error) {
+	if sr.err != nil {
+		return 0, sr.err
+	}
+
+	if sr.err = sr.setRdrContents(); sr.err != nil {
+		return 0, sr.err
+	}
+
+	val, err := sr.rdr.LookupErr(key)
+	if err != nil {
+		return 0, err
+	}
+
+	return val.Type, nil
+}
+
 // setRdrContents will set the contents of rdr by iterating the underlying cursor if necessary.
 func (sr *SingleResult) setRdrContents() error {
 	switch {
@@ -129,6 +238,30 @@ func (sr *SingleResult) setRdrContents() error {
 	return ErrNoDocuments
 }
 
+// setSingleMatchRdrContents is like setRdrContents but also returns ErrMultipleDocuments if the underlying cursor
+// contains more than one document.
+func (sr *SingleResult) setSingleMatchRdrContents() error {
+	if sr.err != nil || sr.rdr != nil || sr.cur == nil {
+		return sr.setRdrContents()
+	}
+	defer sr.cur.Close(sr.ctx)
+
+	if !sr.cur.Next(sr.ctx) {
+		if err := sr.cur.Err(); err != nil {
+			return err
+		}
+
+		return ErrNoDocuments
+	}
+
+	sr.rdr = sr.cur.Current
+	if sr.cur.Next(sr.ctx) {
+		return ErrMultipleDocuments
+	}
+
+	return sr.cur.Err()
+}
+
 // Err provides a way to check for query errors without calling Decode. Err returns the error, if
 // any, that was encountered while running the operation. If the operation was successful but did
 // not return any documents, Err returns ErrNoDocuments. If this error is not nil, this error will
@@ -138,3 +271,21 @@ func (sr *SingleResult) Err() error {
 
 	return sr.err
 }
+
+// DiagnosticRaw returns the raw server response for the command that created this SingleResult, which
+// can be used to diagnose decoding errors or responses with an unexpected shape. If the command failed
+// with a CommandError, the response containing the error is returned. Otherwise, DiagnosticRaw returns
+// nil unless the Client was configured with options.ClientOptions.SetRetainRawResponses(true).
+func (sr *SingleResult) DiagnosticRaw() bson.Raw {
+	var ce CommandError
+	if errors.As(sr.err, &ce) {
+		return ce.Raw
+	}
+	if sr.rawResponse != nil {
+		return sr.rawResponse
+	}
+	if sr.cur != nil {
+		return sr.cur.LastResponse()
+	}
+	return nil
+}
@@ -10,10 +10,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"testing"
 
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/drivertest"
 )
 
 func TestNewSingleResultFromDocument(t *testing.T) {
@@ -129,9 +134,223 @@ func TestSingleResult_Decode(t *testing.T) {
 	})
 }
 
+func TestSingleResult_DecodeField(t *testing.T) {
+	doc := bson.D{{"_id", 1}, {"foo", bson.D{{"bar", "baz"}}}}
+
+	t.Run("top-level field", func(t *testing.T) {
+		sr := NewSingleResultFromDocument(doc, nil, nil)
+
+		var id int32
+		err := sr.DecodeField("_id", &id)
+		assert.Nil(t, err, "DecodeField error: %v", err)
+		assert.Equal(t, int32(1), id, "expected value %v, got %v", int32(1), id)
+	})
+	t.Run("nested field", func(t *testing.T) {
+		sr := NewSingleResultFromDocument(doc, nil, nil)
+
+		var bar string
+		err := sr.DecodeField("foo.bar", &bar)
+		assert.Nil(t, err, "DecodeField error: %v", err)
+		assert.Equal(t, "baz", bar, "expected value %q, got %q", "baz", bar)
+
+		var rv bson.RawValue
+		err = sr.DecodeField("foo.bar", &rv)
+		assert.Nil(t, err, "DecodeField error: %v", err)
+		assert.Equal(t, bson.TypeString, rv.Type, "expected type %v, got %v", bson.TypeString, rv.Type)
+		assert.Equal(t, "baz", rv.StringValue(), "expected value %q, got %q", "baz", rv.StringValue())
+	})
+	t.Run("uses BSON options", func(t *testing.T) {
+		sr := NewSingleResultFromDocument(doc, nil, nil)
+		sr.bsonOpts = &options.BSONOptions{DefaultDocumentM: true}
+
+		var foo interface{}
+		err := sr.DecodeField("foo", &foo)
+		assert.Nil(t, err, "DecodeField error: %v", err)
+		assert.Equal(t, bson.M{"bar": "baz"}, foo, "expected value %v, got %v", bson.M{"bar": "baz"}, foo)
+	})
+	t.Run("missing field", func(t *testing.T) {
+		sr := NewSingleResultFromDocument(doc, nil, nil)
+
+		var val string
+		err := sr.DecodeField("foo.qux", &val)
+		assert.True(t, errors.Is(err, bsoncore.ErrElementNotFound),
+			"expected error %v, got %v", bsoncore.ErrElementNotFound, err)
+	})
+	t.Run("no documents", func(t *testing.T) {
+		sr := &SingleResult{reg: defaultRegistry}
+
+		var val string
+		err := sr.DecodeField("foo", &val)
+		assert.Equal(t, ErrNoDocuments, err, "expected error %v, got %v", ErrNoDocuments, err)
+	})
+}
+
+func TestSingleResult_Bytes(t *testing.T) {
+	doc := bson.D{{"_id", int32(1)}, {"foo", "bar"}}
+	expected, err := bson.Marshal(doc)
+	assert.Nil(t, err, "Marshal error: %v", err)
+
+	t.Run("returns document bytes", func(t *testing.T) {
+		sr := NewSingleResultFromDocument(doc, nil, nil)
+
+		b, err := sr.Bytes()
+		assert.Nil(t, err, "Bytes error: %v", err)
+		assert.Equal(t, expected, b, "expected bytes %v, got %v", expected, b)
+	})
+	t.Run("modifying does not affect subsequent decodes", func(t *testing.T) {
+		sr := NewSingleResultFromDocument(doc, nil, nil)
+
+		b, err := sr.Bytes()
+		assert.Nil(t, err, "Bytes error: %v", err)
+		b[len(b)-3] = 'z' // "bar" -> "baz"
+		_ = append(b, 0x01, 0x02, 0x03)
+
+		var got bson.D
+		err = sr.Decode(&got)
+		assert.Nil(t, err, "Decode error: %v", err)
+		assert.Equal(t, doc, got, "expected document %v, got %v", doc, got)
+
+		raw, err := sr.Raw()
+		assert.Nil(t, err, "Raw error: %v", err)
+		assert.Equal(t, bson.Raw(expected), raw, "expected raw %v, got %v", bson.Raw(expected), raw)
+	})
+	t.Run("no documents", func(t *testing.T) {
+		sr := &SingleResult{reg: defaultRegistry}
+
+		b, err := sr.Bytes()
+		assert.Equal(t, ErrNoDocuments, err, "expected error %v, got %v", ErrNoDocuments, err)
+		assert.Nil(t, b, "expected nil bytes, got %v", b)
+	})
+}
+
+func TestSingleResult_DecodeWithRegistry(t *testing.T) {
+	type myInt int64
+
+	reg := bson.NewRegistry()
+	reg.RegisterTypeDecoder(reflect.TypeOf(myInt(0)), bson.ValueDecoderFunc(
+		func(_ bson.DecodeContext, vr bson.ValueReader, val reflect.Value) error {
+			i32, err := vr.ReadInt32()
+			if err != nil {
+				return err
+			}
+			val.SetInt(int64(i32) * 10)
+			return nil
+		}))
+
+	sr := NewSingleResultFromDocument(bson.D{{"x", int32(4)}}, nil, nil)
+
+	var got struct {
+		X myInt `bson:"x"`
+	}
+	err := sr.DecodeWithRegistry(reg, &got)
+	assert.Nil(t, err, "DecodeWithRegistry error: %v", err)
+	assert.Equal(t, myInt(40), got.X, "expected value %v, got %v", myInt(40), got.X)
+
+	err = sr.Decode(&got)
+	assert.Nil(t, err, "Decode error: %v", err)
+	assert.Equal(t, myInt(4), got.X, "expected value %v, got %v", myInt(4), got.X)
+
+	err = sr.DecodeWithRegistry(nil, &got)
+	assert.Equal(t, bson.ErrNilRegistry, err, "expected error %v, got %v", bson.ErrNilRegistry, err)
+}
+
+func TestSingleResult_Type(t *testing.T) {
+	sr := NewSingleResultFromDocument(bson.D{{"x", int32(4)}, {"y", "foo"}}, nil, nil)
+
+	typ, err := sr.Type("x")
+	assert.Nil(t, err, "Type error: %v", err)
+	assert.Equal(t, bson.TypeInt32, typ, "expected type %v, got %v", bson.TypeInt32, typ)
+
+	typ, err = sr.Type("y")
+	assert.Nil(t, err, "Type error: %v", err)
+	assert.Equal(t, bson.TypeString, typ, "expected type %v, got %v", bson.TypeString, typ)
+
+	_, err = sr.Type("z")
+	assert.True(t, errors.Is(err, bsoncore.ErrElementNotFound),
+		"expected error %v, got %v", bsoncore.ErrElementNotFound, err)
+}
+
 func TestSingleResult_Err(t *testing.T) {
 	t.Run("bson.Raw", func(t *testing.T) {
 		sr := &SingleResult{}
 		assert.Equal(t, ErrNoDocuments, sr.Err(), "expected error %v, got %v", ErrNoDocuments, sr.Err())
 	})
 }
+
+func TestSingleResult_DiagnosticRaw(t *testing.T) {
+	ns := testDbName + ".coll"
+	findReply := bson.D{{"ok", 1}, {"cursor", bson.D{
+		{"id", int64(0)},
+		{"ns", ns},
+		{"firstBatch", bson.A{bson.D{{"x", "not a number"}}}},
+	}}}
+	findAndModifyReply := bson.D{{"ok", 1}, {"value", bson.D{{"x", "not a number"}}}}
+
+	marshal := func(t *testing.T, doc bson.D) bson.Raw {
+		t.Helper()
+
+		raw, err := bson.Marshal(doc)
+		require.NoError(t, err, "Marshal error")
+		return raw
+	}
+
+	var mismatched struct {
+		X int32 `bson:"x"`
+	}
+
+	newColl := func(t *testing.T, retain bool) (*Collection, *drivertest.MockDeployment) {
+		t.Helper()
+
+		mc := newMockClient(t, options.Client().SetRetainRawResponses(retain))
+		return mc.Database(testDbName).Collection("coll"), mc.md
+	}
+
+	t.Run("FindOne", func(t *testing.T) {
+		coll, md := newColl(t, true)
+		md.AddResponses(findReply)
+
+		sr := coll.FindOne(context.Background(), bson.D{})
+		err := sr.Decode(&mismatched)
+		require.Error(t, err, "expected Decode error")
+
+		want := marshal(t, findReply)
+		got := sr.DiagnosticRaw()
+		assert.Equal(t, want, got, "expected raw response %v, got %v", want, got)
+	})
+	t.Run("FindOneAndUpdate", func(t *testing.T) {
+		coll, md := newColl(t, true)
+		md.AddResponses(findAndModifyReply)
+
+		sr := coll.FindOneAndUpdate(context.Background(), bson.D{}, bson.D{{"$set", bson.D{{"y", 1}}}})
+		err := sr.Decode(&mismatched)
+		require.Error(t, err, "expected Decode error")
+
+		want := marshal(t, findAndModifyReply)
+		got := sr.DiagnosticRaw()
+		assert.Equal(t, want, got, "expected raw response %v, got %v", want, got)
+	})
+	t.Run("command error", func(t *testing.T) {
+		coll, md := newColl(t, false)
+		errReply := bson.D{{"ok", 0}, {"code", 2}, {"errmsg", "bad filter"}}
+		md.AddResponses(errReply)
+
+		sr := coll.FindOne(context.Background(), bson.D{})
+		require.Error(t, sr.Err(), "expected FindOne error")
+
+		want := marshal(t, errReply)
+		got := sr.DiagnosticRaw()
+		assert.Equal(t, want, got, "expected raw response %v, got %v", want, got)
+	})
+	t.Run("not retained by default", func(t *testing.T) {
+		coll, md := newColl(t, false)
+		md.AddResponses(findReply, findAndModifyReply)
+
+		sr := coll.FindOne(context.Background(), bson.D{})
+		require.Error(t, sr.Decode(&mismatched), "expected Decode error")
+		assert.Nil(t, sr.DiagnosticRaw(), "expected nil raw response, got %v", sr.DiagnosticRaw())
+
+		sr = coll.FindOneAndUpdate(context.Background(), bson.D{}, bson.D{{"$set", bson.D{{"y", 1}}}})
+		require.Error(t, sr.Decode(&mismatched), "expected Decode error")
+		assert.Nil(t, sr.DiagnosticRaw(), "expected nil raw response, got %v", sr.DiagnosticRaw())
+	})
+}
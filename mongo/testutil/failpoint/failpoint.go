@@ -0,0 +1,177 @@
+// Copyright (C) MongoDB, Inc. 2024-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+// Package failpoint provides helpers for configuring server fail points in
+// tests. Fail points require the server to be started with
+// enableTestCommands=1.
+//
+// For more information about fail points, see
+// https://github.com/mongodb/specifications/tree/HEAD/source/transactions/tests#server-fail-point
+package failpoint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const (
+	// ModeAlwaysOn is the fail point mode that enables the fail point for an
+	// indefinite number of matching commands.
+	ModeAlwaysOn = "alwaysOn"
+
+	// ModeOff is the fail point mode that disables the fail point.
+	ModeOff = "off"
+)
+
+// FailPoint is used to configure a server fail point. It is intended to be
+// passed as the command argument to RunCommand.
+//
+// For more information about fail points, see
+// https://github.com/mongodb/specifications/tree/HEAD/source/transactions/tests#server-fail-point
+type FailPoint struct {
+	ConfigureFailPoint string `bson:"configureFailPoint"`
+	// Mode should be a string, Mode, or map[string]interface{}
+	Mode interface{} `bson:"mode"`
+	Data Data        `bson:"data"`
+}
+
+// Mode configures when a fail point will be enabled. It is used to set the
+// FailPoint.Mode field.
+type Mode struct {
+	Times int32 `bson:"times"`
+	Skip  int32 `bson:"skip"`
+}
+
+// Data configures how a fail point will behave. It is used to set the
+// FailPoint.Data field.
+type Data struct {
+	FailCommands                  []string           `bson:"failCommands,omitempty"`
+	CloseConnection               bool               `bson:"closeConnection,omitempty"`
+	ErrorCode                     int32              `bson:"errorCode,omitempty"`
+	FailBeforeCommitExceptionCode int32              `bson:"failBeforeCommitExceptionCode,omitempty"`
+	ErrorLabels                   *[]string          `bson:"errorLabels,omitempty"`
+	WriteConcernError             *WriteConcernError `bson:"writeConcernError,omitempty"`
+	BlockConnection               bool               `bson:"blockConnection,omitempty"`
+	BlockTimeMS                   int32              `bson:"blockTimeMS,omitempty"`
+	AppName                       string             `bson:"appName,omitempty"`
+}
+
+// WriteConcernError is the write concern error to return when the fail point is
+// triggered. It is used to set the FailPoint.Data.WriteConcernError field.
+type WriteConcernError struct {
+	Code        int32     `bson:"code"`
+	Name        string    `bson:"codeName"`
+	Errmsg      string    `bson:"errmsg"`
+	ErrorLabels *[]string `bson:"errorLabels,omitempty"`
+	ErrInfo     bson.Raw  `bson:"errInfo,omitempty"`
+}
+
+// Cleanup disables a fail point that was configured by Set, SetOnHost, or
+// SetOnHosts.
+type Cleanup func(ctx context.Context) error
+
+// Set configures fp on the deployment that client is connected to and returns
+// a Cleanup that disables it. In a sharded deployment the command is routed to
+// a single mongos; use SetOnHosts to configure every mongos individually.
+func Set(ctx context.Context, client *mongo.Client, fp FailPoint) (Cleanup, error) {
+	if fp.ConfigureFailPoint == "" {
+		return nil, errors.New("fail point name must be set")
+	}
+
+	if err := client.Database("admin").RunCommand(ctx, fp).Err(); err != nil {
+		return nil, fmt.Errorf("error setting fail point %q: %w", fp.ConfigureFailPoint, err)
+	}
+
+	cleanup := func(ctx context.Context) error {
+		return Disable(ctx, client, fp.ConfigureFailPoint)
+	}
+	return cleanup, nil
+}
+
+// Disable turns off the fail point with the given name on the deployment that
+// client is connected to.
+func Disable(ctx context.Context, client *mongo.Client, name string) error {
+	cmd := FailPoint{
+		ConfigureFailPoint: name,
+		Mode:               ModeOff,
+	}
+	if err := client.Database("admin").RunCommand(ctx, cmd).Err(); err != nil {
+		return fmt.Errorf("error disabling fail point %q: %w", name, err)
+	}
+	return nil
+}
+
+// SetOnHost configures fp on the server at host only. A dedicated client is
+// created from clientOpts with its host list replaced by host and a direct
+// connection, which makes it possible to target a specific mongos in a sharded
+// deployment. The returned Cleanup disables the fail point and disconnects the
+// dedicated client.
+func SetOnHost(
+	ctx context.Context,
+	clientOpts *options.ClientOptions,
+	host string,
+	fp FailPoint,
+) (Cleanup, error) {
+	opts := options.MergeClientOptions(clientOpts, options.Client().SetHosts([]string{host}).SetDirect(true))
+
+	client, err := mongo.Connect(opts)
+	if err != nil {
+		return nil, fmt.Errorf("error creating client for host %q: %w", host, err)
+	}
+
+	disable, err := Set(ctx, client, fp)
+	if err != nil {
+		_ = client.Disconnect(ctx)
+		return nil, err
+	}
+
+	cleanup := func(ctx context.Context) error {
+		err := disable(ctx)
+		if dcErr := client.Disconnect(ctx); err == nil {
+			err = dcErr
+		}
+		return err
+	}
+	return cleanup, nil
+}
+
+// SetOnHosts calls SetOnHost for each of the given hosts, such as every mongos
+// in a sharded deployment. If configuring any host fails, the fail points
+// already configured are disabled before the error is returned. The returned
+// Cleanup disables the fail point on every host and returns the first error
+// encountered.
+func SetOnHosts(
+	ctx context.Context,
+	clientOpts *options.ClientOptions,
+	hosts []string,
+	fp FailPoint,
+) (Cleanup, error) {
+	cleanups := make([]Cleanup, 0, len(hosts))
+	cleanup := func(ctx context.Context) error {
+		var first error
+		for _, c := range cleanups {
+			if err := c(ctx); err != nil && first == nil {
+				first = err
+			}
+		}
+		return first
+	}
+
+	for _, host := range hosts {
+		c, err := SetOnHost(ctx, clientOpts, host, fp)
+		if err != nil {
+			_ = cleanup(ctx)
+			return nil, err
+		}
+		cleanups = append(cleanups, c)
+	}
+	return cleanup, nil
+}
@@ -0,0 +1,135 @@
+// Copyright (C) MongoDB, Inc. 2026-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package failpoint
+
+import (
+	"context"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/event"
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/drivertest"
+)
+
+func newMockClientOptions(md *drivertest.MockDeployment, started *[]*event.CommandStartedEvent) *options.ClientOptions {
+	clientOpts := options.Client().SetMonitor(&event.CommandMonitor{
+		Started: func(_ context.Context, evt *event.CommandStartedEvent) {
+			if evt.CommandName == "configureFailPoint" {
+				*started = append(*started, evt)
+			}
+		},
+	})
+	clientOpts.Deployment = md
+	return clientOpts
+}
+
+func assertFailPointCommand(t *testing.T, evt *event.CommandStartedEvent, name, mode string) {
+	t.Helper()
+
+	assert.Equal(t, "admin", evt.DatabaseName, "expected database %q, got %q", "admin", evt.DatabaseName)
+
+	gotName := evt.Command.Lookup("configureFailPoint").StringValue()
+	assert.Equal(t, name, gotName, "expected fail point %q, got %q", name, gotName)
+
+	gotMode, ok := evt.Command.Lookup("mode").StringValueOK()
+	assert.True(t, ok, "expected mode to be a string")
+	assert.Equal(t, mode, gotMode, "expected mode %q, got %q", mode, gotMode)
+}
+
+func TestSet(t *testing.T) {
+	md := drivertest.NewMockDeployment()
+
+	var started []*event.CommandStartedEvent
+	client, err := mongo.Connect(newMockClientOptions(md, &started))
+	require.NoError(t, err, "Connect error")
+
+	fp := FailPoint{
+		ConfigureFailPoint: "failCommand",
+		Mode:               ModeAlwaysOn,
+		Data: Data{
+			FailCommands: []string{"insert"},
+			ErrorCode:    91,
+			BlockTimeMS:  100,
+			AppName:      "failpointTest",
+		},
+	}
+
+	t.Run("set and clear", func(t *testing.T) {
+		started = nil
+		md.ClearResponses()
+		md.AddResponses(bson.D{{"ok", 1}}, bson.D{{"ok", 1}})
+
+		cleanup, err := Set(context.Background(), client, fp)
+		require.NoError(t, err, "Set error")
+		require.Len(t, started, 1, "expected 1 started event, got %d", len(started))
+		assertFailPointCommand(t, started[0], "failCommand", ModeAlwaysOn)
+
+		data := started[0].Command.Lookup("data").Document()
+		code := data.Lookup("errorCode").Int32()
+		assert.Equal(t, int32(91), code, "expected errorCode %v, got %v", 91, code)
+		appName := data.Lookup("appName").StringValue()
+		assert.Equal(t, "failpointTest", appName, "expected appName %q, got %q", "failpointTest", appName)
+		_, err = data.LookupErr("closeConnection")
+		assert.Error(t, err, "expected unset closeConnection to be omitted")
+
+		err = cleanup(context.Background())
+		require.NoError(t, err, "cleanup error")
+		require.Len(t, started, 2, "expected 2 started events, got %d", len(started))
+		assertFailPointCommand(t, started[1], "failCommand", ModeOff)
+	})
+	t.Run("server error", func(t *testing.T) {
+		started = nil
+		md.ClearResponses()
+		md.AddResponses(bson.D{{"ok", 0}, {"code", 59}, {"errmsg", "no such command"}})
+
+		cleanup, err := Set(context.Background(), client, fp)
+		assert.Error(t, err, "expected Set error")
+		assert.Nil(t, cleanup, "expected nil cleanup on error")
+	})
+	t.Run("missing name", func(t *testing.T) {
+		started = nil
+
+		_, err := Set(context.Background(), client, FailPoint{Mode: ModeAlwaysOn})
+		assert.Error(t, err, "expected Set error")
+		assert.Len(t, started, 0, "expected no commands to be sent, got %d", len(started))
+	})
+}
+
+func TestSetOnHosts(t *testing.T) {
+	md := drivertest.NewMockDeployment()
+
+	var started []*event.CommandStartedEvent
+	clientOpts := newMockClientOptions(md, &started)
+
+	hosts := []string{"mongos1:27017", "mongos2:27017"}
+	md.AddResponses(bson.D{{"ok", 1}}, bson.D{{"ok", 1}})
+
+	fp := FailPoint{
+		ConfigureFailPoint: "failCommand",
+		Mode:               Mode{Times: 1},
+		Data: Data{
+			FailCommands:    []string{"find"},
+			CloseConnection: true,
+		},
+	}
+	// The cleanup is not run because the mock deployment does not support
+	// Disconnect.
+	_, err := SetOnHosts(context.Background(), clientOpts, hosts, fp)
+	require.NoError(t, err, "SetOnHosts error")
+	require.Len(t, started, len(hosts), "expected %d started events, got %d", len(hosts), len(started))
+
+	for _, evt := range started {
+		name := evt.Command.Lookup("configureFailPoint").StringValue()
+		assert.Equal(t, "failCommand", name, "expected fail point %q, got %q", "failCommand", name)
+		times := evt.Command.Lookup("mode", "times").Int32()
+		assert.Equal(t, int32(1), times, "expected times %v, got %v", 1, times)
+	}
+}
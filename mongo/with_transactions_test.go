@@ -188,6 +188,50 @@ func TestConvenientTransactions(t *testing.T) {
 				"expected error with label %v, got %v", driver.TransientTransactionError, cmdErr)
 		})
 	})
+	t.Run("retry timeout option enforced", func(t *testing.T) {
+		withTransactionTimeout = 120 * time.Second
+
+		coll := db.Collection(t.Name())
+		_, err := coll.InsertOne(bgCtx, bson.D{{"x", 1}})
+		assert.Nil(t, err, "InsertOne error: %v", err)
+
+		// set failpoint
+		failpoint := bson.D{{"configureFailPoint", "failCommand"},
+			{"mode", "alwaysOn"},
+			{"data", bson.D{
+				{"failCommands", bson.A{"commitTransaction"}},
+				{"errorCode", 251},
+			}},
+		}
+		err = dbAdmin.RunCommand(bgCtx, failpoint).Err()
+		assert.Nil(t, err, "error setting failpoint: %v", err)
+		defer func() {
+			err = dbAdmin.RunCommand(bgCtx, bson.D{
+				{"configureFailPoint", "failCommand"},
+				{"mode", "off"},
+			}).Err()
+			assert.Nil(t, err, "error turning off failpoint: %v", err)
+		}()
+
+		sess, err := client.StartSession()
+		assert.Nil(t, err, "StartSession error: %v", err)
+		defer sess.EndSession(context.Background())
+
+		start := time.Now()
+		txnOpts := options.Transaction().SetMaxCommitRetryTime(time.Second)
+		_, err = sess.WithTransaction(context.Background(), func(ctx context.Context) (interface{}, error) {
+			_, err := coll.InsertOne(ctx, bson.D{{"x", 1}})
+			return nil, err
+		}, txnOpts)
+		elapsed := time.Since(start)
+
+		assert.NotNil(t, err, "expected WithTransaction error, got nil")
+		var cmdErr CommandError
+		assert.True(t, errors.As(err, &cmdErr), "expected error type %T, got %T", cmdErr, err)
+		assert.True(t, cmdErr.HasErrorLabel(driver.TransientTransactionError),
+			"expected error with label %v, got %v", driver.TransientTransactionError, cmdErr)
+		assert.Less(t, elapsed, 10*time.Second, "expected WithTransaction to stop retrying after 1s, took %v", elapsed)
+	})
 	t.Run("abortTransaction does not time out", func(t *testing.T) {
 		// Create a special CommandMonitor that only records information about abortTransaction events and also
 		// records the Context used in the CommandStartedEvent listener.
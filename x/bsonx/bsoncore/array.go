@@ -150,10 +150,14 @@ func (a Array) Validate() error {
 	if int(length) > len(a) {
 		return NewArrayLengthError(int(length), len(a))
 	}
+	if length < 5 {
+		return NewInsufficientBytesError(a, rem)
+	}
 	if a[length-1] != 0x00 {
 		return ErrMissingNull
 	}
 
+	rem = a[4:length]
 	length -= 4
 	var elem Element
 
@@ -8,6 +8,7 @@ package bsoncore
 
 import (
 	"strconv"
+	"time"
 )
 
 // ArrayBuilder builds a bson array
@@ -111,6 +112,13 @@ func (a *ArrayBuilder) AppendDateTime(dt int64) *ArrayBuilder {
 	return a
 }
 
+// AppendTime will append t as a datetime element to a.arr. t is converted to milliseconds since the Unix epoch in
+// the same way as the time.Time codec in the bson package, truncating any sub-millisecond precision.
+func (a *ArrayBuilder) AppendTime(t time.Time) *ArrayBuilder {
+	a.arr = AppendTimeElement(a.arr, a.incrementKey(), t)
+	return a
+}
+
 // AppendNull will append a null element to a.arr
 func (a *ArrayBuilder) AppendNull() *ArrayBuilder {
 	a.arr = AppendNullElement(a.arr, a.incrementKey())
@@ -12,6 +12,7 @@ import (
 	"math"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestArrayBuilder(t *testing.T) {
@@ -82,6 +83,12 @@ func TestArrayBuilder(t *testing.T) {
 			[]interface{}{int64(256)},
 			BuildDocumentFromElements(nil, AppendDateTimeElement(nil, "0", int64(256))),
 		},
+		{
+			"AppendTime",
+			NewArrayBuilder().AppendTime,
+			[]interface{}{time.Date(2024, time.January, 2, 3, 4, 5, 678901234, time.UTC)},
+			BuildDocumentFromElements(nil, AppendDateTimeElement(nil, "0", int64(1704164645678))),
+		},
 		{
 			"AppendNull",
 			NewArrayBuilder().AppendNull,
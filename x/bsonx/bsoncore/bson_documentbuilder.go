@@ -6,6 +6,8 @@
 
 package bsoncore
 
+import "time"
+
 // DocumentBuilder builds a bson document
 type DocumentBuilder struct {
 	doc     []byte
@@ -97,6 +99,13 @@ func (db *DocumentBuilder) AppendDateTime(key string, dt int64) *DocumentBuilder
 	return db
 }
 
+// AppendTime will append t as a datetime element using key to db.doc. t is converted to milliseconds since the
+// Unix epoch in the same way as the time.Time codec in the bson package, truncating any sub-millisecond precision.
+func (db *DocumentBuilder) AppendTime(key string, t time.Time) *DocumentBuilder {
+	db.doc = AppendTimeElement(db.doc, key, t)
+	return db
+}
+
 // AppendNull will append a null element using key to db.doc
 func (db *DocumentBuilder) AppendNull(key string) *DocumentBuilder {
 	db.doc = AppendNullElement(db.doc, key)
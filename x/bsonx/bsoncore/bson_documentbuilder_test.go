@@ -12,6 +12,7 @@ import (
 	"math"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestDocumentBuilder(t *testing.T) {
@@ -83,6 +84,18 @@ func TestDocumentBuilder(t *testing.T) {
 			[]interface{}{"foobar", int64(256)},
 			BuildDocumentFromElements(nil, AppendDateTimeElement(nil, "foobar", int64(256))),
 		},
+		{
+			"AppendTime",
+			NewDocumentBuilder().AppendTime,
+			[]interface{}{"foobar", time.Date(2024, time.January, 2, 3, 4, 5, 678901234, time.UTC)},
+			BuildDocumentFromElements(nil, AppendDateTimeElement(nil, "foobar", int64(1704164645678))),
+		},
+		{
+			"AppendTime before the Unix epoch",
+			NewDocumentBuilder().AppendTime,
+			[]interface{}{"foobar", time.Unix(-2, 500999999)},
+			BuildDocumentFromElements(nil, AppendDateTimeElement(nil, "foobar", int64(-1500))),
+		},
 		{
 			"AppendNull",
 			NewDocumentBuilder().AppendNull,
@@ -686,6 +686,12 @@ func valueLength(src []byte, t Type) (int32, bool) {
 		ok = false
 	}
 
+	// A corrupted length prefix can be negative or overflow when the size of
+	// the surrounding fields is added to it.
+	if length < 0 {
+		return 0, false
+	}
+
 	return length, ok
 }
 
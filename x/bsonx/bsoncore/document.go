@@ -385,10 +385,14 @@ func (d Document) Validate() error {
 	if int(length) > len(d) {
 		return NewDocumentLengthError(int(length), len(d))
 	}
+	if length < 5 {
+		return NewInsufficientBytesError(d, rem)
+	}
 	if d[length-1] != 0x00 {
 		return ErrMissingNull
 	}
 
+	rem = d[4:length]
 	length -= 4
 	var elem Element
 
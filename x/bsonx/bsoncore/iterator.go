@@ -23,17 +23,20 @@ type Iterator struct {
 	pos  int   // The position of the iterator in the list in reference to Next()
 }
 
-// Count returned the number of elements in the iterator's list.
+// Count returned the number of elements in the iterator's list. Count returns 0
+// if the list is corrupted; use Validate to distinguish a corrupted list from an
+// empty one.
 func (iter *Iterator) Count() int {
 	if iter == nil {
 		return 0
 	}
 
-	_, rem, ok := ReadLength(iter.List)
+	list, ok := iter.bounded()
 	if !ok {
 		return 0
 	}
 
+	rem := list[4:]
 	var count int
 	for len(rem) > 1 {
 		_, rem, ok = ReadElement(rem)
@@ -45,9 +48,22 @@ func (iter *Iterator) Count() int {
 	return count
 }
 
+// Validate returns an error if the iterator's list is not a valid BSON array.
+// A nil iterator or an empty list is valid.
+func (iter *Iterator) Validate() error {
+	if iter == nil || len(iter.List) == 0 {
+		return nil
+	}
+
+	if err := iter.List.Validate(); err != nil {
+		return fmt.Errorf("invalid DocumentSequence: %w", err)
+	}
+	return nil
+}
+
 // Empty returns true if the iterator's list is empty.
 func (iter *Iterator) Empty() bool {
-	return len(iter.List) <= 5
+	return iter == nil || len(iter.List) <= 5
 }
 
 // Reset will reset the iteration point for the Next method to the beginning of
@@ -58,24 +74,33 @@ func (iter *Iterator) Reset() {
 
 // Documents traverses the list as documents and returns them. This method
 // assumes that the underlying list is composed of documents and will return
-// an error otherwise.
+// an error otherwise. The list and each of the documents are validated before
+// they are returned.
 func (iter *Iterator) Documents() ([]Document, error) {
 	if iter == nil || len(iter.List) == 0 {
 		return nil, nil
 	}
 
+	if err := iter.Validate(); err != nil {
+		return nil, err
+	}
+
 	vals, err := iter.List.Values()
 	if err != nil {
 		return nil, errCorruptedDocument
 	}
 
 	docs := make([]Document, 0, len(vals))
-	for _, v := range vals {
+	for i, v := range vals {
 		if v.Type != TypeEmbeddedDocument {
 			return nil, fmt.Errorf("invalid DocumentSequence: a non-document value was found in sequence")
 		}
 
-		docs = append(docs, v.Data)
+		doc := Document(v.Data)
+		if err := doc.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid DocumentSequence: document %d: %w", i, err)
+		}
+		docs = append(docs, doc)
 	}
 
 	return docs, nil
@@ -88,15 +113,19 @@ func (iter *Iterator) Next() (*Value, error) {
 		return nil, io.EOF
 	}
 
-	if iter.pos < 4 {
-		if len(iter.List) < 4 {
-			return nil, errCorruptedDocument
-		}
+	list, ok := iter.bounded()
+	if !ok {
+		return nil, errCorruptedDocument
+	}
 
+	if iter.pos < 4 {
 		iter.pos = 4 // Skip the length of the document
 	}
+	if iter.pos >= len(list) {
+		return nil, errCorruptedDocument
+	}
 
-	rem := iter.List[iter.pos:]
+	rem := list[iter.pos:]
 	if len(rem) == 1 && rem[0] == 0x00 {
 		return nil, io.EOF // At the end of the document
 	}
@@ -111,3 +140,14 @@ func (iter *Iterator) Next() (*Value, error) {
 
 	return &val, nil
 }
+
+// bounded returns the iterator's list truncated to the length declared in its
+// header. It returns false if the declared length is invalid or exceeds the
+// available bytes.
+func (iter *Iterator) bounded() (Array, bool) {
+	length, _, ok := ReadLength(iter.List)
+	if !ok || length < 5 || int(length) > len(iter.List) {
+		return nil, false
+	}
+	return iter.List[:length], true
+}
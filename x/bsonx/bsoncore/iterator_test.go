@@ -7,6 +7,7 @@
 package bsoncore
 
 import (
+	"errors"
 	"io"
 	"testing"
 
@@ -266,6 +267,180 @@ func TestIterator_Next(t *testing.T) {
 
 }
 
+func TestIterator_Validate(t *testing.T) {
+	t.Parallel()
+
+	docs := BuildArray(nil,
+		Value{Type: TypeEmbeddedDocument, Data: BuildDocument(nil, AppendInt32Element(nil, "x", 1))},
+		Value{Type: TypeEmbeddedDocument, Data: BuildDocument(nil, AppendInt32Element(nil, "x", 2))},
+	)
+
+	// A document whose declared length is larger than the number of bytes in
+	// the array.
+	truncated := append(Array{}, docs[:len(docs)-3]...)
+	truncated = append(truncated, 0x00)
+
+	// An array whose first document is not null-terminated.
+	badDoc := BuildArray(nil, Value{Type: TypeEmbeddedDocument, Data: []byte{0x06, 0x00, 0x00, 0x00, 0x0a, 0x01}})
+
+	tests := []struct {
+		name     string
+		list     Array
+		validErr bool
+		docsErr  bool
+		count    int
+	}{
+		{
+			name:  "nil",
+			list:  nil,
+			count: 0,
+		},
+		{
+			name:  "empty",
+			list:  BuildArray(nil),
+			count: 0,
+		},
+		{
+			name:  "documents",
+			list:  docs,
+			count: 2,
+		},
+		{
+			name:    "non-document value",
+			list:    BuildArray(nil, Value{Type: TypeString, Data: AppendString(nil, "foo")}),
+			docsErr: true,
+			count:   1,
+		},
+		{
+			name:     "length exceeds bytes",
+			list:     docs[:len(docs)-1],
+			validErr: true,
+			docsErr:  true,
+			count:    0,
+		},
+		{
+			name:     "length too small",
+			list:     Array{0x00, 0x00, 0x00, 0x00, 0x00},
+			validErr: true,
+			docsErr:  true,
+			count:    0,
+		},
+		{
+			name:     "truncated document",
+			list:     truncated,
+			validErr: true,
+			docsErr:  true,
+			count:    0,
+		},
+		{
+			name:    "corrupted document",
+			list:    badDoc,
+			docsErr: true,
+			count:   1,
+		},
+	}
+
+	for _, tcase := range tests {
+		tcase := tcase
+
+		t.Run(tcase.name, func(t *testing.T) {
+			t.Parallel()
+
+			iter := &Iterator{List: tcase.list}
+
+			err := iter.Validate()
+			if tcase.validErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			_, err = iter.Documents()
+			if tcase.docsErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tcase.count, iter.Count())
+		})
+	}
+
+	t.Run("nil iterator", func(t *testing.T) {
+		t.Parallel()
+
+		var iter *Iterator
+
+		assert.NoError(t, iter.Validate())
+		assert.True(t, iter.Empty())
+		assert.Equal(t, 0, iter.Count())
+
+		docs, err := iter.Documents()
+		assert.NoError(t, err)
+		assert.Nil(t, docs)
+
+		_, err = iter.Next()
+		assert.ErrorIs(t, err, io.EOF)
+	})
+}
+
+// FuzzIterator checks that an Iterator never panics on corrupted input and
+// that its methods agree with each other on valid input.
+func FuzzIterator(f *testing.F) {
+	f.Add([]byte(BuildArray(nil,
+		Value{Type: TypeEmbeddedDocument, Data: BuildDocument(nil, AppendInt32Element(nil, "x", 1))},
+		Value{Type: TypeEmbeddedDocument, Data: BuildDocument(nil, AppendStringElement(nil, "y", "foo"))},
+	)))
+	f.Add([]byte(BuildArray(nil, Value{Type: TypeString, Data: AppendString(nil, "foo")})))
+	f.Add([]byte(BuildArray(nil)))
+	f.Add([]byte{0x05, 0x00, 0x00, 0x00})
+	f.Add([]byte{0xff, 0xff, 0xff, 0xff, 0x00})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		iter := &Iterator{List: data}
+
+		count := iter.Count()
+		validErr := iter.Validate()
+		docs, docsErr := iter.Documents()
+		_ = iter.Empty()
+
+		var values []*Value
+		var nextErr error
+		for i := 0; i <= len(data); i++ {
+			val, err := iter.Next()
+			if err != nil {
+				nextErr = err
+				break
+			}
+			values = append(values, val)
+		}
+
+		if validErr != nil {
+			if docsErr == nil && len(data) > 0 {
+				t.Fatalf("expected Documents to fail on invalid list, got %d documents", len(docs))
+			}
+			return
+		}
+
+		if !errors.Is(nextErr, io.EOF) {
+			t.Fatalf("expected Next to end with io.EOF on valid list, got %v", nextErr)
+		}
+		if len(data) > 0 && count != len(values) {
+			t.Fatalf("expected Count %d to match number of values %d", count, len(values))
+		}
+		if docsErr == nil && len(docs) != len(values) {
+			t.Fatalf("expected %d documents, got %d", len(values), len(docs))
+		}
+
+		iter.Reset()
+		if len(values) > 0 {
+			val, err := iter.Next()
+			require.NoError(t, err, "Next error after Reset")
+			assert.Equal(t, *values[0], *val, "expected same value after Reset")
+		}
+	})
+}
+
 // BenchmarkNext measures the performance of the Next function.
 func BenchmarkIterator_Next(b *testing.B) {
 	values := []Value{
@@ -18,35 +18,119 @@ import (
 )
 
 func TestCreateAuthenticator(t *testing.T) {
+	cred := &auth.Cred{
+		Username:    "user",
+		Password:    "pencil",
+		PasswordSet: true,
+	}
 
 	tests := []struct {
 		name   string
 		source string
+		cred   *auth.Cred
 		auth   auth.Authenticator
 	}{
-		{name: "", auth: &auth.DefaultAuthenticator{}},
-		{name: "SCRAM-SHA-1", auth: &auth.ScramAuthenticator{}},
-		{name: "SCRAM-SHA-256", auth: &auth.ScramAuthenticator{}},
-		{name: "MONGODB-CR", auth: &auth.MongoDBCRAuthenticator{}},
-		{name: "PLAIN", auth: &auth.PlainAuthenticator{}},
-		{name: "MONGODB-X509", auth: &auth.MongoDBX509Authenticator{}},
+		{name: "", cred: cred, auth: &auth.DefaultAuthenticator{}},
+		{name: "SCRAM-SHA-1", cred: cred, auth: &auth.ScramAuthenticator{}},
+		{name: "SCRAM-SHA-256", cred: cred, auth: &auth.ScramAuthenticator{}},
+		{name: "MONGODB-CR", cred: cred, auth: &auth.MongoDBCRAuthenticator{}},
+		{name: "PLAIN", cred: cred, auth: &auth.PlainAuthenticator{}},
+		{name: "MONGODB-X509", cred: &auth.Cred{Username: "user"}, auth: &auth.MongoDBX509Authenticator{}},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			cred := &auth.Cred{
-				Username:    "user",
-				Password:    "pencil",
-				PasswordSet: true,
-			}
-
-			a, err := auth.CreateAuthenticator(test.name, cred, &http.Client{})
+			a, err := auth.CreateAuthenticator(test.name, test.cred, &http.Client{})
 			require.NoError(t, err)
 			require.IsType(t, test.auth, a)
 		})
 	}
 }
 
+func TestCreateAuthenticator_InvalidCred(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		mechanism string
+		cred      *auth.Cred
+		wantErr   string
+	}{
+		{
+			name:      "SCRAM-SHA-1 without username",
+			mechanism: auth.SCRAMSHA1,
+			cred:      &auth.Cred{Password: "pencil"},
+			wantErr:   "SCRAM-SHA-1 username must not be empty",
+		},
+		{
+			name:      "SCRAM-SHA-1 without password",
+			mechanism: auth.SCRAMSHA1,
+			cred:      &auth.Cred{Username: "user"},
+			wantErr:   "SCRAM-SHA-1 password must not be empty",
+		},
+		{
+			name:      "SCRAM-SHA-256 without password",
+			mechanism: auth.SCRAMSHA256,
+			cred:      &auth.Cred{Username: "user"},
+			wantErr:   "SCRAM-SHA-256 password must not be empty",
+		},
+		{
+			name:      "PLAIN without username",
+			mechanism: auth.PLAIN,
+			cred:      &auth.Cred{Password: "pencil"},
+			wantErr:   "PLAIN username must not be empty",
+		},
+		{
+			name:      "PLAIN without password",
+			mechanism: auth.PLAIN,
+			cred:      &auth.Cred{Username: "user"},
+			wantErr:   "PLAIN password must not be empty",
+		},
+		{
+			name:      "MONGODB-X509 with password",
+			mechanism: auth.MongoDBX509,
+			cred:      &auth.Cred{Username: "user", Password: "pencil"},
+			wantErr:   "MONGODB-X509 password must not be set",
+		},
+		{
+			name:      "MONGODB-X509 with non-$external source",
+			mechanism: auth.MongoDBX509,
+			cred:      &auth.Cred{Source: "admin"},
+			wantErr:   "MONGODB-X509 source must be empty or $external",
+		},
+		{
+			name:      "MONGODB-AWS with username only",
+			mechanism: auth.MongoDBAWS,
+			cred:      &auth.Cred{Username: "key"},
+			wantErr:   "MONGODB-AWS password must be set when a username is set",
+		},
+		{
+			name:      "MONGODB-AWS with password only",
+			mechanism: auth.MongoDBAWS,
+			cred:      &auth.Cred{Password: "secret"},
+			wantErr:   "MONGODB-AWS username must be set when a password is set",
+		},
+		{
+			name:      "MONGODB-AWS with non-$external source",
+			mechanism: auth.MongoDBAWS,
+			cred:      &auth.Cred{Source: "admin"},
+			wantErr:   "MONGODB-AWS source must be empty or $external",
+		},
+	}
+
+	for _, test := range tests {
+		test := test // Capture range variable.
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := auth.CreateAuthenticator(test.mechanism, test.cred, &http.Client{})
+			require.Error(t, err)
+			require.Contains(t, err.Error(), test.wantErr)
+		})
+	}
+}
+
 func compareResponses(t *testing.T, wm []byte, expectedPayload bsoncore.Document, dbName string) {
 	_, _, _, opcode, wm, ok := wiremessage.ReadHeader(wm)
 	if !ok {
@@ -20,6 +20,7 @@ import (
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/internal/aws/credentials"
 	v4signer "go.mongodb.org/mongo-driver/v2/internal/aws/signer/v4"
+	"go.mongodb.org/mongo-driver/v2/internal/credproviders"
 	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
 )
 
@@ -166,8 +167,9 @@ func (ac *awsConversation) finalMsg(s1 []byte) ([]byte, error) {
 	req.Header.Set("X-MongoDB-Server-Nonce", base64.StdEncoding.EncodeToString(sm.Nonce.Data))
 	req.Header.Set("X-MongoDB-GS2-CB-Flag", "n")
 
-	// Create signer with credentials
-	signer := v4signer.NewSigner(ac.credentials)
+	// Create signer with the credentials retrieved above so the signature and the
+	// session token always come from the same set of credentials.
+	signer := v4signer.NewSigner(credentials.NewCredentials(&credproviders.StaticProvider{Value: creds}))
 
 	// Get signed header
 	_, err = signer.Sign(req, strings.NewReader(body), "sts", region, currentTime)
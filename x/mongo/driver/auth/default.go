@@ -8,26 +8,20 @@ package auth
 
 import (
 	"context"
-	"fmt"
 	"net/http"
 
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver"
 )
 
 func newDefaultAuthenticator(cred *Cred, httpClient *http.Client) (Authenticator, error) {
-	scram, err := newScramSHA256Authenticator(cred, httpClient)
+	scram, err := createScramSHA256Authenticator(cred)
 	if err != nil {
 		return nil, newAuthError("failed to create internal authenticator", err)
 	}
-	speculative, ok := scram.(SpeculativeAuthenticator)
-	if !ok {
-		typeErr := fmt.Errorf("expected SCRAM authenticator to be SpeculativeAuthenticator but got %T", scram)
-		return nil, newAuthError("failed to create internal authenticator", typeErr)
-	}
 
 	return &DefaultAuthenticator{
 		Cred:                     cred,
-		speculativeAuthenticator: speculative,
+		speculativeAuthenticator: scram,
 		httpClient:               httpClient,
 	}, nil
 }
@@ -58,9 +52,9 @@ func (a *DefaultAuthenticator) Auth(ctx context.Context, cfg *driver.AuthConfig)
 
 	switch chooseAuthMechanism(cfg) {
 	case SCRAMSHA256:
-		actual, err = newScramSHA256Authenticator(a.Cred, a.httpClient)
+		actual, err = createScramSHA256Authenticator(a.Cred)
 	case SCRAMSHA1:
-		actual, err = newScramSHA1Authenticator(a.Cred, a.httpClient)
+		actual, err = createScramSHA1Authenticator(a.Cred)
 	default:
 		actual, err = newMongoDBCRAuthenticator(a.Cred, a.httpClient)
 	}
@@ -24,6 +24,12 @@ func newMongoDBAWSAuthenticator(cred *Cred, httpClient *http.Client) (Authentica
 	if cred.Source != "" && cred.Source != sourceExternal {
 		return nil, newAuthError("MONGODB-AWS source must be empty or $external", nil)
 	}
+	if cred.Username != "" && cred.Password == "" {
+		return nil, newAuthError("MONGODB-AWS password must be set when a username is set", nil)
+	}
+	if cred.Username == "" && cred.Password != "" {
+		return nil, newAuthError("MONGODB-AWS username must be set when a password is set", nil)
+	}
 	if httpClient == nil {
 		return nil, errors.New("httpClient must not be nil")
 	}
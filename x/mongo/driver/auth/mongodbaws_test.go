@@ -8,9 +8,25 @@ package auth
 
 import (
 	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/aws/credentials"
+	"go.mongodb.org/mongo-driver/v2/internal/credproviders"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/auth/creds"
 )
 
 func TestGetRegion(t *testing.T) {
@@ -46,3 +62,124 @@ func TestGetRegion(t *testing.T) {
 	}
 
 }
+
+// redirectTransport sends every request to the test server while preserving the path and query.
+type redirectTransport struct {
+	target *url.URL
+	client *http.Client
+}
+
+func (t redirectTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.URL.Scheme = t.target.Scheme
+	req.URL.Host = t.target.Host
+	req.Host = t.target.Host
+	req.RequestURI = ""
+	return t.client.Transport.RoundTrip(req)
+}
+
+// runAWSConversation runs the client side of a MONGODB-AWS conversation and returns the client-final
+// payload.
+func runAWSConversation(t *testing.T, cred *credentials.Credentials) bsoncore.Document {
+	t.Helper()
+
+	conv := &awsConversation{credentials: cred}
+	first, err := conv.Step(nil)
+	require.NoError(t, err, "error in client-first step")
+
+	_, clientNonce, ok := bsoncore.Document(first).Lookup("r").BinaryOK()
+	require.True(t, ok, "expected client nonce in client-first message")
+	serverNonce := append(append([]byte{}, clientNonce...), make([]byte, 32)...)
+	challenge, err := bson.Marshal(bson.D{
+		{"s", bson.Binary{Data: serverNonce}},
+		{"h", "sts.amazonaws.com"},
+	})
+	require.NoError(t, err, "error marshaling server-first message")
+
+	final, err := conv.Step(challenge)
+	require.NoError(t, err, "error in client-final step")
+	return final
+}
+
+func TestMongoDBAWSWebIdentity(t *testing.T) {
+	const (
+		roleArn      = "arn:aws:iam::123456789012:role/test-role"
+		webToken     = "web-identity-token"
+		accessKeyID  = "ASIATEMPORARYKEY"
+		secretKey    = "temporarySecret"
+		sessionToken = "temporarySessionToken"
+	)
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte(webToken), 0600), "error writing token file")
+
+	testCases := []struct {
+		name        string
+		sessionName string
+		expiration  time.Duration
+		reqCount    uint32
+	}{
+		{"cached until expiry", "test-session", time.Hour, 1},
+		{"refreshed within expiry window", "test-session", time.Minute, 2},
+		{"generated session name", "", time.Hour, 1},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("AWS_ACCESS_KEY_ID", "")
+			t.Setenv("AWS_SECRET_ACCESS_KEY", "")
+			t.Setenv("AWS_SESSION_TOKEN", "")
+			t.Setenv("AWS_ROLE_ARN", roleArn)
+			t.Setenv("AWS_WEB_IDENTITY_TOKEN_FILE", tokenFile)
+			t.Setenv("AWS_ROLE_SESSION_NAME", tc.sessionName)
+
+			var cnt uint32
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				query := r.URL.Query()
+				if r.Method != http.MethodPost ||
+					query.Get("Action") != "AssumeRoleWithWebIdentity" ||
+					query.Get("RoleArn") != roleArn ||
+					query.Get("WebIdentityToken") != webToken ||
+					query.Get("RoleSessionName") == "" ||
+					(tc.sessionName != "" && query.Get("RoleSessionName") != tc.sessionName) {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				atomic.AddUint32(&cnt, 1)
+				_, _ = io.WriteString(w, fmt.Sprintf(`{
+					"AssumeRoleWithWebIdentityResponse": {
+						"AssumeRoleWithWebIdentityResult": {
+							"Credentials": {
+								"AccessKeyId": %q,
+								"SecretAccessKey": %q,
+								"SessionToken": %q,
+								"Expiration": %d
+							}
+						}
+					}
+				}`, accessKeyID, secretKey, sessionToken, time.Now().Add(tc.expiration).Unix()))
+			}))
+			defer ts.Close()
+
+			target, err := url.Parse(ts.URL)
+			require.NoError(t, err, "error parsing test server URL")
+			client := &http.Client{Transport: redirectTransport{target: target, client: ts.Client()}}
+
+			provider := creds.NewAWSCredentialProvider(client, &credproviders.StaticProvider{})
+			for i := 0; i < 2; i++ {
+				final := runAWSConversation(t, provider.Cred)
+
+				token, ok := final.Lookup("t").StringValueOK()
+				assert.True(t, ok, "expected session token in client-final message")
+				assert.Equal(t, sessionToken, token, "expected session token %q, got %q", sessionToken, token)
+
+				authz, ok := final.Lookup("a").StringValueOK()
+				assert.True(t, ok, "expected Authorization header in client-final message")
+				assert.True(t, strings.Contains(authz, "Credential="+accessKeyID+"/"),
+					"expected Authorization header to be signed with %q, got %q", accessKeyID, authz)
+				assert.True(t, strings.Contains(authz, "x-amz-security-token"),
+					"expected Authorization header to sign the session token, got %q", authz)
+			}
+			assert.Equal(t, tc.reqCount, atomic.LoadUint32(&cnt), "expected and actual STS request count don't match")
+		})
+	}
+}
@@ -17,6 +17,13 @@ import (
 const PLAIN = "PLAIN"
 
 func newPlainAuthenticator(cred *Cred, _ *http.Client) (Authenticator, error) {
+	if cred.Username == "" {
+		return nil, newAuthError("PLAIN username must not be empty", nil)
+	}
+	if cred.Password == "" {
+		return nil, newAuthError("PLAIN password must not be empty", nil)
+	}
+
 	// TODO(GODRIVER-3317): The PLAIN specification says about auth source:
 	//
 	// "MUST be specified. Defaults to the database name if supplied on the
@@ -38,6 +38,33 @@ var (
 )
 
 func newScramSHA1Authenticator(cred *Cred, _ *http.Client) (Authenticator, error) {
+	if err := validateScramCred(SCRAMSHA1, cred); err != nil {
+		return nil, err
+	}
+	return createScramSHA1Authenticator(cred)
+}
+
+func newScramSHA256Authenticator(cred *Cred, _ *http.Client) (Authenticator, error) {
+	if err := validateScramCred(SCRAMSHA256, cred); err != nil {
+		return nil, err
+	}
+	return createScramSHA256Authenticator(cred)
+}
+
+// validateScramCred returns an error if cred cannot be used with the SCRAM mechanism. It is only applied when SCRAM is
+// requested explicitly, because the default authenticator also creates SCRAM authenticators for credentials that may
+// never be used, such as ones that only set an auth source.
+func validateScramCred(mechanism string, cred *Cred) error {
+	if cred.Username == "" {
+		return newAuthError(mechanism+" username must not be empty", nil)
+	}
+	if cred.Password == "" {
+		return newAuthError(mechanism+" password must not be empty", nil)
+	}
+	return nil
+}
+
+func createScramSHA1Authenticator(cred *Cred) (*ScramAuthenticator, error) {
 	source := cred.Source
 	if source == "" {
 		source = "admin"
@@ -55,7 +82,7 @@ func newScramSHA1Authenticator(cred *Cred, _ *http.Client) (Authenticator, error
 	}, nil
 }
 
-func newScramSHA256Authenticator(cred *Cred, _ *http.Client) (Authenticator, error) {
+func createScramSHA256Authenticator(cred *Cred) (*ScramAuthenticator, error) {
 	source := cred.Source
 	if source == "" {
 		source = "admin"
@@ -52,6 +52,11 @@ type BatchCursor struct {
 	crypt                Crypt
 	serverAPI            *ServerAPIOptions
 
+	// retainLastResponse specifies whether lastResponse is recorded for each
+	// command run by the cursor.
+	retainLastResponse bool
+	lastResponse       bsoncore.Document
+
 	// maxAwaitTime is only valid for tailable awaitData cursors. If this option
 	// is set, it will be used as the "maxTimeMS" field on getMore commands.
 	maxAwaitTime *time.Duration
@@ -73,6 +78,11 @@ type CursorResponse struct {
 	Collection           string
 	ID                   int64
 	postBatchResumeToken bsoncore.Document
+
+	// Response is the full server response that contained the cursor document.
+	// It is only retained by the BatchCursor if CursorOptions.RetainLastResponse
+	// is true.
+	Response bsoncore.Document
 }
 
 // ExtractCursorDocument retrieves cursor document from a database response. If the
@@ -174,6 +184,11 @@ type CursorOptions struct {
 	// MaxAwaitTime is only valid for tailable awaitData cursors. If this option
 	// is set, it will be used as the "maxTimeMS" field on getMore commands.
 	MaxAwaitTime *time.Duration
+
+	// RetainLastResponse specifies whether the BatchCursor keeps a reference to
+	// the server response for the most recent command it ran, which can be
+	// retrieved with LastResponse.
+	RetainLastResponse bool
 }
 
 // SetMaxAwaitTime will set the maxTimeMS value on getMore commands for
@@ -210,6 +225,11 @@ func NewBatchCursor(
 		serverAPI:            opts.ServerAPI,
 		serverDescription:    cr.Desc,
 		encoderFn:            opts.MarshalValueEncoderFn,
+		retainLastResponse:   opts.RetainLastResponse,
+	}
+
+	if bc.retainLastResponse {
+		bc.lastResponse = cr.Response
 	}
 
 	if firstBatch != nil {
@@ -406,6 +426,10 @@ func (bc *BatchCursor) getMore(ctx context.Context) {
 		Database:   bc.database,
 		Deployment: bc.getOperationDeployment(),
 		ProcessResponseFn: func(_ context.Context, response bsoncore.Document, _ ResponseInfo) error {
+			if bc.retainLastResponse {
+				bc.lastResponse = response
+			}
+
 			id, ok := response.Lookup("cursor", "id").Int64OK()
 			if !ok {
 				return fmt.Errorf("cursor.id should be an int64 but is a BSON %s", response.Lookup("cursor", "id").Type)
@@ -459,6 +483,13 @@ func (bc *BatchCursor) getMore(ctx context.Context) {
 		omitReadPreference: true,
 	}.Execute(ctx)
 
+	// Server errors are returned before ProcessResponseFn is called, so record
+	// the response that contained the error.
+	var driverErr Error
+	if bc.retainLastResponse && errors.As(bc.err, &driverErr) && driverErr.Raw != nil {
+		bc.lastResponse = driverErr.Raw
+	}
+
 	// Once the cursor has been drained, we can unpin the connection if one is currently pinned.
 	if bc.id == 0 {
 		err := bc.unpinConnection()
@@ -489,6 +520,13 @@ func (bc *BatchCursor) PostBatchResumeToken() bsoncore.Document {
 	return bc.postBatchResumeToken
 }
 
+// LastResponse returns the server response for the most recent command run by
+// the cursor, including responses that contained a server error. It returns nil
+// if the cursor was not created with CursorOptions.RetainLastResponse set.
+func (bc *BatchCursor) LastResponse() bsoncore.Document {
+	return bc.lastResponse
+}
+
 // SetBatchSize sets the batchSize for future getMore operations.
 func (bc *BatchCursor) SetBatchSize(size int32) {
 	bc.batchSize = size
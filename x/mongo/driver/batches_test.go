@@ -71,3 +71,30 @@ func TestAppendBatchArray(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, dst, got)
 }
+
+func BenchmarkAppendBatch(b *testing.B) {
+	doc := bsoncore.NewDocumentBuilder().
+		AppendInt32("_id", 1).
+		AppendString("x", "Lorem ipsum dolor sit amet").
+		Build()
+	docs := make([]bsoncore.Document, 10000)
+	for i := range docs {
+		docs[i] = doc
+	}
+	const maxMessageSize = 48000000
+
+	b.Run("sequence", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			batches := &Batches{Identifier: "documents", Documents: docs}
+			_, _, _ = batches.AppendBatchSequence(nil, len(docs), maxMessageSize)
+		}
+	})
+	b.Run("array", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			batches := &Batches{Identifier: "documents", Documents: docs}
+			_, _, _ = batches.AppendBatchArray(nil, len(docs), maxMessageSize)
+		}
+	})
+}
@@ -81,7 +81,14 @@ var random = randutil.NewLockedRand()
 // ParseAndValidate parses the provided URI into a ConnString object.
 // It check that all values are valid.
 func ParseAndValidate(s string) (*ConnString, error) {
-	connStr, err := Parse(s)
+	return ParseAndValidateWithResolver(s, dns.DefaultResolver)
+}
+
+// ParseAndValidateWithResolver behaves like ParseAndValidate but uses the
+// provided resolver for the SRV and TXT lookups of "mongodb+srv" URIs. If
+// resolver is nil, dns.DefaultResolver is used.
+func ParseAndValidateWithResolver(s string, resolver *dns.Resolver) (*ConnString, error) {
+	connStr, err := ParseWithResolver(s, resolver)
 	if err != nil {
 		return nil, err
 	}
@@ -96,7 +103,18 @@ func ParseAndValidate(s string) (*ConnString, error) {
 // but does not check that all values are valid. Use `ConnString.Validate()`
 // to run the validation checks separately.
 func Parse(s string) (*ConnString, error) {
-	p := parser{dnsResolver: dns.DefaultResolver}
+	return ParseWithResolver(s, dns.DefaultResolver)
+}
+
+// ParseWithResolver behaves like Parse but uses the provided resolver for the
+// SRV and TXT lookups of "mongodb+srv" URIs. If resolver is nil,
+// dns.DefaultResolver is used.
+func ParseWithResolver(s string, resolver *dns.Resolver) (*ConnString, error) {
+	if resolver == nil {
+		resolver = dns.DefaultResolver
+	}
+
+	p := parser{dnsResolver: resolver}
 	connStr, err := p.parse(s)
 	if err != nil {
 		return nil, fmt.Errorf("error parsing uri: %w", err)
@@ -195,6 +213,120 @@ func (u *ConnString) String() string {
 	return u.Original
 }
 
+// Redacted returns the original URI with the password in the user info and the
+// values of any password options and secret authMechanismProperties, such as
+// AWS_SESSION_TOKEN, replaced by "***". The rest of the URI is returned
+// unchanged.
+func (u *ConnString) Redacted() string {
+	return Redact(u.Original)
+}
+
+// Redact returns uri with the password in the user info and the values of any
+// password options and secret authMechanismProperties replaced by "***". The
+// user info and options are located the
+// same way Parse locates them, but uri is not otherwise validated.
+func Redact(uri string) string {
+	const redacted = "***"
+
+	var b strings.Builder
+	b.Grow(len(uri))
+
+	if scheme, rest, ok := strings.Cut(uri, "://"); ok {
+		b.WriteString(scheme)
+		b.WriteString("://")
+		uri = rest
+	}
+
+	if idx := strings.Index(uri, "@"); idx != -1 {
+		if username, _, ok := strings.Cut(uri[:idx], ":"); ok {
+			b.WriteString(username)
+			b.WriteString(":")
+			b.WriteString(redacted)
+		} else {
+			b.WriteString(uri[:idx])
+		}
+		b.WriteString("@")
+		uri = uri[idx+1:]
+	}
+
+	idx := strings.Index(uri, "?")
+	if idx == -1 {
+		b.WriteString(uri)
+		return b.String()
+	}
+	b.WriteString(uri[:idx+1])
+	uri = uri[idx+1:]
+
+	for len(uri) > 0 {
+		pair := uri
+		sep := ""
+		if idx := strings.IndexAny(uri, "&;"); idx != -1 {
+			pair, sep = uri[:idx], uri[idx:idx+1]
+			uri = uri[idx+1:]
+		} else {
+			uri = ""
+		}
+
+		key, _, ok := strings.Cut(pair, "=")
+		switch strings.ToLower(key) {
+		case "sslclientcertificatekeypassword", "tlscertificatekeyfilepassword":
+			if ok {
+				pair = key + "=" + redacted
+			}
+		case "authmechanismproperties":
+			if ok {
+				pair = key + "=" + redactAuthMechanismProperties(pair[len(key)+1:], redacted)
+			}
+		}
+		b.WriteString(pair)
+		b.WriteString(sep)
+	}
+
+	return b.String()
+}
+
+// secretAuthMechanismProperties are the authMechanismProperties whose values
+// are credentials.
+var secretAuthMechanismProperties = map[string]bool{
+	"AWS_SESSION_TOKEN": true,
+}
+
+// redactAuthMechanismProperties replaces the values of any secret properties
+// in the escaped authMechanismProperties option value with redacted. The ","
+// and ":" separators may be percent-encoded, as Parse unescapes the value
+// before splitting it.
+func redactAuthMechanismProperties(value, redacted string) string {
+	var b strings.Builder
+	for len(value) > 0 {
+		prop, sep, rest := cutEscaped(value, ',')
+		if key, kvSep, _ := cutEscaped(prop, ':'); kvSep != "" {
+			if unescaped, err := url.QueryUnescape(key); err != nil || secretAuthMechanismProperties[unescaped] {
+				prop = key + kvSep + redacted
+			}
+		}
+		b.WriteString(prop)
+		b.WriteString(sep)
+		value = rest
+	}
+	return b.String()
+}
+
+// cutEscaped slices s around the first instance of sep, either literal or
+// percent-encoded, and returns the text before it, the separator as it appears
+// in s, and the text after it. If sep is not found, it returns s, "", "".
+func cutEscaped(s string, sep byte) (before, found, after string) {
+	escaped := fmt.Sprintf("%%%02X", sep)
+	for i := 0; i < len(s); i++ {
+		if s[i] == sep {
+			return s[:i], s[i : i+1], s[i+1:]
+		}
+		if s[i] == '%' && i+3 <= len(s) && strings.EqualFold(s[i:i+3], escaped) {
+			return s[:i], s[i : i+3], s[i+3:]
+		}
+	}
+	return s, "", ""
+}
+
 // HasAuthParameters returns true if this ConnString has any authentication parameters set and therefore represents
 // a request for authentication.
 func (u *ConnString) HasAuthParameters() bool {
@@ -14,6 +14,7 @@
 package dns
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net"
@@ -31,6 +32,30 @@ type Resolver struct {
 // DefaultResolver is a Resolver that uses the default Resolver from the net package.
 var DefaultResolver = &Resolver{net.LookupSRV, net.LookupTXT}
 
+// ContextResolver performs context-aware SRV and TXT lookups. Its method
+// signatures match those of *net.Resolver.
+type ContextResolver interface {
+	LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error)
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+}
+
+// NewResolver creates a Resolver that performs lookups using the provided
+// ContextResolver. If cr is nil, DefaultResolver is returned.
+func NewResolver(cr ContextResolver) *Resolver {
+	if cr == nil {
+		return DefaultResolver
+	}
+
+	return &Resolver{
+		LookupSRV: func(service, proto, name string) (string, []*net.SRV, error) {
+			return cr.LookupSRV(context.Background(), service, proto, name)
+		},
+		LookupTXT: func(name string) ([]string, error) {
+			return cr.LookupTXT(context.Background(), name)
+		},
+	}
+}
+
 // ParseHosts uses the srv string and service name to get the hosts.
 func (r *Resolver) ParseHosts(host string, srvName string, stopOnErr bool) ([]string, error) {
 	parsedHosts := strings.Split(host, ",")
@@ -141,10 +141,8 @@ func (wce WriteCommandError) Error() string {
 
 // Retryable returns true if the error is retryable
 func (wce WriteCommandError) Retryable(serverKind description.ServerKind, wireVersion *description.VersionRange) bool {
-	for _, label := range wce.Labels {
-		if label == RetryableWriteError {
-			return true
-		}
+	if wce.HasErrorLabel(RetryableWriteError) {
+		return true
 	}
 	if wireVersion != nil && wireVersion.Max >= 9 {
 		return false
@@ -156,13 +154,16 @@ func (wce WriteCommandError) Retryable(serverKind description.ServerKind, wireVe
 	return wce.WriteConcernError.Retryable(serverKind, wireVersion)
 }
 
-// HasErrorLabel returns true if the error contains the specified label.
+// HasErrorLabel returns true if the error or its write concern error contains the specified label.
 func (wce WriteCommandError) HasErrorLabel(label string) bool {
 	for _, l := range wce.Labels {
 		if l == label {
 			return true
 		}
 	}
+	if wce.WriteConcernError != nil {
+		return wce.WriteConcernError.HasErrorLabel(label)
+	}
 	return false
 }
 
@@ -203,6 +204,16 @@ func (wce WriteConcernError) Retryable(serverKind description.ServerKind, wireVe
 	return false
 }
 
+// HasErrorLabel returns true if the write concern error contains the specified label.
+func (wce WriteConcernError) HasErrorLabel(label string) bool {
+	for _, l := range wce.Labels {
+		if l == label {
+			return true
+		}
+	}
+	return false
+}
+
 // NodeIsRecovering returns true if this error is a node is recovering error.
 func (wce WriteConcernError) NodeIsRecovering() bool {
 	for _, code := range nodeIsRecoveringCodes {
@@ -276,6 +287,12 @@ type Error struct {
 	Raw             bsoncore.Document
 }
 
+// CodeName returns the name of the server error code (e.g. "NamespaceNotFound"), or an empty string if the server
+// did not report one. The numeric code is available through the Code field.
+func (e Error) CodeName() string {
+	return e.Name
+}
+
 // UnsupportedStorageEngine returns whether e came as a result of an unsupported storage engine
 func (e Error) UnsupportedStorageEngine() bool {
 	return e.Code == 20 && strings.HasPrefix(strings.ToLower(e.Message), "transaction numbers")
@@ -436,7 +453,8 @@ func ExtractErrorFromServerResponse(doc bsoncore.Document) error {
 				codeName = str
 			}
 		case "code":
-			if c, okay := elem.Value().Int32OK(); okay {
+			// Some servers and proxies encode the code as a double or int64.
+			if c, okay := elem.Value().AsInt32OK(); okay {
 				code = c
 			}
 		case "errorLabels":
@@ -511,6 +529,7 @@ func ExtractErrorFromServerResponse(doc bsoncore.Document) error {
 				for _, val := range vals {
 					if str, ok := val.StringValueOK(); ok {
 						labels = append(labels, str)
+						wcError.WriteConcernError.Labels = append(wcError.WriteConcernError.Labels, str)
 					}
 				}
 			}
@@ -0,0 +1,95 @@
+// Copyright (C) MongoDB, Inc. 2026-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package driver
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/internal/assert"
+	"go.mongodb.org/mongo-driver/v2/internal/require"
+	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/description"
+)
+
+func TestExtractErrorFromServerResponse_WriteConcernErrorLabels(t *testing.T) {
+	wceDoc := bsoncore.NewDocumentBuilder().
+		AppendInt32("code", 91).
+		AppendString("errmsg", "shutdown in progress").
+		AppendArray("errorLabels", bsoncore.NewArrayBuilder().
+			AppendString(RetryableWriteError).
+			Build()).
+		Build()
+	rdr := bsoncore.NewDocumentBuilder().
+		AppendInt32("ok", 1).
+		AppendDocument("writeConcernError", wceDoc).
+		Build()
+
+	err := ExtractErrorFromServerResponse(rdr)
+
+	wce, ok := err.(WriteCommandError)
+	require.True(t, ok, "expected error to be a WriteCommandError, got %T", err)
+	require.NotNil(t, wce.WriteConcernError, "expected a write concern error, got nil")
+
+	assert.Equal(t, []string{RetryableWriteError}, wce.WriteConcernError.Labels,
+		"expected write concern error labels %v, got %v", []string{RetryableWriteError}, wce.WriteConcernError.Labels)
+	assert.True(t, wce.HasErrorLabel(RetryableWriteError), "expected error to have label %q", RetryableWriteError)
+
+	wireVersion := &description.VersionRange{Min: 0, Max: 21}
+	assert.True(t, wce.Retryable(description.ServerKindRSPrimary, wireVersion),
+		"expected labeled write concern error to be retryable")
+
+	// A write concern error with the label should be retryable even if the label
+	// is only present on the write concern error itself.
+	wce.Labels = nil
+	assert.True(t, wce.Retryable(description.ServerKindRSPrimary, wireVersion),
+		"expected labeled write concern error to be retryable")
+}
+
+func TestExtractErrorFromServerResponse_CodeName(t *testing.T) {
+	rdr := bsoncore.NewDocumentBuilder().
+		AppendInt32("ok", 0).
+		AppendInt32("code", 26).
+		AppendString("codeName", "NamespaceNotFound").
+		AppendString("errmsg", "ns not found").
+		Build()
+
+	err := ExtractErrorFromServerResponse(rdr)
+
+	derr, ok := err.(Error)
+	require.True(t, ok, "expected error to be an Error, got %T", err)
+	assert.Equal(t, int32(26), derr.Code, "expected code 26, got %d", derr.Code)
+	assert.Equal(t, "NamespaceNotFound", derr.CodeName(),
+		"expected code name %q, got %q", "NamespaceNotFound", derr.CodeName())
+	assert.True(t, derr.NamespaceNotFound(), "expected NamespaceNotFound to be true")
+
+	assert.Equal(t, "", Error{Code: 1}.CodeName(), "expected empty code name for error without codeName")
+}
+
+func TestExtractErrorFromServerResponse_NonInt32Code(t *testing.T) {
+	testCases := []struct {
+		name string
+		doc  bsoncore.Document
+	}{
+		{
+			name: "double",
+			doc:  bsoncore.NewDocumentBuilder().AppendDouble("ok", 0).AppendDouble("code", 13).Build(),
+		},
+		{
+			name: "int64",
+			doc:  bsoncore.NewDocumentBuilder().AppendInt32("ok", 0).AppendInt64("code", 13).Build(),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ExtractErrorFromServerResponse(tc.doc)
+
+			derr, ok := err.(Error)
+			require.True(t, ok, "expected error to be an Error, got %T", err)
+			assert.Equal(t, int32(13), derr.Code, "expected code 13, got %d", derr.Code)
+		})
+	}
+}
@@ -117,9 +117,15 @@ func NewMongoCrypt(opts *options.MongoCryptOptions) (*MongoCrypt, error) {
 
 	C.mongocrypt_setopt_use_need_kms_credentials_state(crypt.wrapped)
 
-	// initialize handle
+	// initialize handle. The crypt_shared library is loaded during initialization, so an error here is reported
+	// with the override path if one was set.
 	if !C.mongocrypt_init(crypt.wrapped) {
-		return nil, crypt.createErrorFromStatus()
+		err := crypt.createErrorFromStatus()
+		if !opts.CryptSharedLibDisabled && opts.CryptSharedLibOverridePath != "" {
+			return nil, fmt.Errorf("error loading the crypt_shared library from %q: %w",
+				opts.CryptSharedLibOverridePath, err)
+		}
+		return nil, err
 	}
 
 	return crypt, nil
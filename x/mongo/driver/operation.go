@@ -317,9 +317,14 @@ type Operation struct {
 	Name string
 
 	// OmitMaxTimeMS will ensure that wire messages sent to the server in service
-	// of the operation do not contain a maxTimeMS field.
+	// of the operation do not contain a maxTimeMS field calculated from either
+	// the context deadline or MaxTime.
 	OmitMaxTimeMS bool
 
+	// MaxTime is the server-side time limit for the operation. It is sent as
+	// maxTimeMS only if the operation's context does not have a deadline.
+	MaxTime *time.Duration
+
 	// Authenticator is the authenticator to use for this operation when a reauthentication is
 	// required.
 	Authenticator Authenticator
@@ -1379,13 +1384,18 @@ func (op Operation) createWireMessage(
 		fIdx = len(dst)
 
 		batchOffset := -1
-		switch op.Batches.(type) {
+		switch opBatches := op.Batches.(type) {
 		case *Batches:
 			dst, info.cmd, err = op.createMsgWireMessage(ctx, maxTimeMS, dst, desc, conn, op.CommandFn)
-			if err == nil && op.Batches != nil {
+			if err == nil && opBatches != nil {
 				batchOffset = len(dst)
-				info.processedBatches, dst, err = op.Batches.AppendBatchSequence(dst,
-					int(desc.MaxBatchCount), int(desc.MaxMessageSize),
+				// The header, command body, and document sequence section header share the
+				// maxMessageSizeBytes budget with the documents, so only the remainder is
+				// available for the batch.
+				seqHeaderLen := 1 + 4 + len(opBatches.Identifier) + 1
+				maxBatchSize := int(desc.MaxMessageSize) - (len(dst) - int(wmindex)) - seqHeaderLen
+				info.processedBatches, dst, err = opBatches.AppendBatchSequence(dst,
+					int(desc.MaxBatchCount), maxBatchSize,
 				)
 				if err != nil {
 					break
@@ -1717,7 +1727,7 @@ func (op Operation) addClusterTime(dst []byte, desc description.SelectedServer)
 // calculateMaxTimeMS calculates the value of the 'maxTimeMS' field to potentially append
 // to the wire message based on the current context's deadline and the 90th percentile RTT
 // if the ctx is a Timeout context. If the context is not a Timeout context, it uses the
-// operation's MaxTimeMS if set. If no MaxTimeMS is set on the operation, and context is
+// operation's MaxTime if set. If no MaxTime is set on the operation, and context is
 // not a Timeout context, calculateMaxTimeMS returns 0.
 func (op Operation) calculateMaxTimeMS(ctx context.Context, rttMin time.Duration, rttStats string) (int64, error) {
 	if op.OmitMaxTimeMS {
@@ -1726,7 +1736,11 @@ func (op Operation) calculateMaxTimeMS(ctx context.Context, rttMin time.Duration
 
 	deadline, ok := ctx.Deadline()
 	if !ok {
-		return 0, nil
+		if op.MaxTime == nil || *op.MaxTime <= 0 {
+			return 0, nil
+		}
+		// Round up so a sub-millisecond MaxTime is not truncated to 0, which would disable the limit.
+		return int64((*op.MaxTime + time.Millisecond - 1) / time.Millisecond), nil
 	}
 
 	remainingTimeout := time.Until(deadline)
@@ -2080,6 +2094,40 @@ func (op Operation) canLogCommandMessage() bool {
 	return op.Logger != nil && op.Logger.LevelComponentEnabled(logger.LevelDebug, logger.ComponentCommand)
 }
 
+// startedLogKeysAndValues returns the extra keys and values for a command started log message. If the concern
+// sources of the operation are known, the read and write concerns sent with the command and where they were configured
+// are included to help debug concern inheritance. Concerns that were not sent with the command are omitted.
+func (op Operation) startedLogKeysAndValues(ctx context.Context, info startedInformation, formattedCmd string) []interface{} {
+	keysAndValues := []interface{}{logger.KeyCommand, formattedCmd}
+
+	sources, ok := driverutil.ConcernSourcesFromContext(ctx)
+	if client := op.Client; client != nil && (client.TransactionRunning() || client.Committing || client.Aborting) {
+		// Concerns set on a transaction override all others.
+		sources, ok = driverutil.ConcernSources{
+			ReadConcern:  driverutil.ConcernSourceTransaction,
+			WriteConcern: driverutil.ConcernSourceTransaction,
+		}, true
+	}
+	if !ok {
+		return keysAndValues
+	}
+
+	for _, concern := range []struct {
+		field, key, sourceKey, source string
+	}{
+		{"readConcern", logger.KeyReadConcern, logger.KeyReadConcernSource, sources.ReadConcern},
+		{"writeConcern", logger.KeyWriteConcern, logger.KeyWriteConcernSource, sources.WriteConcern},
+	} {
+		// Only report a source for a concern that was sent with the command.
+		val, err := info.cmd.LookupErr(concern.field)
+		if err != nil {
+			continue
+		}
+		keysAndValues = append(keysAndValues, concern.key, val.String(), concern.sourceKey, concern.source)
+	}
+	return keysAndValues
+}
+
 func (op Operation) canPublishStartedEvent() bool {
 	return op.CommandMonitor != nil && op.CommandMonitor.Started != nil
 }
@@ -2109,7 +2157,7 @@ func (op Operation) publishStartedEvent(ctx context.Context, info startedInforma
 				ServerPort:         port,
 				ServiceID:          info.serviceID,
 			},
-				logger.KeyCommand, formattedCmd)...)
+				op.startedLogKeysAndValues(ctx, info, formattedCmd)...)...)
 
 	}
 
@@ -13,6 +13,7 @@ import (
 
 	"go.mongodb.org/mongo-driver/v2/event"
 	"go.mongodb.org/mongo-driver/v2/internal/driverutil"
+	"go.mongodb.org/mongo-driver/v2/internal/logger"
 	"go.mongodb.org/mongo-driver/v2/mongo/readconcern"
 	"go.mongodb.org/mongo-driver/v2/mongo/readpref"
 	"go.mongodb.org/mongo-driver/v2/mongo/writeconcern"
@@ -25,6 +26,7 @@ import (
 // Aggregate represents an aggregate operation.
 type Aggregate struct {
 	authenticator            driver.Authenticator
+	logger                   *logger.Logger
 	allowDiskUse             *bool
 	batchSize                *int32
 	bypassDocumentValidation *bool
@@ -49,9 +51,13 @@ type Aggregate struct {
 	hasOutputStage           bool
 	customOptions            map[string]bsoncore.Value
 	timeout                  *time.Duration
+	maxTime                  *time.Duration
 	omitMaxTimeMS            bool
+	explain                  string
 
-	result driver.CursorResponse
+	result         driver.CursorResponse
+	explainResult  bsoncore.Document
+	documentResult bsoncore.Document
 }
 
 // NewAggregate constructs and returns a new Aggregate.
@@ -71,6 +77,17 @@ func (a *Aggregate) Result(opts driver.CursorOptions) (*driver.BatchCursor, erro
 	return driver.NewBatchCursor(a.result, clientSession, clock, opts)
 }
 
+// ExplainResult returns the output of the explain command if the operation was executed with Explain set.
+func (a *Aggregate) ExplainResult() bsoncore.Document {
+	return a.explainResult
+}
+
+// DocumentResult returns the server response if it did not contain a cursor, which happens when an explain option is
+// passed to the aggregate command through CustomOptions. It returns nil if the response contained a cursor.
+func (a *Aggregate) DocumentResult() bsoncore.Document {
+	return a.documentResult
+}
+
 // ResultCursorResponse returns the underlying CursorResponse result of executing this
 // operation.
 func (a *Aggregate) ResultCursorResponse() driver.CursorResponse {
@@ -78,12 +95,21 @@ func (a *Aggregate) ResultCursorResponse() driver.CursorResponse {
 }
 
 func (a *Aggregate) processResponse(_ context.Context, resp bsoncore.Document, info driver.ResponseInfo) error {
+	a.documentResult = nil
 	curDoc, err := driver.ExtractCursorDocument(resp)
+	if errors.Is(err, driver.ErrNoCursor) {
+		a.documentResult = append(bsoncore.Document(nil), resp...)
+		return nil
+	}
 	if err != nil {
 		return err
 	}
 	a.result, err = driver.NewCursorResponse(curDoc, info)
-	return err
+	if err != nil {
+		return err
+	}
+	a.result.Response = resp
+	return nil
 
 }
 
@@ -93,27 +119,37 @@ func (a *Aggregate) Execute(ctx context.Context) error {
 		return errors.New("the Aggregate operation must have a Deployment set before Execute can be called")
 	}
 
+	commandFn, processResponseFn := a.command, a.processResponse
+	readConcern, writeConcern := a.readConcern, a.writeConcern
+	if a.explain != "" {
+		commandFn = explainCommand(a.command, a.explain)
+		processResponseFn = explainResponse(&a.explainResult)
+		readConcern, writeConcern = nil, nil
+	}
+
 	return driver.Operation{
-		CommandFn:         a.command,
-		ProcessResponseFn: a.processResponse,
+		CommandFn:         commandFn,
+		ProcessResponseFn: processResponseFn,
 
 		Client:                         a.session,
 		Clock:                          a.clock,
 		CommandMonitor:                 a.monitor,
 		Database:                       a.database,
 		Deployment:                     a.deployment,
-		ReadConcern:                    a.readConcern,
+		ReadConcern:                    readConcern,
 		ReadPreference:                 a.readPreference,
 		Type:                           driver.Read,
 		RetryMode:                      a.retry,
 		Selector:                       a.selector,
-		WriteConcern:                   a.writeConcern,
+		WriteConcern:                   writeConcern,
 		Crypt:                          a.crypt,
 		MinimumWriteConcernWireVersion: 5,
 		ServerAPI:                      a.serverAPI,
 		IsOutputAggregate:              a.hasOutputStage,
 		Timeout:                        a.timeout,
+		MaxTime:                        a.maxTime,
 		Name:                           driverutil.AggregateOp,
+		Logger:                         a.logger,
 		Authenticator:                  a.authenticator,
 		OmitMaxTimeMS:                  a.omitMaxTimeMS,
 	}.Execute(ctx)
@@ -411,6 +447,27 @@ func (a *Aggregate) Timeout(timeout *time.Duration) *Aggregate {
 	return a
 }
 
+// MaxTime sets the server-side time limit for this operation. It is only used if the operation's
+// context does not have a deadline.
+func (a *Aggregate) MaxTime(maxTime *time.Duration) *Aggregate {
+	if a == nil {
+		a = new(Aggregate)
+	}
+
+	a.maxTime = maxTime
+	return a
+}
+
+// Logger sets the logger for this operation.
+func (a *Aggregate) Logger(logger *logger.Logger) *Aggregate {
+	if a == nil {
+		a = new(Aggregate)
+	}
+
+	a.logger = logger
+	return a
+}
+
 // Authenticator sets the authenticator to use for this operation.
 func (a *Aggregate) Authenticator(authenticator driver.Authenticator) *Aggregate {
 	if a == nil {
@@ -431,3 +488,14 @@ func (a *Aggregate) OmitMaxTimeMS(omit bool) *Aggregate {
 	a.omitMaxTimeMS = omit
 	return a
 }
+
+// Explain causes the operation to be run inside an explain command with the given verbosity (e.g. "queryPlanner")
+// instead of being executed. The explain output is available through ExplainResult.
+func (a *Aggregate) Explain(verbosity string) *Aggregate {
+	if a == nil {
+		a = new(Aggregate)
+	}
+
+	a.explain = verbosity
+	return a
+}
@@ -94,6 +94,7 @@ func (c *Command) Execute(ctx context.Context) error {
 				if err != nil {
 					return err
 				}
+				cursorRes.Response = resp
 
 				c.resultCursor, err = driver.NewBatchCursor(cursorRes, c.session, c.clock, c.cursorOpts)
 				return err
@@ -41,6 +41,7 @@ type Count struct {
 	result         CountResult
 	serverAPI      *driver.ServerAPIOptions
 	timeout        *time.Duration
+	maxTime        *time.Duration
 }
 
 // CountResult represents a count result returned by the server.
@@ -125,6 +126,7 @@ func (c *Count) Execute(ctx context.Context) error {
 		Selector:          c.selector,
 		ServerAPI:         c.serverAPI,
 		Timeout:           c.timeout,
+		MaxTime:           c.maxTime,
 		Name:              driverutil.CountOp,
 		Authenticator:     c.authenticator,
 	}.Execute(ctx)
@@ -301,6 +303,17 @@ func (c *Count) Timeout(timeout *time.Duration) *Count {
 	return c
 }
 
+// MaxTime sets the server-side time limit for this operation. It is only used if the operation's
+// context does not have a deadline.
+func (c *Count) MaxTime(maxTime *time.Duration) *Count {
+	if c == nil {
+		c = new(Count)
+	}
+
+	c.maxTime = maxTime
+	return c
+}
+
 // Authenticator sets the authenticator to use for this operation.
 func (c *Count) Authenticator(authenticator driver.Authenticator) *Count {
 	if c == nil {
@@ -14,6 +14,7 @@ import (
 
 	"go.mongodb.org/mongo-driver/v2/event"
 	"go.mongodb.org/mongo-driver/v2/internal/driverutil"
+	"go.mongodb.org/mongo-driver/v2/internal/logger"
 	"go.mongodb.org/mongo-driver/v2/mongo/writeconcern"
 	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver"
@@ -24,6 +25,7 @@ import (
 // CreateIndexes performs a createIndexes operation.
 type CreateIndexes struct {
 	authenticator driver.Authenticator
+	logger        *logger.Logger
 	commitQuorum  bsoncore.Value
 	indexes       bsoncore.Document
 	session       *session.Client
@@ -117,6 +119,7 @@ func (ci *CreateIndexes) Execute(ctx context.Context) error {
 		ServerAPI:         ci.serverAPI,
 		Timeout:           ci.timeout,
 		Name:              driverutil.CreateIndexesOp,
+		Logger:            ci.logger,
 		Authenticator:     ci.authenticator,
 	}.Execute(ctx)
 
@@ -268,6 +271,16 @@ func (ci *CreateIndexes) Timeout(timeout *time.Duration) *CreateIndexes {
 	return ci
 }
 
+// Logger sets the logger for this operation.
+func (ci *CreateIndexes) Logger(logger *logger.Logger) *CreateIndexes {
+	if ci == nil {
+		ci = new(CreateIndexes)
+	}
+
+	ci.logger = logger
+	return ci
+}
+
 // Authenticator sets the authenticator to use for this operation.
 func (ci *CreateIndexes) Authenticator(authenticator driver.Authenticator) *CreateIndexes {
 	if ci == nil {
@@ -43,6 +43,7 @@ type Distinct struct {
 	result         DistinctResult
 	serverAPI      *driver.ServerAPIOptions
 	timeout        *time.Duration
+	maxTime        *time.Duration
 }
 
 // DistinctResult represents a distinct result returned by the server.
@@ -104,6 +105,7 @@ func (d *Distinct) Execute(ctx context.Context) error {
 		Selector:          d.selector,
 		ServerAPI:         d.serverAPI,
 		Timeout:           d.timeout,
+		MaxTime:           d.maxTime,
 		Name:              driverutil.DistinctOp,
 		Authenticator:     d.authenticator,
 	}.Execute(ctx)
@@ -314,6 +316,17 @@ func (d *Distinct) Timeout(timeout *time.Duration) *Distinct {
 	return d
 }
 
+// MaxTime sets the server-side time limit for this operation. It is only used if the operation's
+// context does not have a deadline.
+func (d *Distinct) MaxTime(maxTime *time.Duration) *Distinct {
+	if d == nil {
+		d = new(Distinct)
+	}
+
+	d.maxTime = maxTime
+	return d
+}
+
 // Authenticator sets the authenticator to use for this operation.
 func (d *Distinct) Authenticator(authenticator driver.Authenticator) *Distinct {
 	if d == nil {
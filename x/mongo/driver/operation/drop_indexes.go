@@ -14,6 +14,7 @@ import (
 
 	"go.mongodb.org/mongo-driver/v2/event"
 	"go.mongodb.org/mongo-driver/v2/internal/driverutil"
+	"go.mongodb.org/mongo-driver/v2/internal/logger"
 	"go.mongodb.org/mongo-driver/v2/mongo/writeconcern"
 	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver"
@@ -24,6 +25,7 @@ import (
 // DropIndexes performs an dropIndexes operation.
 type DropIndexes struct {
 	authenticator driver.Authenticator
+	logger        *logger.Logger
 	index         any
 	session       *session.Client
 	clock         *session.ClusterClock
@@ -99,6 +101,7 @@ func (di *DropIndexes) Execute(ctx context.Context) error {
 		ServerAPI:         di.serverAPI,
 		Timeout:           di.timeout,
 		Name:              driverutil.DropIndexesOp,
+		Logger:            di.logger,
 		Authenticator:     di.authenticator,
 	}.Execute(ctx)
 
@@ -239,6 +242,16 @@ func (di *DropIndexes) Timeout(timeout *time.Duration) *DropIndexes {
 	return di
 }
 
+// Logger sets the logger for this operation.
+func (di *DropIndexes) Logger(logger *logger.Logger) *DropIndexes {
+	if di == nil {
+		di = new(DropIndexes)
+	}
+
+	di.logger = logger
+	return di
+}
+
 // Authenticator sets the authenticator to use for this operation.
 func (di *DropIndexes) Authenticator(authenticator driver.Authenticator) *DropIndexes {
 	if di == nil {
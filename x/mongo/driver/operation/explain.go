@@ -0,0 +1,45 @@
+// Copyright (C) MongoDB, Inc. 2026-present.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+
+package operation
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/description"
+)
+
+type commandFn func(dst []byte, desc description.SelectedServer) ([]byte, error)
+
+// explainCommand returns a command function that runs the command built by fn inside an explain command with the
+// given verbosity.
+func explainCommand(fn commandFn, verbosity string) commandFn {
+	return func(dst []byte, desc description.SelectedServer) ([]byte, error) {
+		idx, cmd := bsoncore.AppendDocumentStart(nil)
+		cmd, err := fn(cmd, desc)
+		if err != nil {
+			return nil, err
+		}
+		cmd, err = bsoncore.AppendDocumentEnd(cmd, idx)
+		if err != nil {
+			return nil, err
+		}
+
+		dst = bsoncore.AppendDocumentElement(dst, "explain", cmd)
+		dst = bsoncore.AppendStringElement(dst, "verbosity", verbosity)
+		return dst, nil
+	}
+}
+
+// explainResponse returns a response function that stores a copy of the explain output in dst.
+func explainResponse(dst *bsoncore.Document) func(context.Context, bsoncore.Document, driver.ResponseInfo) error {
+	return func(_ context.Context, resp bsoncore.Document, _ driver.ResponseInfo) error {
+		*dst = append(bsoncore.Document(nil), resp...)
+		return nil
+	}
+}
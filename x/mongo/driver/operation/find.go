@@ -61,8 +61,11 @@ type Find struct {
 	result              driver.CursorResponse
 	serverAPI           *driver.ServerAPIOptions
 	timeout             *time.Duration
+	maxTime             *time.Duration
 	logger              *logger.Logger
 	omitMaxTimeMS       bool
+	explain             string
+	explainResult       bsoncore.Document
 }
 
 // NewFind constructs and returns a new Find.
@@ -78,13 +81,22 @@ func (f *Find) Result(opts driver.CursorOptions) (*driver.BatchCursor, error) {
 	return driver.NewBatchCursor(f.result, f.session, f.clock, opts)
 }
 
+// ExplainResult returns the output of the explain command if the operation was executed with Explain set.
+func (f *Find) ExplainResult() bsoncore.Document {
+	return f.explainResult
+}
+
 func (f *Find) processResponse(_ context.Context, resp bsoncore.Document, info driver.ResponseInfo) error {
 	curDoc, err := driver.ExtractCursorDocument(resp)
 	if err != nil {
 		return err
 	}
 	f.result, err = driver.NewCursorResponse(curDoc, info)
-	return err
+	if err != nil {
+		return err
+	}
+	f.result.Response = resp
+	return nil
 }
 
 // Execute runs this operations and returns an error if the operation did not execute successfully.
@@ -93,9 +105,17 @@ func (f *Find) Execute(ctx context.Context) error {
 		return errors.New("the Find operation must have a Deployment set before Execute can be called")
 	}
 
+	commandFn, processResponseFn := f.command, f.processResponse
+	readConcern := f.readConcern
+	if f.explain != "" {
+		commandFn = explainCommand(f.command, f.explain)
+		processResponseFn = explainResponse(&f.explainResult)
+		readConcern = nil
+	}
+
 	return driver.Operation{
-		CommandFn:         f.command,
-		ProcessResponseFn: f.processResponse,
+		CommandFn:         commandFn,
+		ProcessResponseFn: processResponseFn,
 		RetryMode:         f.retry,
 		Type:              driver.Read,
 		Client:            f.session,
@@ -104,12 +124,13 @@ func (f *Find) Execute(ctx context.Context) error {
 		Crypt:             f.crypt,
 		Database:          f.database,
 		Deployment:        f.deployment,
-		ReadConcern:       f.readConcern,
+		ReadConcern:       readConcern,
 		ReadPreference:    f.readPreference,
 		Selector:          f.selector,
 		Legacy:            driver.LegacyFind,
 		ServerAPI:         f.serverAPI,
 		Timeout:           f.timeout,
+		MaxTime:           f.maxTime,
 		Logger:            f.logger,
 		Name:              driverutil.FindOp,
 		Authenticator:     f.authenticator,
@@ -545,6 +566,17 @@ func (f *Find) Timeout(timeout *time.Duration) *Find {
 	return f
 }
 
+// MaxTime sets the server-side time limit for this operation. It is only used if the operation's
+// context does not have a deadline.
+func (f *Find) MaxTime(maxTime *time.Duration) *Find {
+	if f == nil {
+		f = new(Find)
+	}
+
+	f.maxTime = maxTime
+	return f
+}
+
 // Logger sets the logger for this operation.
 func (f *Find) Logger(logger *logger.Logger) *Find {
 	if f == nil {
@@ -575,3 +607,14 @@ func (f *Find) OmitMaxTimeMS(omit bool) *Find {
 	f.omitMaxTimeMS = omit
 	return f
 }
+
+// Explain causes the operation to be run inside an explain command with the given verbosity (e.g. "queryPlanner")
+// instead of being executed. The explain output is available through ExplainResult.
+func (f *Find) Explain(verbosity string) *Find {
+	if f == nil {
+		f = new(Find)
+	}
+
+	f.explain = verbosity
+	return f
+}
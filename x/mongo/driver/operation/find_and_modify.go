@@ -50,6 +50,7 @@ type FindAndModify struct {
 	serverAPI                *driver.ServerAPIOptions
 	let                      bsoncore.Document
 	timeout                  *time.Duration
+	maxTime                  *time.Duration
 
 	result FindAndModifyResult
 }
@@ -68,6 +69,8 @@ type FindAndModifyResult struct {
 	Value bsoncore.Document
 	// Contains information about updates and upserts.
 	LastErrorObject LastErrorObject
+	// The full server response.
+	Raw bsoncore.Document
 }
 
 func buildFindAndModifyResult(response bsoncore.Document) (FindAndModifyResult, error) {
@@ -75,7 +78,7 @@ func buildFindAndModifyResult(response bsoncore.Document) (FindAndModifyResult,
 	if err != nil {
 		return FindAndModifyResult{}, err
 	}
-	famr := FindAndModifyResult{}
+	famr := FindAndModifyResult{Raw: response}
 	for _, element := range elements {
 		switch element.Key() {
 		case "value":
@@ -142,6 +145,7 @@ func (fam *FindAndModify) Execute(ctx context.Context) error {
 		Crypt:          fam.crypt,
 		ServerAPI:      fam.serverAPI,
 		Timeout:        fam.timeout,
+		MaxTime:        fam.maxTime,
 		Name:           driverutil.FindAndModifyOp,
 		Authenticator:  fam.authenticator,
 	}.Execute(ctx)
@@ -467,6 +471,17 @@ func (fam *FindAndModify) Timeout(timeout *time.Duration) *FindAndModify {
 	return fam
 }
 
+// MaxTime sets the server-side time limit for this operation. It is only used if the operation's
+// context does not have a deadline.
+func (fam *FindAndModify) MaxTime(maxTime *time.Duration) *FindAndModify {
+	if fam == nil {
+		fam = new(FindAndModify)
+	}
+
+	fam.maxTime = maxTime
+	return fam
+}
+
 // Authenticator sets the authenticator to use for this operation.
 func (fam *FindAndModify) Authenticator(authenticator driver.Authenticator) *FindAndModify {
 	if fam == nil {
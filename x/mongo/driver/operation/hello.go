@@ -13,6 +13,7 @@ import (
 	"runtime"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/internal/bsonutil"
@@ -413,12 +414,36 @@ func appendClientOS(dst []byte, omitNonType bool) ([]byte, error) {
 // responsibility of the caller to check that this appending does not cause dst
 // to exceed any size limitations.
 func appendClientPlatform(dst []byte, outerLibraryPlatform string) []byte {
+	return bsoncore.AppendStringElement(dst, "platform", clientPlatform(outerLibraryPlatform))
+}
+
+// clientPlatform returns the platform string for the client metadata.
+func clientPlatform(outerLibraryPlatform string) string {
 	platform := runtime.Version()
 	if outerLibraryPlatform != "" {
 		platform = platform + "|" + outerLibraryPlatform
 	}
 
-	return bsoncore.AppendStringElement(dst, "platform", platform)
+	return platform
+}
+
+// truncateUTF8 returns the longest prefix of s that is at most maxLen bytes
+// long and does not split a multi-byte UTF-8 sequence.
+func truncateUTF8(s string, maxLen int) string {
+	if maxLen <= 0 {
+		return ""
+	}
+	if len(s) <= maxLen {
+		return s
+	}
+
+	// Back up to the start of the rune that contains the byte at maxLen so the
+	// prefix ends on a rune boundary.
+	for maxLen > 0 && !utf8.RuneStart(s[maxLen]) {
+		maxLen--
+	}
+
+	return s[:maxLen]
 }
 
 // encodeClientMetadata encodes the client metadata into a BSON document. maxLen
@@ -455,6 +480,9 @@ func appendClientPlatform(dst []byte, outerLibraryPlatform string) []byte {
 //			}
 //		}
 //	}
+//
+// If omitting fields is not enough, platform and then application.name are
+// truncated to fit. Truncated strings never split a multi-byte UTF-8 sequence.
 func encodeClientMetadata(h *Hello, maxLen int) ([]byte, error) {
 	dst := make([]byte, 0, maxLen)
 
@@ -463,13 +491,17 @@ func encodeClientMetadata(h *Hello, maxLen int) ([]byte, error) {
 	omitOSNonType := false
 	omitEnvDocument := false
 	truncatePlatform := false
+	truncateAppName := false
+
+	appname := h.appname
+	platform := clientPlatform(h.outerLibraryPlatform)
 
 retry:
 	var idx int32
 	idx, dst = bsoncore.AppendDocumentStart(dst)
 
 	var err error
-	dst, err = appendClientAppName(dst, h.appname)
+	dst, err = appendClientAppName(dst, appname)
 	if err != nil {
 		return nil, err
 	}
@@ -484,8 +516,8 @@ retry:
 		return nil, err
 	}
 
-	if !truncatePlatform {
-		dst = appendClientPlatform(dst, h.outerLibraryPlatform)
+	if platform != "" {
+		dst = bsoncore.AppendStringElement(dst, "platform", platform)
 	}
 
 	if !omitEnvDocument {
@@ -508,6 +540,10 @@ retry:
 		//    2. Omit fields from ``os`` except ``os.type``
 		//    3. Omit the ``env`` document entirely
 		//    4. Truncate ``platform``
+		//
+		// If that is still not enough, truncate ``application.name`` as a
+		// last resort.
+		overflow := len(dst) - maxLen
 		dst = dst[:0]
 
 		if !omitEnvNonName {
@@ -530,6 +566,18 @@ retry:
 
 		if !truncatePlatform {
 			truncatePlatform = true
+			// The platform element is omitted if the platform is truncated
+			// to an empty string.
+			platform = truncateUTF8(platform, len(platform)-overflow)
+
+			goto retry
+		}
+
+		if !truncateAppName && appname != "" {
+			truncateAppName = true
+			// The application document is omitted if the name is
+			// truncated to an empty string.
+			appname = truncateUTF8(appname, len(appname)-overflow)
 
 			goto retry
 		}
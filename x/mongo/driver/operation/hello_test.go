@@ -10,7 +10,9 @@ import (
 	"fmt"
 	"os"
 	"runtime"
+	"strings"
 	"testing"
+	"unicode/utf8"
 
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/internal/assert"
@@ -600,6 +602,118 @@ func TestEncodeClientMetadata(t *testing.T) {
 	})
 }
 
+func TestTruncateUTF8(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		s      string
+		maxLen int
+		want   string
+	}{
+		{name: "shorter than max", s: "foo", maxLen: 5, want: "foo"},
+		{name: "equal to max", s: "foo", maxLen: 3, want: "foo"},
+		{name: "ascii", s: "foobar", maxLen: 3, want: "foo"},
+		{name: "rune boundary", s: "aé日", maxLen: 3, want: "aé"},
+		{name: "inside two-byte rune", s: "aé日", maxLen: 2, want: "a"},
+		{name: "inside three-byte rune", s: "aé日", maxLen: 5, want: "aé"},
+		{name: "zero", s: "foo", maxLen: 0, want: ""},
+		{name: "negative", s: "foo", maxLen: -1, want: ""},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := truncateUTF8(test.s, test.maxLen)
+			assert.Equal(t, test.want, got, "expected %q, got %q", test.want, got)
+		})
+	}
+}
+
+func TestEncodeClientMetadataTruncation(t *testing.T) {
+	clearTestEnv(t)
+
+	// Populate every FaaS field so that the env document is as large as
+	// possible.
+	t.Setenv("AWS_LAMBDA_RUNTIME_API", "lambda")
+	t.Setenv("AWS_LAMBDA_FUNCTION_MEMORY_SIZE", "123")
+	t.Setenv("AWS_REGION", "us-east-2")
+
+	type clientMetadata struct {
+		Application *struct {
+			Name string `bson:"name"`
+		} `bson:"application"`
+		Driver *struct {
+			Name    string `bson:"name"`
+			Version string `bson:"version"`
+		} `bson:"driver"`
+		OS       bson.M  `bson:"os"`
+		Platform *string `bson:"platform"`
+		Env      bson.M  `bson:"env"`
+	}
+
+	decode := func(t *testing.T, doc []byte, maxLen int) clientMetadata {
+		t.Helper()
+
+		require.NotEqual(t, 0, len(doc), "expected client metadata, got none")
+		assert.LessOrEqual(t, len(doc), maxLen, "expected metadata of at most %d bytes, got %d", maxLen, len(doc))
+		require.NoError(t, bsoncore.Document(doc).Validate(), "expected metadata to be valid BSON")
+
+		var got clientMetadata
+		err := bson.Unmarshal(doc, &got)
+		require.NoError(t, err, "error unmarshaling client metadata")
+
+		assert.NotNil(t, got.Driver, "expected driver to be present")
+		assert.Nil(t, got.Env, "expected env to be omitted, got %v", got.Env)
+		assert.Equal(t, bson.M{"type": runtime.GOOS}, got.OS, "expected only os.type to be present")
+		return got
+	}
+
+	t.Run("platform is truncated at a rune boundary", func(t *testing.T) {
+		h := NewHello().AppName("foo").OuterLibraryPlatform(strings.Repeat("é", 300))
+
+		doc, err := encodeClientMetadata(h, maxClientMetadataSize)
+		require.NoError(t, err, "error in encodeClientMetadata")
+
+		got := decode(t, doc, maxClientMetadataSize)
+		require.NotNil(t, got.Platform, "expected truncated platform to be present")
+		assert.True(t, utf8.ValidString(*got.Platform), "expected platform to be valid UTF-8: %q", *got.Platform)
+		assert.True(t, strings.HasPrefix(*got.Platform, runtime.Version()+"|é"),
+			"expected platform to keep its prefix, got %q", *got.Platform)
+		assert.Less(t, len(*got.Platform), len(clientPlatform(strings.Repeat("é", 300))),
+			"expected platform to be truncated")
+
+		require.NotNil(t, got.Application, "expected application to be present")
+		assert.Equal(t, "foo", got.Application.Name, "expected application name to be unchanged")
+	})
+
+	t.Run("application name is truncated last", func(t *testing.T) {
+		appname := strings.Repeat("日", 100)
+		const maxLen = 200
+
+		doc, err := encodeClientMetadata(NewHello().AppName(appname), maxLen)
+		require.NoError(t, err, "error in encodeClientMetadata")
+
+		got := decode(t, doc, maxLen)
+		assert.Nil(t, got.Platform, "expected platform to be omitted, got %v", got.Platform)
+		require.NotNil(t, got.Application, "expected truncated application to be present")
+		assert.True(t, utf8.ValidString(got.Application.Name),
+			"expected application name to be valid UTF-8: %q", got.Application.Name)
+		assert.True(t, strings.HasPrefix(appname, got.Application.Name),
+			"expected application name to be a prefix of %q, got %q", appname, got.Application.Name)
+		assert.Less(t, len(got.Application.Name), len(appname), "expected application name to be truncated")
+	})
+
+	t.Run("nothing fits", func(t *testing.T) {
+		doc, err := encodeClientMetadata(NewHello().AppName("foo"), 10)
+		require.NoError(t, err, "error in encodeClientMetadata")
+		assert.Len(t, doc, 0, "expected no client metadata, got %v", doc)
+	})
+}
+
 func TestParseFaasEnvName(t *testing.T) {
 	clearTestEnv(t)
 
@@ -61,7 +61,11 @@ func (lc *ListCollections) processResponse(_ context.Context, resp bsoncore.Docu
 		return err
 	}
 	lc.result, err = driver.NewCursorResponse(curDoc, info)
-	return err
+	if err != nil {
+		return err
+	}
+	lc.result.Response = resp
+	return nil
 }
 
 // Execute runs this operations and returns an error if the operation did not execute successfully.
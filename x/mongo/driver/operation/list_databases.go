@@ -15,6 +15,7 @@ import (
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/event"
 	"go.mongodb.org/mongo-driver/v2/internal/driverutil"
+	"go.mongodb.org/mongo-driver/v2/internal/logger"
 	"go.mongodb.org/mongo-driver/v2/mongo/readpref"
 	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver"
@@ -25,6 +26,7 @@ import (
 // ListDatabases performs a listDatabases operation.
 type ListDatabases struct {
 	authenticator       driver.Authenticator
+	logger              *logger.Logger
 	filter              bsoncore.Document
 	authorizedDatabases *bool
 	nameOnly            *bool
@@ -166,6 +168,7 @@ func (ld *ListDatabases) Execute(ctx context.Context) error {
 		ServerAPI:      ld.serverAPI,
 		Timeout:        ld.timeout,
 		Name:           driverutil.ListDatabasesOp,
+		Logger:         ld.logger,
 		Authenticator:  ld.authenticator,
 	}.Execute(ctx)
 
@@ -330,6 +333,16 @@ func (ld *ListDatabases) Timeout(timeout *time.Duration) *ListDatabases {
 	return ld
 }
 
+// Logger sets the logger for this operation.
+func (ld *ListDatabases) Logger(logger *logger.Logger) *ListDatabases {
+	if ld == nil {
+		ld = new(ListDatabases)
+	}
+
+	ld.logger = logger
+	return ld
+}
+
 // Authenticator sets the authenticator to use for this operation.
 func (ld *ListDatabases) Authenticator(authenticator driver.Authenticator) *ListDatabases {
 	if ld == nil {
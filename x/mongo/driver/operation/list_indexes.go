@@ -13,6 +13,7 @@ import (
 
 	"go.mongodb.org/mongo-driver/v2/event"
 	"go.mongodb.org/mongo-driver/v2/internal/driverutil"
+	"go.mongodb.org/mongo-driver/v2/internal/logger"
 	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/description"
@@ -22,6 +23,7 @@ import (
 // ListIndexes performs a listIndexes operation.
 type ListIndexes struct {
 	authenticator driver.Authenticator
+	logger        *logger.Logger
 	batchSize     *int32
 	session       *session.Client
 	clock         *session.ClusterClock
@@ -59,7 +61,11 @@ func (li *ListIndexes) processResponse(_ context.Context, resp bsoncore.Document
 		return err
 	}
 	li.result, err = driver.NewCursorResponse(curDoc, info)
-	return err
+	if err != nil {
+		return err
+	}
+	li.result.Response = resp
+	return nil
 
 }
 
@@ -86,6 +92,7 @@ func (li *ListIndexes) Execute(ctx context.Context) error {
 		ServerAPI:      li.serverAPI,
 		Timeout:        li.timeout,
 		Name:           driverutil.ListIndexesOp,
+		Logger:         li.logger,
 		Authenticator:  li.authenticator,
 	}.Execute(ctx)
 
@@ -226,6 +233,16 @@ func (li *ListIndexes) Timeout(timeout *time.Duration) *ListIndexes {
 	return li
 }
 
+// Logger sets the logger for this operation.
+func (li *ListIndexes) Logger(logger *logger.Logger) *ListIndexes {
+	if li == nil {
+		li = new(ListIndexes)
+	}
+
+	li.logger = logger
+	return li
+}
+
 // Authenticator sets the authenticator to use for this operation.
 func (li *ListIndexes) Authenticator(authenticator driver.Authenticator) *ListIndexes {
 	if li == nil {
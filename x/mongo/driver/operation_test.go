@@ -10,6 +10,7 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -271,9 +272,11 @@ func TestOperation(t *testing.T) {
 	})
 	t.Run("calculateMaxTimeMS", func(t *testing.T) {
 		var (
-			timeout  = 5 * time.Second
-			shortRTT = 50 * time.Millisecond
-			longRTT  = 10 * time.Second
+			timeout     = 5 * time.Second
+			maxTime     = 2 * time.Second
+			longMaxTime = time.Hour
+			shortRTT    = 50 * time.Millisecond
+			longRTT     = 10 * time.Second
 		)
 
 		timeoutCtx, cancel := csot.WithTimeout(context.Background(), &timeout)
@@ -305,6 +308,38 @@ func TestOperation(t *testing.T) {
 				want:     1,
 				err:      nil,
 			},
+			{
+				name:   "uses MaxTime without context deadline",
+				op:     Operation{MaxTime: &maxTime},
+				ctx:    context.Background(),
+				rttMin: shortRTT,
+				want:   2000,
+				err:    nil,
+			},
+			{
+				name:   "context deadline takes precedence over MaxTime",
+				op:     Operation{MaxTime: &longMaxTime},
+				ctx:    timeoutCtx,
+				rttMin: shortRTT,
+				want:   5000,
+				err:    nil,
+			},
+			{
+				name:   "omitted context deadline does not fall back to MaxTime",
+				op:     Operation{MaxTime: &maxTime, OmitMaxTimeMS: true},
+				ctx:    timeoutCtx,
+				rttMin: shortRTT,
+				want:   0,
+				err:    nil,
+			},
+			{
+				name:   "OmitMaxTimeMS without context deadline does not use MaxTime",
+				op:     Operation{MaxTime: &maxTime, OmitMaxTimeMS: true},
+				ctx:    context.Background(),
+				rttMin: shortRTT,
+				want:   0,
+				err:    nil,
+			},
 		}
 		for _, tc := range testCases {
 			// Capture test-case for parallel sub-test.
@@ -828,6 +863,48 @@ func (ms *mockRetryServer) RTTMonitor() RTTMonitor {
 	return &csot.ZeroRTTMonitor{}
 }
 
+func TestCreateWireMessageDocumentSequence(t *testing.T) {
+	doc := bsoncore.NewDocumentBuilder().AppendString("x", strings.Repeat("a", 100)).Build()
+	docs := make([]bsoncore.Document, 10)
+	for i := range docs {
+		docs[i] = doc
+	}
+
+	// Allow room for exactly four documents by document size alone. Once the header and command
+	// body are accounted for, only three fit.
+	maxMessageSize := uint32(4 * len(doc))
+	desc := description.SelectedServer{
+		Server: description.Server{
+			WireVersion:    &description.VersionRange{Max: 21},
+			MaxBatchCount:  100000,
+			MaxMessageSize: maxMessageSize,
+		},
+	}
+
+	op := Operation{
+		Database: "foobar",
+		Batches:  &Batches{Identifier: "documents", Documents: docs},
+		CommandFn: func(dst []byte, _ description.SelectedServer) ([]byte, error) {
+			return bsoncore.AppendStringElement(dst, "insert", "coll"), nil
+		},
+	}
+
+	conn := mnet.NewConnection(&mockConnection{})
+	wm, _, info, err := op.createWireMessage(context.Background(), 0, nil, desc, conn, 1)
+	require.NoError(t, err, "createWireMessage error")
+
+	assert.LessOrEqual(t, len(wm), int(maxMessageSize),
+		"expected wire message size to be at most %d, got %d", maxMessageSize, len(wm))
+	assert.Equal(t, 3, info.processedBatches, "expected 3 documents in batch, got %d", info.processedBatches)
+
+	// Command monitoring reassembles the document sequence from the message.
+	require.Len(t, info.documentSequences, 1, "expected 1 document sequence, got %d", len(info.documentSequences))
+	assert.Equal(t, "documents", info.documentSequences[0].identifier,
+		"expected identifier %q, got %q", "documents", info.documentSequences[0].identifier)
+	assert.Equal(t, 3*len(doc), len(info.documentSequences[0].data),
+		"expected %d bytes of documents, got %d", 3*len(doc), len(info.documentSequences[0].data))
+}
+
 func TestRetry(t *testing.T) {
 	t.Run("retries multiple times with RetryContext", func(t *testing.T) {
 		d := new(mockDeployment)
@@ -7,6 +7,7 @@
 package session
 
 import (
+	"context"
 	"errors"
 	"time"
 
@@ -174,6 +175,17 @@ func NewImplicitClientSession(pool *Pool, clientID uuid.UUID) *Client {
 
 // NewClientSession creates a new explicit client-side session.
 func NewClientSession(pool *Pool, clientID uuid.UUID, opts ...*ClientOptions) (*Client, error) {
+	return NewClientSessionWithContext(context.Background(), pool, clientID, opts...)
+}
+
+// NewClientSessionWithContext creates a new explicit client-side session. The server session is checked out of pool
+// using ctx, so the context's error is returned if ctx is done before the checkout.
+func NewClientSessionWithContext(
+	ctx context.Context,
+	pool *Pool,
+	clientID uuid.UUID,
+	opts ...*ClientOptions,
+) (*Client, error) {
 	c := &Client{
 		pool:     pool,
 		ClientID: clientID,
@@ -205,7 +217,7 @@ func NewClientSession(pool *Pool, clientID uuid.UUID, opts ...*ClientOptions) (*
 		return nil, errors.New("causal consistency and snapshot cannot both be set for a session")
 	}
 
-	if err := c.SetServer(); err != nil {
+	if err := c.SetServerWithContext(ctx); err != nil {
 		return nil, err
 	}
 
@@ -214,8 +226,13 @@ func NewClientSession(pool *Pool, clientID uuid.UUID, opts ...*ClientOptions) (*
 
 // SetServer will check out a session from the client session pool.
 func (c *Client) SetServer() error {
+	return c.SetServerWithContext(context.Background())
+}
+
+// SetServerWithContext will check out a session from the client session pool using the provided context.
+func (c *Client) SetServerWithContext(ctx context.Context) error {
 	var err error
-	c.Server, err = c.pool.GetSession()
+	c.Server, err = c.pool.GetSessionWithContext(ctx)
 	return err
 }
 
@@ -7,6 +7,7 @@
 package session
 
 import (
+	"context"
 	"sync"
 	"sync/atomic"
 
@@ -74,9 +75,19 @@ func (p *Pool) updateTimeout() {
 
 // GetSession retrieves an unexpired session from the pool.
 func (p *Pool) GetSession() (*Server, error) {
+	return p.GetSessionWithContext(context.Background())
+}
+
+// GetSessionWithContext retrieves an unexpired session from the pool. If ctx is cancelled or its deadline has been
+// exceeded by the time the pool is available, no session is checked out and the context's error is returned.
+func (p *Pool) GetSessionWithContext(ctx context.Context) (*Server, error) {
 	p.mutex.Lock() // prevent changing the linked list while seeing if sessions have expired
 	defer p.mutex.Unlock()
 
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	// empty pool
 	if p.head == nil && p.tail == nil {
 		return p.createServerSession()
@@ -8,6 +8,7 @@ package session
 
 import (
 	"bytes"
+	"context"
 	"testing"
 
 	"go.mongodb.org/mongo-driver/v2/internal/assert"
@@ -69,4 +70,17 @@ func TestSessionPool(t *testing.T) {
 		assert.False(t, bytes.Equal(sess.SessionID, firstID), "first expired session was not removed")
 		assert.False(t, bytes.Equal(sess.SessionID, secondID), "second expired session was not removed")
 	})
+
+	t.Run("GetSessionWithContext cancelled", func(t *testing.T) {
+		descChan := make(chan description.Topology)
+		p := NewPool(descChan)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		sess, err := p.GetSessionWithContext(ctx)
+		assert.Nil(t, sess, "expected nil session, got %v", sess)
+		assert.Equal(t, context.Canceled, err, "expected error %v, got %v", context.Canceled, err)
+		assert.Equal(t, int64(0), p.CheckedOut(), "expected 0 checked out sessions, got %d", p.CheckedOut())
+	})
 }
@@ -20,6 +20,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"go.mongodb.org/mongo-driver/v2/event"
 	"go.mongodb.org/mongo-driver/v2/internal/driverutil"
 	"go.mongodb.org/mongo-driver/v2/mongo/address"
 	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
@@ -53,6 +54,12 @@ type connection struct {
 	// - suggested layout: https://go101.org/article/memory-layout.html
 	state int64
 
+	// The following byte counters must be accessed using the atomic package.
+	bytesSent                 int64
+	bytesReceived             int64
+	uncompressedBytesSent     int64
+	uncompressedBytesReceived int64
+
 	id                   string
 	nc                   net.Conn // When nil, the connection is closed.
 	addr                 address.Address
@@ -370,10 +377,53 @@ func (c *connection) writeWireMessage(ctx context.Context, wm []byte) error {
 			message:      "unable to write wire message to network",
 		}
 	}
+	c.countBytes(wm, &c.bytesSent, &c.uncompressedBytesSent)
 
 	return nil
 }
 
+// countBytes adds the size of wm to the wire counter and the size wm would have had if it were not compressed to
+// the uncompressed counter.
+func (c *connection) countBytes(wm []byte, wire, uncompressed *int64) {
+	atomic.AddInt64(wire, int64(len(wm)))
+
+	size := int64(len(wm))
+	if _, _, _, opcode, rem, ok := wiremessage.ReadHeader(wm); ok && opcode == wiremessage.OpCompressed {
+		_, rem, _ = wiremessage.ReadCompressedOriginalOpCode(rem)
+		if uncompressedSize, _, ok := wiremessage.ReadCompressedUncompressedSize(rem); ok {
+			// The uncompressed size does not include the 16 byte message header.
+			size = int64(uncompressedSize) + 16
+		}
+	}
+	atomic.AddInt64(uncompressed, size)
+}
+
+// compressionStats returns the negotiated compressor and a snapshot of the byte counters for the connection.
+func (c *connection) compressionStats() *event.CompressionStats {
+	return &event.CompressionStats{
+		Compressor:                c.compressorName(),
+		BytesSent:                 atomic.LoadInt64(&c.bytesSent),
+		BytesReceived:             atomic.LoadInt64(&c.bytesReceived),
+		UncompressedBytesSent:     atomic.LoadInt64(&c.uncompressedBytesSent),
+		UncompressedBytesReceived: atomic.LoadInt64(&c.uncompressedBytesReceived),
+	}
+}
+
+// compressorName returns the name of the compressor negotiated for the connection, or an empty string if no
+// compressor was negotiated.
+func (c *connection) compressorName() string {
+	switch c.compressor {
+	case wiremessage.CompressorSnappy:
+		return "snappy"
+	case wiremessage.CompressorZLib:
+		return "zlib"
+	case wiremessage.CompressorZstd:
+		return "zstd"
+	default:
+		return ""
+	}
+}
+
 func (c *connection) write(ctx context.Context, wm []byte) (err error) {
 	go c.cancellationListener.Listen(ctx, c.cancellationListenerCallback)
 	defer func() {
@@ -422,6 +472,7 @@ func (c *connection) readWireMessage(ctx context.Context) ([]byte, error) {
 			message:      message,
 		}
 	}
+	c.countBytes(dst, &c.bytesReceived, &c.uncompressedBytesReceived)
 
 	return dst, nil
 }
@@ -18,9 +18,11 @@ import (
 	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"go.mongodb.org/mongo-driver/v2/event"
 	"go.mongodb.org/mongo-driver/v2/internal/assert"
 	"go.mongodb.org/mongo-driver/v2/internal/require"
 	"go.mongodb.org/mongo-driver/v2/mongo/address"
+	"go.mongodb.org/mongo-driver/v2/x/bsonx/bsoncore"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/description"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/mnet"
@@ -789,6 +791,29 @@ func TestConnection(t *testing.T) {
 	})
 }
 
+func TestConnection_compressionStats(t *testing.T) {
+	idx, wm := wiremessage.AppendHeaderStart(nil, 1, 0, wiremessage.OpMsg)
+	wm = append(wm, make([]byte, 512)...)
+	wm = bsoncore.UpdateLength(wm, idx, int32(len(wm)))
+
+	c := &connection{compressor: wiremessage.CompressorZLib, zliblevel: wiremessage.DefaultZlibLevel}
+	compressed, err := (&Connection{connection: c}).CompressWireMessage(wm, nil)
+	require.NoError(t, err, "CompressWireMessage error")
+	require.Less(t, len(compressed), len(wm), "expected compressed message to be smaller")
+
+	c.countBytes(compressed, &c.bytesSent, &c.uncompressedBytesSent)
+	c.countBytes(wm, &c.bytesReceived, &c.uncompressedBytesReceived)
+
+	want := &event.CompressionStats{
+		Compressor:                "zlib",
+		BytesSent:                 int64(len(compressed)),
+		BytesReceived:             int64(len(wm)),
+		UncompressedBytesSent:     int64(len(wm)),
+		UncompressedBytesReceived: int64(len(wm)),
+	}
+	assert.Equal(t, want, c.compressionStats(), "unexpected compression stats")
+}
+
 func BenchmarkConnection(b *testing.B) {
 	b.Run("CompressWireMessage CompressorNoOp", func(b *testing.B) {
 		buf := make([]byte, 256)
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -20,6 +21,7 @@ import (
 	"go.mongodb.org/mongo-driver/v2/internal/logger"
 	"go.mongodb.org/mongo-driver/v2/mongo/address"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/wiremessage"
 )
 
 // Connection pool state constants.
@@ -765,6 +767,7 @@ func (p *pool) removeConnection(conn *connection, reason reason, err error) erro
 			ConnectionID: conn.driverConnectionID,
 			Reason:       reason.event,
 			Error:        err,
+			Compression:  conn.compressionStats(),
 		})
 	}
 
@@ -872,6 +875,7 @@ func (p *pool) checkIn(conn *connection) error {
 			Type:         event.ConnectionCheckedIn,
 			ConnectionID: conn.driverConnectionID,
 			Address:      conn.addr.String(),
+			Compression:  conn.compressionStats(),
 		})
 	}
 
@@ -1261,6 +1265,14 @@ func (p *pool) createConnections(ctx context.Context, wg *sync.WaitGroup) {
 			}
 
 			logPoolMessage(p, logger.ConnectionReady, keysAndValues...)
+
+			// Log when compression was requested but the server did not accept any of the requested
+			// compressors, as all messages on the connection will be sent uncompressed.
+			if len(conn.config.compressors) > 0 && conn.compressor == wiremessage.CompressorNoOp {
+				logPoolMessage(p, logger.ConnectionCompressionRejected,
+					logger.KeyDriverConnectionID, conn.driverConnectionID,
+					logger.KeyCompressors, strings.Join(conn.config.compressors, ","))
+			}
 		}
 
 		if p.monitor != nil {
@@ -1269,6 +1281,7 @@ func (p *pool) createConnections(ctx context.Context, wg *sync.WaitGroup) {
 				Address:      p.address.String(),
 				ConnectionID: conn.driverConnectionID,
 				Duration:     duration,
+				Compression:  conn.compressionStats(),
 			})
 		}
 
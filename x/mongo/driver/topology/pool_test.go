@@ -19,8 +19,12 @@ import (
 	"go.mongodb.org/mongo-driver/v2/internal/assert"
 	"go.mongodb.org/mongo-driver/v2/internal/csot"
 	"go.mongodb.org/mongo-driver/v2/internal/eventtest"
+	"go.mongodb.org/mongo-driver/v2/internal/logger"
 	"go.mongodb.org/mongo-driver/v2/internal/require"
 	"go.mongodb.org/mongo-driver/v2/mongo/address"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/description"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/mnet"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/operation"
 )
 
@@ -463,6 +467,96 @@ func TestPool_ready(t *testing.T) {
 	})
 }
 
+func TestPool_clearAll(t *testing.T) {
+	t.Parallel()
+
+	t.Run("interrupts in-use connections", func(t *testing.T) {
+		t.Parallel()
+
+		cleanup := make(chan struct{})
+		defer close(cleanup)
+		addr := bootstrapConnections(t, 1, func(nc net.Conn) {
+			<-cleanup
+			_ = nc.Close()
+		})
+
+		var mu sync.Mutex
+		var cleared []*event.PoolEvent
+		d := newdialer(&net.Dialer{})
+		p := newPool(poolConfig{
+			Address:        address.Address(addr.String()),
+			ConnectTimeout: defaultConnectionTimeout,
+			PoolMonitor: &event.PoolMonitor{
+				Event: func(evt *event.PoolEvent) {
+					if evt.Type == event.ConnectionPoolCleared {
+						mu.Lock()
+						cleared = append(cleared, evt)
+						mu.Unlock()
+					}
+				},
+			},
+		}, WithDialer(func(Dialer) Dialer { return d }))
+		err := p.ready()
+		require.NoError(t, err)
+		defer p.close(context.Background())
+
+		c, err := p.checkOut(context.Background())
+		require.NoError(t, err)
+
+		// Simulate an in-flight operation blocked waiting for a reply that never arrives.
+		readErr := make(chan error, 1)
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			_, err := c.readWireMessage(ctx)
+			readErr <- err
+		}()
+
+		p.clearAll(errors.New("heartbeat timeout"), nil)
+
+		select {
+		case err := <-readErr:
+			var connErr ConnectionError
+			assert.True(t, errors.As(err, &connErr), "expected a ConnectionError, got %v", err)
+		case <-time.After(3 * time.Second):
+			t.Fatal("timed out waiting for the interrupted read to fail")
+		}
+		assertConnectionsClosed(t, d, 1)
+
+		mu.Lock()
+		defer mu.Unlock()
+		require.Len(t, cleared, 1, "expected 1 ConnectionPoolCleared event")
+		assert.True(t, cleared[0].Interruption, "expected ConnectionPoolCleared event to report an interruption")
+	})
+	t.Run("clear does not interrupt in-use connections", func(t *testing.T) {
+		t.Parallel()
+
+		cleanup := make(chan struct{})
+		defer close(cleanup)
+		addr := bootstrapConnections(t, 1, func(nc net.Conn) {
+			<-cleanup
+			_ = nc.Close()
+		})
+
+		d := newdialer(&net.Dialer{})
+		p := newPool(poolConfig{
+			Address:        address.Address(addr.String()),
+			ConnectTimeout: defaultConnectionTimeout,
+		}, WithDialer(func(Dialer) Dialer { return d }))
+		err := p.ready()
+		require.NoError(t, err)
+		defer p.close(context.Background())
+
+		c, err := p.checkOut(context.Background())
+		require.NoError(t, err)
+
+		p.clear(errors.New("network error"), nil)
+
+		assert.False(t, c.closed(), "expected in-use connection to remain open")
+		assert.Equalf(t, 0, d.lenclosed(), "should have closed 0 connections")
+	})
+}
+
 func TestPool_checkOut(t *testing.T) {
 	t.Parallel()
 
@@ -1656,3 +1750,132 @@ func TestPool_PoolMonitor(t *testing.T) {
 			"expected ConnectionCheckOutFailed Duration to be set")
 	})
 }
+
+type poolTestLogSink struct {
+	mu   sync.Mutex
+	msgs []string
+}
+
+func (s *poolTestLogSink) Info(_ int, msg string, _ ...interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.msgs = append(s.msgs, msg)
+}
+
+func (s *poolTestLogSink) Error(error, string, ...interface{}) {}
+
+func (s *poolTestLogSink) contains(msg string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, m := range s.msgs {
+		if m == msg {
+			return true
+		}
+	}
+	return false
+}
+
+func TestPool_compression(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		compressors    []string
+		serverSupports []string
+		wantCompressor string
+		wantRejected   bool
+	}{
+		{
+			name:           "first supported compressor is negotiated",
+			compressors:    []string{"zstd", "zlib"},
+			serverSupports: []string{"snappy", "zlib", "zstd"},
+			wantCompressor: "zstd",
+		},
+		{
+			name:           "falls back to later compressor",
+			compressors:    []string{"snappy", "zlib"},
+			serverSupports: []string{"zlib"},
+			wantCompressor: "zlib",
+		},
+		{
+			name:           "no supported compressors",
+			compressors:    []string{"snappy", "zstd"},
+			serverSupports: []string{"zlib"},
+			wantCompressor: "",
+			wantRejected:   true,
+		},
+		{
+			name:           "no requested compressors",
+			serverSupports: []string{"zlib"},
+			wantCompressor: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // Capture range variable.
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			cleanup := make(chan struct{})
+			defer close(cleanup)
+			addr := bootstrapConnections(t, 1, func(nc net.Conn) {
+				<-cleanup
+				_ = nc.Close()
+			})
+
+			sink := &poolTestLogSink{}
+			lgr, err := logger.New(sink, 0, map[logger.Component]logger.Level{
+				logger.ComponentConnection: logger.LevelDebug,
+			})
+			require.NoError(t, err, "logger.New error")
+
+			tpm := eventtest.NewTestPoolMonitor()
+			p := newPool(
+				poolConfig{
+					Address:     address.Address(addr.String()),
+					PoolMonitor: tpm.PoolMonitor,
+					Logger:      lgr,
+				},
+				WithCompressors(func([]string) []string { return tc.compressors }),
+				WithHandshaker(func(Handshaker) Handshaker {
+					return &testHandshaker{
+						getHandshakeInformation: func(context.Context, address.Address, *mnet.Connection) (driver.HandshakeInformation, error) {
+							return driver.HandshakeInformation{
+								Description: description.Server{Compression: tc.serverSupports},
+							}, nil
+						},
+					}
+				}),
+			)
+			err = p.ready()
+			require.NoError(t, err, "ready error")
+			defer p.close(context.Background())
+
+			conn, err := p.checkOut(context.Background())
+			require.NoError(t, err, "checkOut error")
+			err = p.checkIn(conn)
+			require.NoError(t, err, "checkIn error")
+
+			events := tpm.Events(func(evt *event.PoolEvent) bool {
+				return evt.Type == event.ConnectionReady
+			})
+			require.Len(t, events, 1, "expected 1 ConnectionReady event")
+			require.NotNil(t, events[0].Compression, "expected ConnectionReady Compression to be set")
+			assert.Equal(t, tc.wantCompressor, events[0].Compression.Compressor,
+				"expected compressor %q, got %q", tc.wantCompressor, events[0].Compression.Compressor)
+
+			rejected := sink.contains(logger.ConnectionCompressionRejected)
+			assert.Equal(t, tc.wantRejected, rejected,
+				"expected rejected compressors to be logged: %v, got %v", tc.wantRejected, rejected)
+
+			events = tpm.Events(func(evt *event.PoolEvent) bool {
+				return evt.Type == event.ConnectionCheckedIn
+			})
+			require.Len(t, events, 1, "expected 1 ConnectionCheckedIn event")
+			require.NotNil(t, events[0].Compression, "expected ConnectionCheckedIn Compression to be set")
+			assert.Equal(t, tc.wantCompressor, events[0].Compression.Compressor,
+				"expected compressor %q, got %q", tc.wantCompressor, events[0].Compression.Compressor)
+		})
+	}
+}
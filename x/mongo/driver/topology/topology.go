@@ -143,13 +143,16 @@ func New(cfg *Config) (*Topology, error) {
 		dnsResolver:       dns.DefaultResolver,
 		id:                bson.NewObjectID(),
 	}
+	if cfg.Resolver != nil {
+		t.dnsResolver = cfg.Resolver
+	}
 	t.desc.Store(description.Topology{})
 	t.updateCallback = func(desc description.Server) description.Server {
 		return t.apply(context.Background(), desc)
 	}
 
 	if t.cfg.URI != "" {
-		connStr, err := connstring.Parse(t.cfg.URI)
+		connStr, err := connstring.ParseWithResolver(t.cfg.URI, t.dnsResolver)
 		if err != nil {
 			return nil, err
 		}
@@ -15,9 +15,11 @@ import (
 
 	"go.mongodb.org/mongo-driver/v2/event"
 	"go.mongodb.org/mongo-driver/v2/internal/logger"
+	"go.mongodb.org/mongo-driver/v2/internal/stringutil"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/auth"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/dns"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/ocsp"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/operation"
 	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/session"
@@ -39,6 +41,7 @@ type Config struct {
 	ServerMonitor          *event.ServerMonitor
 	SRVMaxHosts            int
 	SRVServiceName         string
+	Resolver               *dns.Resolver
 	LoadBalanced           bool
 	logger                 *logger.Logger
 }
@@ -188,6 +191,10 @@ func NewConfigFromOptionsWithAuthenticator(opts *options.ClientOptions, clock *s
 		cfgp.SRVMaxHosts = *opts.SRVMaxHosts
 	}
 
+	if opts.Resolver != nil {
+		cfgp.Resolver = dns.NewResolver(opts.Resolver)
+	}
+
 	// AppName
 	var appName string
 	if opts.AppName != nil {
@@ -218,10 +225,23 @@ func NewConfigFromOptionsWithAuthenticator(opts *options.ClientOptions, clock *s
 	}
 
 	// Compressors & ZlibLevel
-	var comps []string
-	if len(opts.Compressors) > 0 {
-		comps = opts.Compressors
-
+	comps := append([]string(nil), opts.Compressors...)
+	zlibLevel, zstdLevel := opts.ZlibLevel, opts.ZstdLevel
+	for _, comp := range opts.CompressorOptions {
+		if !stringutil.SliceContains(comps, comp.Name) {
+			comps = append(comps, comp.Name)
+		}
+		if comp.Level == nil {
+			continue
+		}
+		switch comp.Name {
+		case "zlib":
+			zlibLevel = comp.Level
+		case "zstd":
+			zstdLevel = comp.Level
+		}
+	}
+	if len(comps) > 0 {
 		connOpts = append(connOpts, WithCompressors(
 			func(compressors []string) []string {
 				return append(compressors, comps...)
@@ -232,11 +252,11 @@ func NewConfigFromOptionsWithAuthenticator(opts *options.ClientOptions, clock *s
 			switch comp {
 			case "zlib":
 				connOpts = append(connOpts, WithZlibLevel(func(*int) *int {
-					return opts.ZlibLevel
+					return zlibLevel
 				}))
 			case "zstd":
 				connOpts = append(connOpts, WithZstdLevel(func(*int) *int {
-					return opts.ZstdLevel
+					return zstdLevel
 				}))
 			}
 		}
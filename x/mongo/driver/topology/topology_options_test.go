@@ -7,7 +7,9 @@
 package topology
 
 import (
+	"context"
 	"fmt"
+	"net"
 	"net/url"
 	"reflect"
 	"testing"
@@ -85,6 +87,68 @@ func TestLoadBalancedFromConnString(t *testing.T) {
 	}
 }
 
+func TestCompressorOptions(t *testing.T) {
+	intPtr := func(i int) *int { return &i }
+
+	testCases := []struct {
+		name        string
+		opts        *options.ClientOptions
+		compressors []string
+		zlibLevel   *int
+		zstdLevel   *int
+	}{
+		{
+			name:        "compressor options only",
+			opts:        options.Client().SetCompressorOptions([]options.CompressorOptions{{Name: "zstd", Level: intPtr(3)}}),
+			compressors: []string{"zstd"},
+			zstdLevel:   intPtr(3),
+		},
+		{
+			name: "compressor options take precedence",
+			opts: options.Client().
+				SetCompressors([]string{"zlib", "zstd"}).
+				SetZlibLevel(4).
+				SetZstdLevel(10).
+				SetCompressorOptions([]options.CompressorOptions{{Name: "zstd", Level: intPtr(3)}}),
+			compressors: []string{"zlib", "zstd"},
+			zlibLevel:   intPtr(4),
+			zstdLevel:   intPtr(3),
+		},
+		{
+			name: "compressor options without level",
+			opts: options.Client().
+				SetZstdLevel(10).
+				SetCompressors([]string{"snappy"}).
+				SetCompressorOptions([]options.CompressorOptions{{Name: "zstd"}}),
+			compressors: []string{"snappy", "zstd"},
+			zstdLevel:   intPtr(10),
+		},
+		{
+			name:        "URI zstdCompressionLevel",
+			opts:        options.Client().ApplyURI("mongodb://localhost/?compressors=zstd&zstdCompressionLevel=3"),
+			compressors: []string{"zstd"},
+			zstdLevel:   intPtr(3),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg, err := NewConfig(tc.opts, nil)
+			require.NoError(t, err, "error constructing topology config")
+
+			topo, err := New(cfg)
+			require.NoError(t, err, "error constructing topology")
+
+			srvr := NewServer("", topo.id, defaultConnectionTimeout, topo.cfg.ServerOpts...)
+			assert.Equal(t, tc.compressors, srvr.cfg.compressionOpts, "unexpected server compressors")
+
+			conn := newConnection("", srvr.cfg.connectionOpts...)
+			assert.Equal(t, tc.compressors, conn.config.compressors, "unexpected connection compressors")
+			assert.Equal(t, tc.zlibLevel, conn.config.zlibLevel, "unexpected zlib level")
+			assert.Equal(t, tc.zstdLevel, conn.config.zstdLevel, "unexpected zstd level")
+		})
+	}
+}
+
 func TestTopologyNewConfig(t *testing.T) {
 	t.Run("default ServerSelectionTimeout", func(t *testing.T) {
 		cfg, err := NewConfig(options.Client(), nil)
@@ -106,6 +170,41 @@ func TestTopologyNewConfig(t *testing.T) {
 		assert.Nil(t, err, "error constructing topology config: %v", err)
 		assert.Equal(t, []string{"localhost:27018"}, cfg.SeedList)
 	})
+	t.Run("custom Resolver", func(t *testing.T) {
+		resolver := &fakeContextResolver{
+			srv: []*net.SRV{{Target: "host1.example.com.", Port: 27017}},
+		}
+		opts := options.Client().
+			SetResolver(resolver).
+			ApplyURI("mongodb+srv://test1.example.com/")
+
+		cfg, err := NewConfig(opts, nil)
+		require.NoError(t, err, "error constructing topology config")
+		assert.Equal(t, []string{"host1.example.com:27017"}, cfg.SeedList)
+		require.NotNil(t, cfg.Resolver, "expected non-nil Resolver")
+
+		topo, err := New(cfg)
+		require.NoError(t, err, "error constructing topology")
+		assert.Equal(t, cfg.Resolver, topo.dnsResolver, "expected topology to use the configured Resolver")
+		assert.True(t, topo.pollingRequired, "expected SRV polling to be required")
+
+		hosts, err := topo.dnsResolver.ParseHosts("test1.example.com", "", true)
+		require.NoError(t, err, "ParseHosts error")
+		assert.Equal(t, []string{"host1.example.com:27017"}, hosts)
+	})
+}
+
+type fakeContextResolver struct {
+	srv []*net.SRV
+	txt []string
+}
+
+func (fr *fakeContextResolver) LookupSRV(context.Context, string, string, string) (string, []*net.SRV, error) {
+	return "", fr.srv, nil
+}
+
+func (fr *fakeContextResolver) LookupTXT(context.Context, string) ([]string, error) {
+	return fr.txt, nil
 }
 
 // Test that convertOIDCArgs exhaustively copies all fields of a driver.OIDCArgs